@@ -14,6 +14,16 @@ _script_dir=$(dirname "$0")
 if ! which gocovmerge > /dev/null; then  echo "Installing gocovmerge..."; pushd $(mktemp -d) && GOSUMDB=off go get -u github.com/wadey/gocovmerge && popd; fi
 if ! which patter > /dev/null; then      echo "Installing patter ..."; pushd $(mktemp -d) && GOSUMDB=off go get -u github.com/apg/patter && popd; fi
 
+# the webhook tests run against the kube-apiserver & etcd binaries of envtest
+ENVTEST_K8S_VERSION=${ENVTEST_K8S_VERSION:-1.19.2}
+export KUBEBUILDER_ASSETS=${KUBEBUILDER_ASSETS:-$(pwd)/build/_output/kubebuilder/bin}
+if [ ! -f "${KUBEBUILDER_ASSETS}/kube-apiserver" ]; then
+    echo "Installing envtest assets ${ENVTEST_K8S_VERSION} ..."
+    mkdir -p "${KUBEBUILDER_ASSETS}"
+    curl -sfL "https://storage.googleapis.com/kubebuilder-tools/kubebuilder-tools-${ENVTEST_K8S_VERSION}-$(go env GOOS)-$(go env GOARCH).tar.gz" \
+        | tar -C "${KUBEBUILDER_ASSETS}" -zx --strip-components=2
+fi
+
 export GOFLAGS=""
 mkdir -p test/unit/coverage
 echo 'mode: atomic' > test/unit/coverage/cover.out
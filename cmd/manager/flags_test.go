@@ -0,0 +1,77 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_validateFlags(t *testing.T) {
+	tests := []struct {
+		name                  string
+		webhookPort           int
+		addonLeaseGracePeriod time.Duration
+		csrApprovalInterval   time.Duration
+		csrApprovalBurst      int
+		wantErr               bool
+	}{
+		{
+			name:                  "defaults",
+			webhookPort:           9443,
+			addonLeaseGracePeriod: 5 * time.Minute,
+			csrApprovalInterval:   time.Minute,
+			csrApprovalBurst:      5,
+		},
+		{
+			name:                  "approval limit disabled",
+			webhookPort:           9443,
+			addonLeaseGracePeriod: 5 * time.Minute,
+			csrApprovalBurst:      1,
+		},
+		{
+			name:                  "invalid webhook port",
+			addonLeaseGracePeriod: 5 * time.Minute,
+			csrApprovalBurst:      5,
+			wantErr:               true,
+		},
+		{
+			name:             "zero lease grace period",
+			webhookPort:      9443,
+			csrApprovalBurst: 5,
+			wantErr:          true,
+		},
+		{
+			name:                  "negative approval interval",
+			webhookPort:           9443,
+			addonLeaseGracePeriod: 5 * time.Minute,
+			csrApprovalInterval:   -time.Minute,
+			csrApprovalBurst:      5,
+			wantErr:               true,
+		},
+		{
+			name:                  "zero approval burst",
+			webhookPort:           9443,
+			addonLeaseGracePeriod: 5 * time.Minute,
+			csrApprovalInterval:   time.Minute,
+			wantErr:               true,
+		},
+		{
+			name:                  "negative approval burst",
+			webhookPort:           9443,
+			addonLeaseGracePeriod: 5 * time.Minute,
+			csrApprovalInterval:   time.Minute,
+			csrApprovalBurst:      -1,
+			wantErr:               true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFlags(tt.webhookPort, tt.addonLeaseGracePeriod, tt.csrApprovalInterval, tt.csrApprovalBurst)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
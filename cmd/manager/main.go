@@ -13,14 +13,20 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"time"
 
 	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
 	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
 	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis"
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller"
 	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/clustermanagementaddon"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/csr"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/klusterletaddon"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/managedclusteraddon"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/webhook"
 	"github.com/open-cluster-management/klusterlet-addon-controller/version"
 	ocinfrav1 "github.com/openshift/api/config/v1"
 
@@ -54,14 +60,61 @@ func printVersion() {
 	log.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
 }
 
+// validateFlags returns an error if a flag is out of its range, the controller would otherwise start with
+// a configuration which can not work, e.g. a csr approval burst of 0 denies every addon csr
+func validateFlags(
+	webhookPort int,
+	addonLeaseGracePeriod, csrApprovalInterval time.Duration,
+	csrApprovalBurst int,
+) error {
+	if webhookPort < 1 || webhookPort > 65535 {
+		return fmt.Errorf("--webhook-port must be between 1 and 65535, got %d", webhookPort)
+	}
+	if addonLeaseGracePeriod <= 0 {
+		return fmt.Errorf("--addon-lease-grace-period must be greater than 0, got %s", addonLeaseGracePeriod)
+	}
+	if csrApprovalInterval < 0 {
+		return fmt.Errorf("--csr-approval-interval must not be negative, got %s", csrApprovalInterval)
+	}
+	if csrApprovalBurst < 1 {
+		return fmt.Errorf("--csr-approval-burst must be at least 1, got %d", csrApprovalBurst)
+	}
+	return nil
+}
+
 func main() {
 	var metricsAddr string
+	var enableWebhook bool
+	var webhookPort int
+	var webhookCertDir string
+	var addonLeaseGracePeriod time.Duration
+	var csrApprovalInterval time.Duration
+	var csrApprovalBurst int
+	var dryRun bool
 
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
+	flag.BoolVar(&enableWebhook, "enable-webhook", true, "Serve the admission webhooks of klusterletaddonconfigs.")
+	flag.IntVar(&webhookPort, "webhook-port", 9443, "The port the admission webhooks are served on.")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "/tmp/k8s-webhook-server/serving-certs",
+		"The directory containing tls.crt and tls.key of the admission webhooks.")
+	flag.DurationVar(&addonLeaseGracePeriod, "addon-lease-grace-period", managedclusteraddon.LeaseGracePeriod,
+		"How long an addon lease can go without being renewed before the ManagedClusterAddOn is not Available.")
+	flag.DurationVar(&csrApprovalInterval, "csr-approval-interval", csr.ApprovalInterval,
+		"The approval budget of the CSRs of an addon on a cluster is refilled by one CSR every interval, "+
+			"CSRs over the budget are denied. Set to 0 to approve CSRs without limit.")
+	flag.IntVar(&csrApprovalBurst, "csr-approval-burst", csr.ApprovalBurst,
+		"The number of CSRs of an addon on a cluster which can be approved at once, at least 1.")
+	flag.BoolVar(&dryRun, "dry-run", klusterletaddon.DryRun,
+		"List the changes of the ManifestWorks in the status of the klusterletaddonconfigs instead of applying them.")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New())
 
+	if err := validateFlags(webhookPort, addonLeaseGracePeriod, csrApprovalInterval, csrApprovalBurst); err != nil {
+		setupLog.Error(err, "invalid flags")
+		os.Exit(1)
+	}
+
 	printVersion()
 
 	// Get a config to talk to the apiserver
@@ -77,18 +130,31 @@ func main() {
 		os.Exit(1)
 	}
 
+	// load image manifests before any reconcile, they are reloaded by the imagemanifest controller afterwards
 	err = agentv1.LoadConfigmaps(kubeclient)
 	if err != nil {
 		log.Error(err, "")
 		os.Exit(1)
 	}
 
+	// apply the addon registry configuration before the addons are used by any controller
+	if err := registry.LoadConfig(kubeclient, os.Getenv("POD_NAMESPACE")); err != nil {
+		log.Error(err, "")
+		os.Exit(1)
+	}
+	if err := registry.ValidateDependencies(); err != nil {
+		log.Error(err, "Invalid addon dependencies")
+		os.Exit(1)
+	}
+
 	// Create a new Cmd to provide shared dependencies and start components
 	mgr, err := manager.New(cfg, manager.Options{
 		Namespace:          os.Getenv("WATCH_NAMESPACE"),
 		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
 		LeaderElection:     true,
 		LeaderElectionID:   "klusterlet-addon-controller-lock",
+		Port:               webhookPort,
+		CertDir:            webhookCertDir,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -127,11 +193,23 @@ func main() {
 	clustermanagementaddon.CreateClusterManagementAddon(kubeclient)
 
 	// Setup all Controllers
+	managedclusteraddon.LeaseGracePeriod = addonLeaseGracePeriod
+	csr.ApprovalInterval = csrApprovalInterval
+	csr.ApprovalBurst = csrApprovalBurst
+	klusterletaddon.DryRun = dryRun
 	if err := controller.AddToManager(mgr); err != nil {
 		log.Error(err, "")
 		os.Exit(1)
 	}
 
+	// Setup all Webhooks
+	if enableWebhook {
+		if err := webhook.AddToManager(mgr); err != nil {
+			log.Error(err, "")
+			os.Exit(1)
+		}
+	}
+
 	log.Info("Starting the Cmd.")
 
 	// Start the Cmd
@@ -0,0 +1,10 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+//go:build go1.15
+// +build go1.15
+
+package main
+
+// the time zones of the maintenance windows are loaded from the embedded database when the image has no zoneinfo
+import _ "time/tzdata"
@@ -0,0 +1,138 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Command render prints the ManifestWorks the controller creates for a KlusterletAddonConfig, without any
+// connection to a cluster
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/ghodss/yaml"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/klusterletaddon"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+// options are the files & the kubernetes version the ManifestWorks are rendered with
+type options struct {
+	klusterletAddonConfigFile string
+	imageManifestFile         string
+	imagePullSecretFile       string
+	addonRegistryFile         string
+	kubeVersion               string
+}
+
+func main() {
+	o := options{}
+	flag.StringVar(&o.klusterletAddonConfigFile, "klusterletaddonconfig", "",
+		"The YAML file of the KlusterletAddonConfig to render.")
+	flag.StringVar(&o.imageManifestFile, "image-manifest", "",
+		"The YAML file of the image-manifest ConfigMap the images are resolved from.")
+	flag.StringVar(&o.imagePullSecretFile, "image-pull-secret", "",
+		"The YAML file of the image pull secret set in the KlusterletAddonConfig, if any.")
+	flag.StringVar(&o.addonRegistryFile, "addon-registry", "",
+		"The YAML file of the klusterlet-addon-registry ConfigMap, if any.")
+	flag.StringVar(&o.kubeVersion, "kube-version", "",
+		"The kubernetes version of the managed cluster, e.g. v1.20.0, the CRDs depend on it.")
+	flag.Parse()
+
+	// logs go to stderr so the ManifestWorks can be piped
+	ctrl.SetLogger(zap.New())
+
+	if err := render(o, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// render prints the ManifestWorks of the KlusterletAddonConfig as a YAML stream
+func render(o options, out io.Writer) error {
+	if o.klusterletAddonConfigFile == "" || o.imageManifestFile == "" {
+		return fmt.Errorf("--klusterletaddonconfig and --image-manifest are required")
+	}
+
+	klusterletAddonConfig := &agentv1.KlusterletAddonConfig{}
+	if err := readObject(o.klusterletAddonConfigFile, klusterletAddonConfig); err != nil {
+		return err
+	}
+
+	imageManifest := &corev1.ConfigMap{}
+	if err := readObject(o.imageManifestFile, imageManifest); err != nil {
+		return err
+	}
+	for key, value := range agentv1.ImageManifestConfigmapLabelSelector {
+		if imageManifest.Labels[key] != value {
+			return fmt.Errorf("%s is not an image manifest, label %s=%s is missing", o.imageManifestFile, key, value)
+		}
+	}
+	objs := []runtime.Object{imageManifest}
+
+	if o.imagePullSecretFile != "" {
+		imagePullSecret := &corev1.Secret{}
+		if err := readObject(o.imagePullSecretFile, imagePullSecret); err != nil {
+			return err
+		}
+		objs = append(objs, imagePullSecret)
+	}
+
+	var addonRegistry *corev1.ConfigMap
+	if o.addonRegistryFile != "" {
+		addonRegistry = &corev1.ConfigMap{}
+		if err := readObject(o.addonRegistryFile, addonRegistry); err != nil {
+			return err
+		}
+		addonRegistry.Name = registry.ConfigMapName
+		objs = append(objs, addonRegistry)
+	}
+
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, objs...)
+	if err := agentv1.LoadConfigmaps(c); err != nil {
+		return err
+	}
+	if addonRegistry != nil {
+		if err := registry.LoadConfig(c, addonRegistry.Namespace); err != nil {
+			return err
+		}
+		if err := registry.ValidateDependencies(); err != nil {
+			return err
+		}
+	}
+
+	manifestWorks, err := klusterletaddon.RenderManifestWorks(klusterletAddonConfig, o.kubeVersion, c)
+	if err != nil {
+		return err
+	}
+	for _, manifestWork := range manifestWorks {
+		data, err := yaml.Marshal(manifestWork)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readObject unmarshals the YAML file into the object
+func readObject(file string, obj interface{}) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("invalid YAML in %s: %w", file, err)
+	}
+	return nil
+}
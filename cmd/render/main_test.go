@@ -0,0 +1,136 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testKlusterletAddonConfig = `apiVersion: agent.open-cluster-management.io/v1
+kind: KlusterletAddonConfig
+metadata:
+  name: cluster1
+  namespace: cluster1
+spec:
+  clusterName: cluster1
+  clusterNamespace: cluster1
+  clusterLabels:
+    vendor: OpenShift
+  version: 2.3.0
+  searchCollector:
+    enabled: true
+`
+
+const testImageManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: image-manifest-2.3.0
+  namespace: open-cluster-management
+  labels:
+    ocm-configmap-type: image-manifest
+    ocm-release-version: 2.3.0
+data:
+  klusterlet_addon_operator: quay.io/open-cluster-management/klusterlet-addon-operator:2.3.0
+  multicloud_manager: quay.io/open-cluster-management/multicloud-manager:2.3.0
+  search_collector: quay.io/open-cluster-management/search-collector:2.3.0
+`
+
+func writeFile(t *testing.T, dir, name, data string) string {
+	file := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(file, []byte(data), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", file, err)
+	}
+	return file
+}
+
+func Test_render(t *testing.T) {
+	dir, err := ioutil.TempDir("", "render")
+	if err != nil {
+		t.Fatalf("failed to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	klusterletAddonConfigFile := writeFile(t, dir, "klusterletaddonconfig.yaml", testKlusterletAddonConfig)
+	imageManifestFile := writeFile(t, dir, "image-manifest.yaml", testImageManifest)
+	unlabeledImageManifestFile := writeFile(t, dir, "unlabeled-image-manifest.yaml",
+		strings.Replace(testImageManifest, "ocm-configmap-type: image-manifest", "other: label", 1))
+
+	tests := []struct {
+		name     string
+		options  options
+		env      map[string]string
+		wantErr  string
+		wantText []string
+	}{
+		{
+			name: "rendered ManifestWorks",
+			options: options{
+				klusterletAddonConfigFile: klusterletAddonConfigFile,
+				imageManifestFile:         imageManifestFile,
+				kubeVersion:               "v1.20.0",
+			},
+			wantText: []string{
+				"name: cluster1-klusterlet-addon-crds",
+				"name: cluster1-klusterlet-addon-operator",
+				"name: cluster1-klusterlet-addon-workmgr",
+				"name: cluster1-klusterlet-addon-search",
+				"image: quay.io/open-cluster-management/klusterlet-addon-operator:2.3.0",
+			},
+		},
+		{
+			name: "rendered ManifestWorks with the default image registry",
+			options: options{
+				klusterletAddonConfigFile: klusterletAddonConfigFile,
+				imageManifestFile:         imageManifestFile,
+				kubeVersion:               "v1.20.0",
+			},
+			env: map[string]string{"DEFAULT_IMAGE_REGISTRY": "registry.example.com/ocm"},
+			wantText: []string{
+				"image: registry.example.com/ocm/klusterlet-addon-operator:2.3.0",
+			},
+		},
+		{
+			name:    "missing files",
+			options: options{klusterletAddonConfigFile: klusterletAddonConfigFile},
+			wantErr: "--klusterletaddonconfig and --image-manifest are required",
+		},
+		{
+			name: "not an image manifest",
+			options: options{
+				klusterletAddonConfigFile: klusterletAddonConfigFile,
+				imageManifestFile:         unlabeledImageManifestFile,
+			},
+			wantErr: "is not an image manifest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				os.Setenv(key, value)
+				defer os.Unsetenv(key)
+			}
+			out := &bytes.Buffer{}
+			err := render(tt.options, out)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("render() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("render() error = %v", err)
+			}
+			for _, text := range tt.wantText {
+				if !strings.Contains(out.String(), text) {
+					t.Errorf("render() output does not contain %q:\n%s", text, out.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// KlusterletAddonConfigAnnotationRolloutVersion is the version of the image manifest a rollout upgraded the
+	// klusterletaddonconfig to, the images of its addons are resolved from this version instead of the version of
+	// the controller unless the klusterletaddonconfig is pinned to a version in its spec
+	KlusterletAddonConfigAnnotationRolloutVersion = "klusterletaddonconfig-rollout-version"
+	// KlusterletAddonConfigAnnotationRolloutTime is when the rollout upgraded the klusterletaddonconfig, in RFC3339
+	KlusterletAddonConfigAnnotationRolloutTime = "klusterletaddonconfig-rollout-time"
+)
+
+// AddonRolloutSpec defines the version the selected clusters are upgraded to, & how many clusters are upgraded at once
+type AddonRolloutSpec struct {
+	// Version is the version of the image manifest the addons of the selected clusters are upgraded to, e.g. 2.3.0
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// ClusterSelector selects the ManagedClusters by label, all ManagedClusters are selected when it is empty. The
+	// clusters whose klusterletaddonconfig is pinned to a version are skipped, and the selected clusters must not be
+	// selected by an older rollout
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// MaxUnavailable is the number or the percentage of the selected clusters which are upgraded at once, a cluster
+	// stays unavailable until its addons are available. It defaults to 1
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MinReadySeconds is how long after its upgrade the addons of a cluster must be available before the cluster is
+	// counted as available
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=60
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// Addons lists the ManagedClusterAddOns which must be available before the rollout advances, all enabled addons
+	// of a cluster are checked when it is empty
+	// +optional
+	Addons []string `json:"addons,omitempty"`
+}
+
+// phases of AddonRollout
+const (
+	RolloutPhaseProgressing = "Progressing"
+	RolloutPhaseCompleted   = "Completed"
+	RolloutPhaseHalted      = "Halted"
+)
+
+// AddonRolloutStatus defines the observed state of AddonRollout
+type AddonRolloutStatus struct {
+	// ObservedGeneration is the most recent generation of the spec that has been reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is one of Progressing, Completed or Halted. A rollout halts when the addon of an upgraded cluster is
+	// degraded, and resumes when its spec is changed, or when it selects the clusters of an older rollout
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Clusters is the number of selected clusters with a klusterletaddonconfig
+	// +optional
+	Clusters int32 `json:"clusters,omitempty"`
+
+	// UpdatedClusters is the number of selected clusters upgraded to the version
+	// +optional
+	UpdatedClusters int32 `json:"updatedClusters,omitempty"`
+
+	// AvailableClusters is the number of upgraded clusters whose addons are available
+	// +optional
+	AvailableClusters int32 `json:"availableClusters,omitempty"`
+
+	// ProgressingClusters lists the upgraded clusters whose addons are not available yet
+	// +optional
+	ProgressingClusters []string `json:"progressingClusters,omitempty"`
+
+	// DegradedClusters lists the upgraded clusters with a degraded addon
+	// +optional
+	DegradedClusters []string `json:"degradedClusters,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AddonRollout upgrades the addons of the selected clusters to a version in batches
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=addonrollouts,scope=Cluster
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Updated",type="integer",JSONPath=".status.updatedClusters"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableClusters"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type AddonRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AddonRolloutSpec   `json:"spec,omitempty"`
+	Status AddonRolloutStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AddonRolloutList contains a list of AddonRollout
+type AddonRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AddonRollout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AddonRollout{}, &AddonRolloutList{})
+}
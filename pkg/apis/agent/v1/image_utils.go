@@ -11,7 +11,10 @@ package v1
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sort"
+	"strings"
+	"sync"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,23 +42,48 @@ import (
 // }
 const ocmVersionLabel = "ocm-release-version"
 
+// addonOperatorImageKey is the image manifest key of the klusterlet addon operator
+const addonOperatorImageKey = "klusterlet_addon_operator"
+
+// ImageManifestConfigmapLabelSelector is the label set on configmaps which contain an image manifest
+var ImageManifestConfigmapLabelSelector = map[string]string{"ocm-configmap-type": "image-manifest"}
+
 //Manifest contains the manifest.
 //The Manifest is loaded using the LoadManifest method.
 
-var versionList []*semver.Version
-
 var log = logf.Log.WithName("image_utils")
 
 type manifest struct {
-	Images map[string]string
+	Version string
+	Images  map[string]string
+}
+
+// manifestStore is a thread-safe index of the loaded image manifests
+type manifestStore struct {
+	sync.RWMutex
+	manifests   map[string]manifest
+	versionList []*semver.Version
 }
 
-var manifests map[string]manifest
+var store = &manifestStore{}
+
+// replace swaps the whole index atomically, returns true if the manifests are changed
+func (s *manifestStore) replace(manifests map[string]manifest, versionList []*semver.Version) bool {
+	s.Lock()
+	defer s.Unlock()
+	changed := !reflect.DeepEqual(s.manifests, manifests)
+	s.manifests = manifests
+	s.versionList = versionList
+	return changed
+}
 
 // GetImage returns the image.Image,  for the specified component return error if information not found
 func (instance KlusterletAddonConfig) GetImage(component string) (imageRepository string, err error) {
+	if image := instance.getImageOverride(component); image != "" {
+		return image, nil
+	}
 
-	m, err := getManifest(version.Version)
+	m, err := getManifest(instance.GetImageVersion())
 	if err != nil {
 		return "", err
 	}
@@ -64,18 +92,87 @@ func (instance KlusterletAddonConfig) GetImage(component string) (imageRepositor
 		return "", fmt.Errorf("addon image not found")
 	}
 
-	return m.Images[component], nil
+	return rewriteImage(m.Images[component], instance.Spec.ImageRegistry, instance.Spec.ImageNamePostfix), nil
+}
+
+// GetImageManifestVersion returns the version of the loaded image manifest the images are resolved from
+func (instance KlusterletAddonConfig) GetImageManifestVersion() (string, error) {
+	m, err := getManifest(instance.GetImageVersion())
+	if err != nil {
+		return "", err
+	}
+	return m.Version, nil
+}
+
+// GetImageVersion returns the version of the image manifest the images are resolved from, it is the version pinned
+// in the spec, or the version a rollout upgraded the klusterletaddonconfig to, or the version of the controller
+func (instance KlusterletAddonConfig) GetImageVersion() string {
+	if v := instance.GetPinnedVersion(); v != "" {
+		return v
+	}
+	if v := instance.GetAnnotations()[KlusterletAddonConfigAnnotationRolloutVersion]; v != "" {
+		return v
+	}
+	return version.Version
+}
+
+// CheckImageManifest returns an error if no loaded image manifest matches the version
+func CheckImageManifest(version string) error {
+	_, err := getManifest(version)
+	return err
+}
+
+// getImageOverride returns the image pinned in the spec for the given image manifest key, or empty string if
+// the image is not pinned
+func (instance KlusterletAddonConfig) getImageOverride(component string) string {
+	if image := instance.Spec.ImageOverrides[component]; image != "" {
+		return image
+	}
+	if component == addonOperatorImageKey {
+		return instance.Spec.ComponentOperatorImage
+	}
+	return ""
+}
+
+// rewriteImage replaces the registry host & organization of the image with the given registry, and appends
+// the postfix to the image name. The tag and digest of the image are preserved.
+// e.g. quay.io/org/search-collector@sha256:abc with registry mirror.io/ocm and postfix -amd64 returns
+// mirror.io/ocm/search-collector-amd64@sha256:abc
+func rewriteImage(image, registry, postfix string) string {
+	if registry == "" && postfix == "" {
+		return image
+	}
+
+	name := image
+	suffix := ""
+	// split the digest
+	if idx := strings.Index(name, "@"); idx >= 0 {
+		name, suffix = name[:idx], name[idx:]
+	}
+	// split the tag, a colon before the last slash belongs to the registry port
+	if idx := strings.LastIndex(name, ":"); idx > strings.LastIndex(name, "/") {
+		name, suffix = name[:idx], name[idx:]+suffix
+	}
+
+	if registry = strings.TrimSuffix(registry, "/"); registry != "" {
+		name = registry + "/" + name[strings.LastIndex(name, "/")+1:]
+	}
+
+	return name + postfix + suffix
 }
 
 // getManifest returns the manifest that is best matching the required version
 // if no version can match (major version), will return error
 func getManifest(version string) (*manifest, error) {
-	if len(versionList) == 0 || manifests == nil {
+	store.RLock()
+	defer store.RUnlock()
+
+	if len(store.versionList) == 0 || store.manifests == nil {
 		return nil, fmt.Errorf("image manifest not loaded")
 	}
 
 	// find exact version first
-	if m, ok := manifests[version]; ok {
+	if m, ok := store.manifests[version]; ok {
 		return &m, nil
 	}
 	log.Error(fmt.Errorf("Failed to find image manifest in version %s", version), "version not found")
@@ -96,9 +193,9 @@ func getManifest(version string) (*manifest, error) {
 	}
 	// search for the first possible version
 	// (used linear because versionList is very short)
-	for _, v := range versionList {
+	for _, v := range store.versionList {
 		if isValid := versionConstraint.Check(v); isValid {
-			if m, ok := manifests[v.Original()]; ok {
+			if m, ok := store.manifests[v.Original()]; ok {
 				return &m, nil
 			}
 		}
@@ -108,15 +205,23 @@ func getManifest(version string) (*manifest, error) {
 }
 
 // LoadConfigmaps - loads pre-release image manifests
-func LoadConfigmaps(k8s client.Client) error {
-	manifests = make(map[string]manifest)
+func LoadConfigmaps(k8s client.Reader) error {
+	_, err := ReloadConfigmaps(k8s)
+	return err
+}
+
+// ReloadConfigmaps rebuilds the image manifests from all image-manifest configmaps and replaces
+// the loaded ones atomically. It returns true if the loaded manifests are changed.
+func ReloadConfigmaps(k8s client.Reader) (bool, error) {
 	configmapList := &corev1.ConfigMapList{}
 
-	err := k8s.List(context.TODO(), configmapList, client.MatchingLabels{"ocm-configmap-type": "image-manifest"})
+	err := k8s.List(context.TODO(), configmapList, client.MatchingLabels(ImageManifestConfigmapLabelSelector))
 	if err != nil {
-		return err
+		return false, err
 	}
 
+	manifests := make(map[string]manifest)
+	versionList := []*semver.Version{}
 	for _, cm := range configmapList.Items {
 		version := cm.Labels[ocmVersionLabel]
 		v, err := semver.NewVersion(version)
@@ -124,13 +229,15 @@ func LoadConfigmaps(k8s client.Client) error {
 			log.Error(err, "Invalid semantic version found in image-manifests")
 			continue
 		}
-		m := manifest{}
-		m.Images = make(map[string]string)
-		m.Images = cm.Data
+		m := manifest{Version: v.Original()}
+		m.Images = make(map[string]string, len(cm.Data))
+		for key, image := range cm.Data {
+			m.Images[key] = image
+		}
 		manifests[v.Original()] = m
 
 		versionList = append(versionList, v)
 	}
 	sort.Sort(semver.Collection(versionList))
-	return nil
+	return store.replace(manifests, versionList), nil
 }
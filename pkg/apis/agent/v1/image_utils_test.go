@@ -143,6 +143,7 @@ func TestGetImageWithManyConfigmapManifest(t *testing.T) {
 		Data: map[string]string{
 			"klusterlet_addon_operator": "sample-registry/uniquePath/klusterlet-addon-operator@sha256:fake-sha256-2-1-0",
 			"cert_policy_controller":    "sample-registry/uniquePath/cert-policy-controller@sha256:fake-sha256-2-1-0",
+			"search_collector":          "sample-registry/uniquePath/search-collector@sha256:fake-sha256-2-2-1",
 		},
 	}
 
@@ -218,6 +219,72 @@ func TestGetImageWithManyConfigmapManifest(t *testing.T) {
 			want:    GlobalValues{},
 			wantErr: true,
 		},
+		{
+			name: "Use Component Sha in the rollout version",
+			args: args{
+				klusterletaddonconfig: &KlusterletAddonConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{KlusterletAddonConfigAnnotationRolloutVersion: "2.2.1"},
+					},
+					Spec: KlusterletAddonConfigSpec{
+						ImageRegistry: "sample-registry/uniquePath",
+					},
+				},
+				component: "search_collector",
+			},
+			want: GlobalValues{
+				ImageOverrides: map[string]string{
+					"search_collector": "sample-registry/uniquePath/search-collector@sha256:fake-sha256-2-2-1",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Component not in the manifest of " + version.Version,
+			args: args{
+				klusterletaddonconfig: &KlusterletAddonConfig{},
+				component:             "search_collector",
+			},
+			want:    GlobalValues{},
+			wantErr: true,
+		},
+		{
+			name: "Use Component Sha in the pinned version over the rollout version",
+			args: args{
+				klusterletaddonconfig: &KlusterletAddonConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							KlusterletAddonConfigAnnotationRolloutVersion:  "9.0.0",
+							KlusterletAddonConfigAnnotationVersionMigrated: "true",
+						},
+					},
+					Spec: KlusterletAddonConfigSpec{
+						Version:       "2.2.0",
+						ImageRegistry: "sample-registry/uniquePath",
+					},
+				},
+				component: "search_collector",
+			},
+			want: GlobalValues{
+				ImageOverrides: map[string]string{
+					"search_collector": "sample-registry/uniquePath/search-collector@sha256:fake-sha256-2-2-1",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "No manifest for the rollout version",
+			args: args{
+				klusterletaddonconfig: &KlusterletAddonConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{KlusterletAddonConfigAnnotationRolloutVersion: "9.0.0"},
+					},
+				},
+				component: "cert_policy_controller",
+			},
+			want:    GlobalValues{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -231,4 +298,237 @@ func TestGetImageWithManyConfigmapManifest(t *testing.T) {
 			}
 		})
 	}
+
+	versionTests := []struct {
+		name    string
+		version string
+		legacy  bool
+		want    string
+		wantErr bool
+	}{
+		{name: "version of the controller", want: version.Version},
+		{name: "version not migrated yet is ignored", version: "2.2.1", legacy: true, want: version.Version},
+		{name: "exact version", version: "2.2.1", want: "2.2.1"},
+		{name: "oldest version of the same major version above it", version: "2.0.0", want: "2.2.1"},
+		{name: "no manifest of the major version", version: "3.0.0", wantErr: true},
+	}
+	for _, tt := range versionTests {
+		t.Run(tt.name, func(t *testing.T) {
+			klusterletaddonconfig := &KlusterletAddonConfig{Spec: KlusterletAddonConfigSpec{Version: tt.version}}
+			if !tt.legacy {
+				klusterletaddonconfig.SetAnnotations(map[string]string{
+					KlusterletAddonConfigAnnotationVersionMigrated: "true",
+				})
+			}
+			got, err := klusterletaddonconfig.GetImageManifestVersion()
+			if tt.wantErr != (err != nil) {
+				t.Errorf("GetImageManifestVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got, "resolved version should match")
+		})
+	}
+}
+
+func Test_rewriteImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    string
+		registry string
+		postfix  string
+		want     string
+	}{
+		{
+			name:  "no registry and no postfix",
+			image: "quay.io/open-cluster-management/search-collector@sha256:abc",
+			want:  "quay.io/open-cluster-management/search-collector@sha256:abc",
+		},
+		{
+			name:     "registry with digest",
+			image:    "quay.io/open-cluster-management/search-collector@sha256:abc",
+			registry: "mirror.example.com:5000/ocm/",
+			want:     "mirror.example.com:5000/ocm/search-collector@sha256:abc",
+		},
+		{
+			name:     "registry with tag",
+			image:    "quay.io/open-cluster-management/search-collector:2.3.0",
+			registry: "mirror.example.com/ocm",
+			want:     "mirror.example.com/ocm/search-collector:2.3.0",
+		},
+		{
+			name:    "postfix with tag and digest",
+			image:   "quay.io/open-cluster-management/search-collector:2.3.0@sha256:abc",
+			postfix: "-rhel8",
+			want:    "quay.io/open-cluster-management/search-collector-rhel8:2.3.0@sha256:abc",
+		},
+		{
+			name:     "registry with port and image without tag",
+			image:    "localhost:5000/search-collector",
+			registry: "mirror.example.com/ocm",
+			postfix:  "-rhel8",
+			want:     "mirror.example.com/ocm/search-collector-rhel8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rewriteImage(tt.image, tt.registry, tt.postfix))
+		})
+	}
+}
+
+func TestGetImageWithRegistryAndPostfix(t *testing.T) {
+	testConfigMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				"ocm-configmap-type":  "image-manifest",
+				"ocm-release-version": version.Version,
+			},
+		},
+		Data: map[string]string{
+			"search_collector": "quay.io/open-cluster-management/search-collector@sha256:fake-sha256",
+		},
+	}
+
+	if err := LoadConfigmaps(fake.NewFakeClient(testConfigMap)); err != nil {
+		t.Fatalf("failed to load configmaps: %v", err)
+	}
+
+	instance := &KlusterletAddonConfig{
+		Spec: KlusterletAddonConfigSpec{
+			ImageRegistry:    "mirror.example.com/ocm",
+			ImageNamePostfix: "-postfix",
+		},
+	}
+	image, err := instance.GetImage("search_collector")
+	assert.NoError(t, err)
+	assert.Equal(t, "mirror.example.com/ocm/search-collector-postfix@sha256:fake-sha256", image)
+}
+
+func TestGetImageWithOverrides(t *testing.T) {
+	testConfigMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				"ocm-configmap-type":  "image-manifest",
+				"ocm-release-version": version.Version,
+			},
+		},
+		Data: map[string]string{
+			"klusterlet_addon_operator": "quay.io/open-cluster-management/klusterlet-addon-operator@sha256:fake-sha256",
+			"search_collector":          "quay.io/open-cluster-management/search-collector@sha256:fake-sha256",
+		},
+	}
+
+	if err := LoadConfigmaps(fake.NewFakeClient(testConfigMap)); err != nil {
+		t.Fatalf("failed to load configmaps: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		spec      KlusterletAddonConfigSpec
+		component string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "override is used as is",
+			spec: KlusterletAddonConfigSpec{
+				ImageRegistry: "mirror.example.com/ocm",
+				ImageOverrides: map[string]string{
+					"search_collector": "registry.example.com/dev/search-collector:latest",
+				},
+			},
+			component: "search_collector",
+			want:      "registry.example.com/dev/search-collector:latest",
+		},
+		{
+			name: "components without override use the manifest",
+			spec: KlusterletAddonConfigSpec{
+				ImageOverrides: map[string]string{
+					"search_collector": "registry.example.com/dev/search-collector:latest",
+				},
+			},
+			component: "klusterlet_addon_operator",
+			want:      "quay.io/open-cluster-management/klusterlet-addon-operator@sha256:fake-sha256",
+		},
+		{
+			name: "override of a component not in the manifest",
+			spec: KlusterletAddonConfigSpec{
+				ImageOverrides: map[string]string{
+					"fake_component": "registry.example.com/dev/fake:latest",
+				},
+			},
+			component: "fake_component",
+			want:      "registry.example.com/dev/fake:latest",
+		},
+		{
+			name: "componentOperatorImage still overrides the operator",
+			spec: KlusterletAddonConfigSpec{
+				ComponentOperatorImage: "registry.example.com/dev/klusterlet-addon-operator:latest",
+			},
+			component: "klusterlet_addon_operator",
+			want:      "registry.example.com/dev/klusterlet-addon-operator:latest",
+		},
+		{
+			name: "imageOverrides takes precedence over componentOperatorImage",
+			spec: KlusterletAddonConfigSpec{
+				ComponentOperatorImage: "registry.example.com/dev/klusterlet-addon-operator:latest",
+				ImageOverrides: map[string]string{
+					"klusterlet_addon_operator": "registry.example.com/dev/klusterlet-addon-operator:pinned",
+				},
+			},
+			component: "klusterlet_addon_operator",
+			want:      "registry.example.com/dev/klusterlet-addon-operator:pinned",
+		},
+		{
+			name: "componentOperatorImage is not rewritten",
+			spec: KlusterletAddonConfigSpec{
+				ImageRegistry:          "mirror.example.com/ocm",
+				ImageNamePostfix:       "-rhel8",
+				ComponentOperatorImage: "registry.example.com/dev/klusterlet-addon-operator:latest",
+			},
+			component: "klusterlet_addon_operator",
+			want:      "registry.example.com/dev/klusterlet-addon-operator:latest",
+		},
+		{
+			name: "only the components without override are rewritten",
+			spec: KlusterletAddonConfigSpec{
+				ImageRegistry:    "mirror.example.com/ocm",
+				ImageNamePostfix: "-rhel8",
+				ImageOverrides: map[string]string{
+					"search_collector": "registry.example.com/dev/search-collector:latest",
+				},
+			},
+			component: "klusterlet_addon_operator",
+			want:      "mirror.example.com/ocm/klusterlet-addon-operator-rhel8@sha256:fake-sha256",
+		},
+		{
+			name:      "empty override is ignored",
+			spec:      KlusterletAddonConfigSpec{ImageOverrides: map[string]string{"fake_component": ""}},
+			component: "fake_component",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &KlusterletAddonConfig{Spec: tt.spec}
+			image, err := instance.GetImage(tt.component)
+			if tt.wantErr != (err != nil) {
+				t.Errorf("GetImage() error = %v, wantErr %v", err, tt.wantErr)
+			} else if !tt.wantErr {
+				assert.Equal(t, tt.want, image)
+			}
+		})
+	}
 }
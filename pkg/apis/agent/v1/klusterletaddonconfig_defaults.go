@@ -0,0 +1,74 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package v1
+
+import (
+	"os"
+)
+
+// environment variables of the controller holding the defaults of klusterletaddonconfigs
+const (
+	DefaultImagePullSecretEnv = "DEFAULT_IMAGE_PULL_SECRET"
+	DefaultImageRegistryEnv   = "DEFAULT_IMAGE_REGISTRY"
+	DefaultHTTPProxyEnv       = "DEFAULT_HTTP_PROXY"
+	DefaultHTTPSProxyEnv      = "DEFAULT_HTTPS_PROXY"
+	DefaultNoProxyEnv         = "DEFAULT_NO_PROXY"
+)
+
+// Default fills the empty imagePullSecret, imageRegistry & proxyConfig with the defaults of the controller.
+// Defaults are recorded in the spec, so they only change when the klusterletaddonconfig is edited
+func (instance *KlusterletAddonConfig) Default() {
+	if instance.Spec.ImagePullSecret == "" {
+		instance.Spec.ImagePullSecret = os.Getenv(DefaultImagePullSecretEnv)
+	}
+	if instance.Spec.ImageRegistry == "" {
+		instance.Spec.ImageRegistry = os.Getenv(DefaultImageRegistryEnv)
+	}
+	if instance.Spec.ProxyConfig == nil {
+		proxy := ProxyConfig{
+			HTTPProxy:  os.Getenv(DefaultHTTPProxyEnv),
+			HTTPSProxy: os.Getenv(DefaultHTTPSProxyEnv),
+			NoProxy:    os.Getenv(DefaultNoProxyEnv),
+		}
+		if proxy != (ProxyConfig{}) {
+			instance.Spec.ProxyConfig = &proxy
+		}
+	}
+}
+
+// KlusterletAddonConfigAnnotationVersionMigrated marks the klusterletaddonconfigs whose spec.version is honored. Older
+// controllers ignored spec.version, so it is cleared once from the klusterletaddonconfigs created before instead of
+// pinning them to a version which was never validated
+const KlusterletAddonConfigAnnotationVersionMigrated = "klusterletaddonconfig-version-migrated"
+
+// IsVersionMigrated returns true if the spec.version of the klusterletaddonconfig is honored
+func (instance KlusterletAddonConfig) IsVersionMigrated() bool {
+	return instance.GetAnnotations()[KlusterletAddonConfigAnnotationVersionMigrated] == "true"
+}
+
+// GetPinnedVersion returns the version the klusterletaddonconfig is pinned to, or an empty string if it is not
+// pinned or its spec.version is not migrated yet
+func (instance KlusterletAddonConfig) GetPinnedVersion() string {
+	if !instance.IsVersionMigrated() {
+		return ""
+	}
+	return instance.Spec.Version
+}
+
+// MigrateVersion clears the spec.version set before it was honored and marks the klusterletaddonconfig as migrated.
+// It returns the cleared version, or an empty string if there was none
+func (instance *KlusterletAddonConfig) MigrateVersion() string {
+	if instance.IsVersionMigrated() {
+		return ""
+	}
+	annotations := instance.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[KlusterletAddonConfigAnnotationVersionMigrated] = "true"
+	instance.SetAnnotations(annotations)
+	legacyVersion := instance.Spec.Version
+	instance.Spec.Version = ""
+	return legacyVersion
+}
@@ -22,6 +22,10 @@ type KlusterletAddonConfigSpec struct {
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
 
+	// Version pins the version of the image manifest the images of the addons are resolved from, e.g. 2.2.0. The
+	// manifest of the version is used, or the oldest loaded manifest of the same major version above it. The version
+	// of the controller is used when it is empty, unless a rollout upgraded the klusterletaddonconfig. A version set
+	// before it was honored is cleared once by the controller
 	// +optional
 	Version string `json:"version"`
 
@@ -39,21 +43,70 @@ type KlusterletAddonConfigSpec struct {
 	CertPolicyControllerConfig KlusterletAddonConfigCertPolicyControllerSpec `json:"certPolicyController"`
 	IAMPolicyControllerConfig  KlusterletAddonConfigIAMPolicyControllerSpec  `json:"iamPolicyController"`
 
-	ImageRegistry    string `json:"imageRegistry,omitempty"`
+	// WorkManagerConfig defines the scheduling & resources of work-manager, which is always enabled
+	// +optional
+	WorkManagerConfig *KlusterletAddonAgentSpec `json:"workManager,omitempty"`
+
+	// AddonOperatorConfig defines the scheduling & resources of the klusterlet addon operator
+	// +optional
+	AddonOperatorConfig *KlusterletAddonAgentSpec `json:"addonOperator,omitempty"`
+
+	// CustomAddons enables the addons rendered from a template of the addon registry, keyed by addon name
+	// +optional
+	CustomAddons map[string]KlusterletAddonConfigCustomAddonSpec `json:"customAddons,omitempty"`
+
+	// ImageRegistry replaces the registry host & organization of every addon image of the image manifest,
+	// e.g. mirror.io/ocm. The images pinned by imageOverrides or componentOperatorImage are used as is.
+	// It defaults to DEFAULT_IMAGE_REGISTRY of the controller on creation
+	ImageRegistry string `json:"imageRegistry,omitempty"`
+	// ImageNamePostfix is appended to the name of every addon image of the image manifest
 	ImageNamePostfix string `json:"imageNamePostfix,omitempty"`
+	// ImagePullSecret is the secret used to pull addon images,
+	// it defaults to DEFAULT_IMAGE_PULL_SECRET of the controller on creation
 	// +kubebuilder:validation:MinLength=1
 	ImagePullSecret string `json:"imagePullSecret,omitempty"`
 
 	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
 	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
 
-	// // ComponentTagMap contains the tag of each component
-	// ComponentTagMap map[string]string `json:"componentTagMap"`
-	// // ComponentImageMap contains the image name of each component
-	// ComponentImageMap map[string]string `json:"componentImageMap"`
+	// ImageOverrides pins images by their image manifest key, e.g. search_collector or config_policy_controller.
+	// An image set here is used as is and takes precedence over the image manifest.
+	// +optional
+	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
 
-	// used for dev work only
+	// used for dev work only, same as setting klusterlet_addon_operator in ImageOverrides
 	ComponentOperatorImage string `json:"componentOperatorImage,omitempty"`
+
+	// ProxyConfig is the HTTP/HTTPS proxy used by the addons connecting to the hub to reach it.
+	// It defaults to DEFAULT_HTTP_PROXY, DEFAULT_HTTPS_PROXY & DEFAULT_NO_PROXY of the controller on creation,
+	// set it to {} to reach the hub without proxy
+	// +optional
+	ProxyConfig *ProxyConfig `json:"proxyConfig,omitempty"`
+
+	// PausedAddons lists the addons, by addon or ManagedClusterAddOn name, whose ManifestWorks are not updated,
+	// e.g. search-collector. The ManifestWorks of the other addons are still updated
+	// +optional
+	PausedAddons []string `json:"pausedAddons,omitempty"`
+
+	// MaintenanceWindows are the recurring windows in which the ManifestWorks of the klusterlet addon operator &
+	// of the addons are updated, the updates are deferred out of them. The updates are applied at any time when empty
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring window in which the ManifestWorks are updated
+type MaintenanceWindow struct {
+	// Schedule is the start of the window in cron format: minute hour day-of-month month day-of-week,
+	// e.g. "0 2 * * 6" starts the window on Saturdays at 02:00
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window lasts, e.g. 4h
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone of the schedule, e.g. Europe/Paris. It defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // KlusterletAddonConfigApplicationManagerSpec defines configuration for the ApplicationManager component
@@ -62,31 +115,80 @@ type KlusterletAddonConfigApplicationManagerSpec struct {
 
 	// +optional
 	ArgoCDCluster bool `json:"argocdCluster"`
+
+	KlusterletAddonAgentSpec `json:",inline"`
 }
 
 // KlusterletAddonConfigSearchCollectorSpec defines configuration for the SearchCollector component
 type KlusterletAddonConfigSearchCollectorSpec struct {
 	Enabled bool `json:"enabled"`
+
+	KlusterletAddonAgentSpec `json:",inline"`
 }
 
 // KlusterletAddonConfigCertPolicyControllerSpec defines configuration for the CertPolicyController component
 type KlusterletAddonConfigCertPolicyControllerSpec struct {
 	Enabled bool `json:"enabled"`
+
+	KlusterletAddonAgentSpec `json:",inline"`
 }
 
 // KlusterletAddonConfigIAMPolicyControllerSpec defines configuration for the IAMPolicyController component
 type KlusterletAddonConfigIAMPolicyControllerSpec struct {
 	Enabled bool `json:"enabled"`
+
+	KlusterletAddonAgentSpec `json:",inline"`
 }
 
-// KlusterletAddonConfigWorkManagerSpec defines configuration for the WorkManager component
-type KlusterletAddonConfigWorkManagerSpec struct {
-	ClusterLabels map[string]string `json:"clusterLabels"`
+// KlusterletAddonConfigCustomAddonSpec defines configuration for an addon rendered from a template
+type KlusterletAddonConfigCustomAddonSpec struct {
+	Enabled bool `json:"enabled"`
+
+	// Values are passed as .Values to the template of the addon
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// KlusterletAddonAgentSpec defines the scheduling & the compute resources of the pods of an addon
+type KlusterletAddonAgentSpec struct {
+	// NodePlacement defines the nodes the pods are scheduled on
+	// +optional
+	NodePlacement *NodePlacement `json:"nodePlacement,omitempty"`
+
+	// Resources are the compute resources of the containers
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ProxyConfig defines the HTTP/HTTPS proxy of the addons
+type ProxyConfig struct {
+	// HTTPProxy is the URL of the proxy for HTTP requests
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy for HTTPS requests
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hostnames, domains & CIDRs which are not proxied
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// NodePlacement defines the nodeSelector & tolerations of pods
+type NodePlacement struct {
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 }
 
 // KlusterletAddonConfigPolicyControllerSpec defines configuration for the PolicyController component
 type KlusterletAddonConfigPolicyControllerSpec struct {
 	Enabled bool `json:"enabled"`
+
+	KlusterletAddonAgentSpec `json:",inline"`
 }
 
 // KlusterletPrometheusIntegrationSpec defines configuration for the Promtheus Integration
@@ -94,11 +196,130 @@ type KlusterletPrometheusIntegrationSpec struct {
 	Enabled bool `json:"enabled"`
 }
 
+// condition types of KlusterletAddonConfig
+const (
+	// KlusterletAddonConfigCRDsApplied means the ManifestWork of the addon CRDs is applied on the managed cluster
+	KlusterletAddonConfigCRDsApplied = "CRDsApplied"
+	// KlusterletAddonConfigOperatorApplied means the ManifestWork of the klusterlet addon operator is applied
+	// on the managed cluster
+	KlusterletAddonConfigOperatorApplied = "OperatorApplied"
+	// KlusterletAddonConfigAddonsReady means all enabled addons are available on the managed cluster
+	KlusterletAddonConfigAddonsReady = "AddonsReady"
+	// KlusterletAddonConfigDependenciesSatisfied means no enabled addon waits for its dependencies to be installed
+	KlusterletAddonConfigDependenciesSatisfied = "DependenciesSatisfied"
+	// KlusterletAddonConfigDeferred means the updates of some ManifestWorks are deferred because their addon is
+	// paused or because of the maintenance windows
+	KlusterletAddonConfigDeferred = "Deferred"
+)
+
+// phases of an addon in KlusterletAddonConfigStatus
+const (
+	AddonPhaseProgressing = "Progressing"
+	AddonPhaseAvailable   = "Available"
+	AddonPhaseDegraded    = "Degraded"
+)
+
 // KlusterletAddonConfigStatus defines the observed state of KlusterletAddonConfig
 type KlusterletAddonConfigStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
-	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
+	// ObservedGeneration is the most recent generation of the spec that has been reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions contains the state of the CRDs, the klusterlet addon operator and the addons
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// AddOnStatus contains the state of each enabled addon, keyed by the ManagedClusterAddOn name
+	// +optional
+	AddOnStatus map[string]KlusterletAddonStatus `json:"addOnStatus,omitempty"`
+
+	// ResolvedVersion is the version of the loaded image manifest the images of the addons are resolved from
+	// +optional
+	ResolvedVersion string `json:"resolvedVersion,omitempty"`
+
+	// PendingChanges lists the changes of the ManifestWorks which are not applied in dry-run mode,
+	// it is empty when the changes are applied
+	// +optional
+	PendingChanges []ManifestWorkChange `json:"pendingChanges,omitempty"`
+}
+
+// KlusterletAddonStatus defines the observed state of a single addon
+type KlusterletAddonStatus struct {
+	// Phase is one of Progressing, Available or Degraded
+	Phase string `json:"phase"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FailedManifests lists the manifests of the addon which failed to apply on the managed cluster,
+	// it is truncated when many manifests fail
+	// +optional
+	FailedManifests []ManifestFailure `json:"failedManifests,omitempty"`
+}
+
+// ManifestFailure is a manifest of a ManifestWork which failed to apply, with the reason of the failure
+type ManifestFailure struct {
+	// +optional
+	Group string `json:"group,omitempty"`
+	// +optional
+	Version string `json:"version,omitempty"`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Message is the message of the Applied condition of the manifest
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// operations of the changes of ManifestWorks & of their manifests
+const (
+	ChangeOperationCreate = "Create"
+	ChangeOperationUpdate = "Update"
+	ChangeOperationDelete = "Delete"
+)
+
+// ManifestWorkChange is a change of a ManifestWork the controller would apply without the dry-run mode
+type ManifestWorkChange struct {
+	// Name is the name of the ManifestWork
+	Name string `json:"name"`
+	// Operation is one of Create, Update or Delete
+	Operation string `json:"operation"`
+	// Manifests lists the changes of the manifests of the ManifestWork, it is empty when the ManifestWork is deleted
+	// +optional
+	Manifests []ManifestChange `json:"manifests,omitempty"`
+}
+
+// ManifestChange is a change of a manifest of a ManifestWork
+type ManifestChange struct {
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Operation is one of Create, Update or Delete
+	Operation string `json:"operation"`
+	// Fields lists the changed fields of an updated manifest, it is truncated when many fields change
+	// +optional
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is a changed field of a manifest, the values are truncated when they are long and the values of the
+// data of a Secret are redacted
+type FieldChange struct {
+	// Path is the path of the field in the manifest, e.g. spec.global.imageOverrides.search_collector
+	Path string `json:"path"`
+	// Old is the live value of the field, it is empty when the field is added
+	// +optional
+	Old string `json:"old,omitempty"`
+	// New is the desired value of the field, it is empty when the field is removed
+	// +optional
+	New string `json:"new,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -106,6 +327,9 @@ type KlusterletAddonConfigStatus struct {
 // KlusterletAddonConfig is the Schema for the klusterletaddonconfigs API
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=klusterletaddonconfigs,scope=Namespaced
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"AddonsReady\")].status"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.resolvedVersion"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type KlusterletAddonConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
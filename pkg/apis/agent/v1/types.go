@@ -18,4 +18,54 @@ type GlobalValues struct {
 	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
 	ImagePullSecret string            `json:"imagePullSecret,omitempty"`
 	ImageOverrides  map[string]string `json:"imageOverrides,omitempty"`
+
+	NodeSelector map[string]string            `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration          `json:"tolerations,omitempty"`
+	Resources    *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ProxyConfig holds the HTTP_PROXY, HTTPS_PROXY & NO_PROXY env vars of the addon containers
+	ProxyConfig map[string]string `json:"proxyConfig,omitempty"`
+}
+
+// SetAgentValues sets the node placement & compute resources of the pods of an addon
+func (gv *GlobalValues) SetAgentValues(agent *KlusterletAddonAgentSpec) {
+	if agent == nil {
+		return
+	}
+	agent = agent.DeepCopy()
+	if agent.NodePlacement != nil {
+		gv.NodeSelector = agent.NodePlacement.NodeSelector
+		gv.Tolerations = agent.NodePlacement.Tolerations
+	}
+	gv.Resources = agent.Resources
+}
+
+// SetProxyValues sets the proxy env vars of the containers of an addon
+func (gv *GlobalValues) SetProxyValues(proxy *ProxyConfig) {
+	envVars := proxy.EnvVars()
+	if len(envVars) == 0 {
+		return
+	}
+	gv.ProxyConfig = make(map[string]string, len(envVars))
+	for _, envVar := range envVars {
+		gv.ProxyConfig[envVar.Name] = envVar.Value
+	}
+}
+
+// EnvVars returns the HTTP_PROXY, HTTPS_PROXY & NO_PROXY env vars of the proxy, unset ones are skipped
+func (proxy *ProxyConfig) EnvVars() []corev1.EnvVar {
+	if proxy == nil {
+		return nil
+	}
+	var envVars []corev1.EnvVar
+	for _, envVar := range []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: proxy.HTTPProxy},
+		{Name: "HTTPS_PROXY", Value: proxy.HTTPSProxy},
+		{Name: "NO_PROXY", Value: proxy.NoProxy},
+	} {
+		if envVar.Value != "" {
+			envVars = append(envVars, envVar)
+		}
+	}
+	return envVars
 }
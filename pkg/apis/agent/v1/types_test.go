@@ -0,0 +1,106 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestSetAgentValues(t *testing.T) {
+	resources := &corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("512Mi"),
+		},
+	}
+	tolerations := []corev1.Toleration{
+		{
+			Key:      "node-role.kubernetes.io/infra",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		agent *KlusterletAddonAgentSpec
+		want  GlobalValues
+	}{
+		{
+			name:  "nil agent",
+			agent: nil,
+			want:  GlobalValues{ImagePullSecret: "pull-secret"},
+		},
+		{
+			name:  "empty agent",
+			agent: &KlusterletAddonAgentSpec{},
+			want:  GlobalValues{ImagePullSecret: "pull-secret"},
+		},
+		{
+			name: "node placement & resources",
+			agent: &KlusterletAddonAgentSpec{
+				NodePlacement: &NodePlacement{
+					NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+					Tolerations:  tolerations,
+				},
+				Resources: resources,
+			},
+			want: GlobalValues{
+				ImagePullSecret: "pull-secret",
+				NodeSelector:    map[string]string{"node-role.kubernetes.io/infra": ""},
+				Tolerations:     tolerations,
+				Resources:       resources,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GlobalValues{ImagePullSecret: "pull-secret"}
+			gv.SetAgentValues(tt.agent)
+			assert.Equal(t, tt.want, gv)
+		})
+	}
+}
+
+func TestSetProxyValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		proxy *ProxyConfig
+		want  map[string]string
+	}{
+		{
+			name:  "no proxy",
+			proxy: nil,
+			want:  nil,
+		},
+		{
+			name:  "proxy disabled",
+			proxy: &ProxyConfig{},
+			want:  nil,
+		},
+		{
+			name: "https proxy only",
+			proxy: &ProxyConfig{
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    ".cluster.local,10.0.0.0/16",
+			},
+			want: map[string]string{
+				"HTTPS_PROXY": "http://proxy.example.com:3128",
+				"NO_PROXY":    ".cluster.local,10.0.0.0/16",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GlobalValues{}
+			gv.SetProxyValues(tt.proxy)
+			assert.Equal(t, tt.want, gv.ProxyConfig)
+		})
+	}
+}
@@ -11,9 +11,126 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonRollout) DeepCopyInto(out *AddonRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonRollout.
+func (in *AddonRollout) DeepCopy() *AddonRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AddonRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonRolloutList) DeepCopyInto(out *AddonRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AddonRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonRolloutList.
+func (in *AddonRolloutList) DeepCopy() *AddonRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AddonRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonRolloutSpec) DeepCopyInto(out *AddonRolloutSpec) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonRolloutSpec.
+func (in *AddonRolloutSpec) DeepCopy() *AddonRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonRolloutStatus) DeepCopyInto(out *AddonRolloutStatus) {
+	*out = *in
+	if in.ProgressingClusters != nil {
+		in, out := &in.ProgressingClusters, &out.ProgressingClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DegradedClusters != nil {
+		in, out := &in.DegradedClusters, &out.DegradedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonRolloutStatus.
+func (in *AddonRolloutStatus) DeepCopy() *AddonRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationManager) DeepCopyInto(out *ApplicationManager) {
 	*out = *in
@@ -194,6 +311,22 @@ func (in *CertPolicyControllerStatus) DeepCopy() *CertPolicyControllerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldChange) DeepCopyInto(out *FieldChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldChange.
+func (in *FieldChange) DeepCopy() *FieldChange {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalValues) DeepCopyInto(out *GlobalValues) {
 	*out = *in
@@ -204,6 +337,32 @@ func (in *GlobalValues) DeepCopyInto(out *GlobalValues) {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyConfig != nil {
+		in, out := &in.ProxyConfig, &out.ProxyConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalValues.
@@ -306,13 +465,38 @@ func (in *IAMPolicyControllerStatus) DeepCopy() *IAMPolicyControllerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KlusterletAddonAgentSpec) DeepCopyInto(out *KlusterletAddonAgentSpec) {
+	*out = *in
+	if in.NodePlacement != nil {
+		in, out := &in.NodePlacement, &out.NodePlacement
+		*out = new(NodePlacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonAgentSpec.
+func (in *KlusterletAddonAgentSpec) DeepCopy() *KlusterletAddonAgentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KlusterletAddonAgentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfig) DeepCopyInto(out *KlusterletAddonConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfig.
@@ -336,6 +520,7 @@ func (in *KlusterletAddonConfig) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfigApplicationManagerSpec) DeepCopyInto(out *KlusterletAddonConfigApplicationManagerSpec) {
 	*out = *in
+	in.KlusterletAddonAgentSpec.DeepCopyInto(&out.KlusterletAddonAgentSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigApplicationManagerSpec.
@@ -351,6 +536,7 @@ func (in *KlusterletAddonConfigApplicationManagerSpec) DeepCopy() *KlusterletAdd
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfigCertPolicyControllerSpec) DeepCopyInto(out *KlusterletAddonConfigCertPolicyControllerSpec) {
 	*out = *in
+	in.KlusterletAddonAgentSpec.DeepCopyInto(&out.KlusterletAddonAgentSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigCertPolicyControllerSpec.
@@ -363,9 +549,33 @@ func (in *KlusterletAddonConfigCertPolicyControllerSpec) DeepCopy() *KlusterletA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KlusterletAddonConfigCustomAddonSpec) DeepCopyInto(out *KlusterletAddonConfigCustomAddonSpec) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigCustomAddonSpec.
+func (in *KlusterletAddonConfigCustomAddonSpec) DeepCopy() *KlusterletAddonConfigCustomAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KlusterletAddonConfigCustomAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfigIAMPolicyControllerSpec) DeepCopyInto(out *KlusterletAddonConfigIAMPolicyControllerSpec) {
 	*out = *in
+	in.KlusterletAddonAgentSpec.DeepCopyInto(&out.KlusterletAddonAgentSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigIAMPolicyControllerSpec.
@@ -413,6 +623,7 @@ func (in *KlusterletAddonConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfigPolicyControllerSpec) DeepCopyInto(out *KlusterletAddonConfigPolicyControllerSpec) {
 	*out = *in
+	in.KlusterletAddonAgentSpec.DeepCopyInto(&out.KlusterletAddonAgentSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigPolicyControllerSpec.
@@ -428,6 +639,7 @@ func (in *KlusterletAddonConfigPolicyControllerSpec) DeepCopy() *KlusterletAddon
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfigSearchCollectorSpec) DeepCopyInto(out *KlusterletAddonConfigSearchCollectorSpec) {
 	*out = *in
+	in.KlusterletAddonAgentSpec.DeepCopyInto(&out.KlusterletAddonAgentSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigSearchCollectorSpec.
@@ -450,11 +662,50 @@ func (in *KlusterletAddonConfigSpec) DeepCopyInto(out *KlusterletAddonConfigSpec
 			(*out)[key] = val
 		}
 	}
-	out.SearchCollectorConfig = in.SearchCollectorConfig
-	out.PolicyController = in.PolicyController
-	out.ApplicationManagerConfig = in.ApplicationManagerConfig
-	out.CertPolicyControllerConfig = in.CertPolicyControllerConfig
-	out.IAMPolicyControllerConfig = in.IAMPolicyControllerConfig
+	in.SearchCollectorConfig.DeepCopyInto(&out.SearchCollectorConfig)
+	in.PolicyController.DeepCopyInto(&out.PolicyController)
+	in.ApplicationManagerConfig.DeepCopyInto(&out.ApplicationManagerConfig)
+	in.CertPolicyControllerConfig.DeepCopyInto(&out.CertPolicyControllerConfig)
+	in.IAMPolicyControllerConfig.DeepCopyInto(&out.IAMPolicyControllerConfig)
+	if in.WorkManagerConfig != nil {
+		in, out := &in.WorkManagerConfig, &out.WorkManagerConfig
+		*out = new(KlusterletAddonAgentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddonOperatorConfig != nil {
+		in, out := &in.AddonOperatorConfig, &out.AddonOperatorConfig
+		*out = new(KlusterletAddonAgentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomAddons != nil {
+		in, out := &in.CustomAddons, &out.CustomAddons
+		*out = make(map[string]KlusterletAddonConfigCustomAddonSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ImageOverrides != nil {
+		in, out := &in.ImageOverrides, &out.ImageOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ProxyConfig != nil {
+		in, out := &in.ProxyConfig, &out.ProxyConfig
+		*out = new(ProxyConfig)
+		**out = **in
+	}
+	if in.PausedAddons != nil {
+		in, out := &in.PausedAddons, &out.PausedAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigSpec.
@@ -470,6 +721,27 @@ func (in *KlusterletAddonConfigSpec) DeepCopy() *KlusterletAddonConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KlusterletAddonConfigStatus) DeepCopyInto(out *KlusterletAddonConfigStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AddOnStatus != nil {
+		in, out := &in.AddOnStatus, &out.AddOnStatus
+		*out = make(map[string]KlusterletAddonStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = make([]ManifestWorkChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigStatus.
@@ -483,23 +755,21 @@ func (in *KlusterletAddonConfigStatus) DeepCopy() *KlusterletAddonConfigStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KlusterletAddonConfigWorkManagerSpec) DeepCopyInto(out *KlusterletAddonConfigWorkManagerSpec) {
+func (in *KlusterletAddonStatus) DeepCopyInto(out *KlusterletAddonStatus) {
 	*out = *in
-	if in.ClusterLabels != nil {
-		in, out := &in.ClusterLabels, &out.ClusterLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.FailedManifests != nil {
+		in, out := &in.FailedManifests, &out.FailedManifests
+		*out = make([]ManifestFailure, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonConfigWorkManagerSpec.
-func (in *KlusterletAddonConfigWorkManagerSpec) DeepCopy() *KlusterletAddonConfigWorkManagerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KlusterletAddonStatus.
+func (in *KlusterletAddonStatus) DeepCopy() *KlusterletAddonStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KlusterletAddonConfigWorkManagerSpec)
+	out := new(KlusterletAddonStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -519,6 +789,111 @@ func (in *KlusterletPrometheusIntegrationSpec) DeepCopy() *KlusterletPrometheusI
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestChange) DeepCopyInto(out *ManifestChange) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestChange.
+func (in *ManifestChange) DeepCopy() *ManifestChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestFailure) DeepCopyInto(out *ManifestFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestFailure.
+func (in *ManifestFailure) DeepCopy() *ManifestFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestWorkChange) DeepCopyInto(out *ManifestWorkChange) {
+	*out = *in
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]ManifestChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestWorkChange.
+func (in *ManifestWorkChange) DeepCopy() *ManifestWorkChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestWorkChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePlacement) DeepCopyInto(out *NodePlacement) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePlacement.
+func (in *NodePlacement) DeepCopy() *NodePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyController) DeepCopyInto(out *PolicyController) {
 	*out = *in
@@ -609,6 +984,21 @@ func (in *PolicyControllerStatus) DeepCopy() *PolicyControllerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SearchCollector) DeepCopyInto(out *SearchCollector) {
 	*out = *in
@@ -98,7 +98,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _crdsAgentOpenClusterManagementIo_applicationmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\x90\x43\x2e\xb5\x8d\x45\x2f\x85\x6e\x0b\xb7\x28\x16\x49\x1a\x23\x2e\xf6\x4e\x91\x63\x69\x1a\x8a\x64\xc9\xa1\x5b\xb7\xe8\x7f\x2f\x86\x94\x3f\xe4\xca\xbb\x0d\x90\xe8\xa6\x99\xe1\xe3\x9b\x37\x1f\xd2\x1b\xd8\xf8\x70\x8c\xd4\xf5\x0c\x1b\xef\x38\x52\x9b\xd9\xc7\x04\xec\x81\x7b\x84\x8f\x01\x1d\x6c\x6c\x4e\x8c\x11\x3e\x28\xa7\x3a\x1c\xd0\x31\x84\xe8\x7f\x43\xcd\x8b\x85\x0a\xf4\x8c\x31\x91\x77\x0d\xa8\x40\xf8\x27\xa3\x93\xb7\xb4\xfa\xfc\x43\x5a\x91\x5f\x1f\x1e\x5a\x64\xf5\xb0\xf8\x4c\xce\x34\xb0\xc9\x89\xfd\xf0\x09\x93\xcf\x51\xe3\x8f\xb8\x27\x47\x4c\xde\x2d\x06\x64\x65\x14\xab\x66\x01\xe0\xd4\x80\x82\x16\x2c\x69\x25\xde\xa1\x5c\x1c\xd3\x4a\x75\xe8\x78\xe5\x03\xba\xa5\xae\xa4\x96\xc3\x99\xd4\x8a\xfc\x22\x05\xd4\x02\xd1\x45\x9f\x43\x03\xaf\xc6\xd7\xcb\x92\x1c\x01\xa8\x14\x1f\x2f\xf7\xd6\x84\x63\x71\x5a\x4a\xfc\xee\x4e\xc0\x7b\x4a\x5c\x82\x82\xcd\x51\xd9\x59\xee\xc5\x9f\xc8\x75\xd9\xaa\x38\x17\xb1\x00\x48\xda\x07\x6c\xe0\x17\xa1\x14\x94\x46\x23\xb6\xdc\xc6\x51\xae\x91\x66\x62\xc5\x39\x35\xf0\xf7\x3f\x0b\x80\x83\xb2\x64\x0a\x4c\x75\x4a\xae\x8f\xdb\xa7\xe7\xef\x77\xba\xc7\x41\x55\x23\x80\xc1\xa4\x23\x85\x12\x37\x93\x00\x50\x2a\xe5\xae\x87\x60\xef\x63\x79\x9d\x49\x03\x1e\xb7\x4f\x23\x66\x88\x3e\x60\x64\x3a\xf1\x92\xe7\xaa\x1d\xce\xb6\x9b\xdb\xdf\x0a\xbd\x1a\x03\x46\x1a\x00\xeb\xdd\x87\x6a\x43\x03\xa9\xb2\xf0\x7b\xe0\x9e\x12\x44\x0c\x11\x13\x3a\x2e\x44\xae\x60\x41\x42\x94\x03\xdf\x4a\x2f\xae\x60\x87\x51\x40\x20\xf5\x3e\x5b\x03\xda\xbb\x03\x46\x86\x88\xda\x77\x8e\xfe\x3a\x23\x9f\xbb\xdb\x2a\xc6\xb1\x72\xa7\x87\x1c\x63\x74\xca\x8a\xb0\x19\xbf\x03\xe5\x0c\x0c\xea\x08\x11\xe5\x0e\xc8\xee\x0a\xad\x84\xa4\x15\x7c\xf0\x11\x81\xdc\xde\x37\xd0\x33\x87\xd4\xac\xd7\x1d\xf1\x69\x00\xb4\x1f\x86\xec\x88\x8f\x6b\x7d\x35\x60\x6b\x83\x07\xb4\xeb\x44\xdd\x52\x45\xdd\x13\xa3\xe6\x1c\x71\xad\x02\x2d\x0b\x71\xc7\x65\x8a\x06\xf3\xe6\x5c\xfe\xb7\x57\x4c\xf9\x28\x9d\x92\x38\x92\xeb\xce\xe6\xd2\xc0\x77\x75\x97\xee\x95\x42\xab\xf1\x58\xe5\x7f\x91\x57\x4c\xa2\xca\xa7\x9f\x76\xbf\xc2\xe9\xd2\x52\x82\xa9\xe6\x45\xed\xcb\xb1\x74\x11\x5e\x84\x22\xb7\xc7\x58\x0b\xb7\x8f\x7e\x28\x88\xe8\x4c\xf0\xe4\xb8\xbc\x68\x4b\xe8\xa6\xa2\xa7\xdc\x0e\xc4\x52\xe9\xdf\x33\x26\x96\xfa\xac\x60\xa3\x9c\xf3\x0c\x2d\x42\x0e\x46\x31\x9a\x15\x3c\x39\xd8\xa8\x01\xed\x46\x25\xfc\xe6\xb2\x8b\xc2\x69\x29\x92\xbe\x2e\xfc\xf5\xf6\x9a\x06\x56\xb5\xce\xe6\xd3\x7e\x9a\xad\xd0\x7f\xe7\x72\x17\x50\x4f\x86\xc4\x60\xa2\x28\x8d\xcc\x8a\x51\xda\xff\xce\xb6\xba\x3f\xa1\xf2\x8c\xbb\x50\xf6\xcc\xd4\x01\x30\x90\x7b\x8f\xae\xe3\xbe\x81\x87\x1b\xd7\x6c\xe6\x37\x70\x65\x6d\x7d\x0d\xcc\x7d\xb6\x56\x56\xf3\xc7\x03\xc6\x48\xe6\xab\x60\x76\xd6\xb7\xca\xde\x22\x4d\x4a\xf0\x73\x09\x79\x2e\x83\x3d\x11\xbe\x9e\x1d\x47\xfe\x06\xe1\x9e\xcc\xf2\xd0\xa0\xba\x73\x12\x33\x7e\x00\x65\x4c\xf9\x04\x2a\xbb\x7d\x01\xe7\xc5\xc4\xae\xdd\x37\x0d\x37\xe1\xb1\xcd\xd6\x6e\xbd\x25\x7d\x9c\xbb\x60\x22\xc4\x25\x74\xb4\xb7\x28\x9b\x23\x54\x93\x7c\x20\x68\xbf\xfe\xa3\x47\x27\xbb\x34\x64\x6b\x41\xcd\x72\x96\xe1\x53\xe4\xe4\x13\x23\x0c\xee\xd2\xbe\x93\xd5\x99\xf6\x0e\x75\x44\x9e\xa3\xfd\xc2\xf9\xbb\x8a\xf4\xb9\x7d\x97\x5b\xd4\xde\xed\xa9\x9b\x87\xfe\xf2\x06\x93\xed\x25\xc3\x79\x8d\xb4\xbc\x1e\x8e\x7b\xf6\x32\x34\x13\xe7\x6d\xf7\x4f\x9c\xb5\x15\x27\xa6\x99\x7c\x5e\xdd\x44\xf5\x2f\xe2\x0b\x76\x51\x39\x30\x19\x0a\xdf\x26\xd9\xfc\xff\x77\x1d\xcd\xf0\xb8\x31\x1d\x4e\xbf\x92\x87\x87\xcb\x5b\x21\xb9\x1c\xff\x0a\x0f\xb5\x18\xf5\xde\x06\x38\x66\x1c\x7f\x8a\x7c\x54\x1d\x8e\x96\x7f\x03\x00\x00\xff\xff\x46\x74\xc4\xd1\xd7\x0a\x00\x00")
+var _crdsAgentOpenClusterManagementIo_applicationmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdd\x8f\xd4\x36\x10\x7f\xcf\x5f\x31\x12\x95\xd8\x2d\x64\xb7\xa7\x56\x55\x1b\x21\x10\xda\x22\x84\x80\x72\x62\x4f\xbc\x1c\x54\xf2\x26\x93\xc4\x3d\xc7\x36\xf6\x78\xcb\xb6\xd7\xff\xbd\x1a\x27\xfb\x91\x25\xb9\x0f\x1d\x15\x7d\x28\xe6\xe1\xec\x19\xcf\xc7\x6f\xc6\x33\x93\xbd\x07\x0b\x63\x37\x4e\x56\x35\xc1\xc2\x68\x72\x72\x15\xc8\x38\x0f\x64\x80\x6a\x84\x37\x16\x35\x2c\x54\xf0\x84\x0e\x5e\x0b\x2d\x2a\x6c\x50\x13\x58\x67\x7e\xc7\x9c\x92\x44\x58\xf9\x0e\x9d\x97\x46\x67\x20\xac\xc4\x4f\x84\x9a\x77\x7e\x76\xf1\x93\x9f\x49\x33\x5f\x9f\xac\x90\xc4\x49\x72\x21\x75\x91\xc1\x22\x78\x32\xcd\x5b\xf4\x26\xb8\x1c\x7f\xc1\x52\x6a\x49\xd2\xe8\xa4\x41\x12\x85\x20\x91\x25\x00\x5a\x34\xc8\xd2\xac\x92\xb9\x60\x6a\x13\x15\x3b\x3f\x13\x15\x6a\x9a\x19\x8b\x3a\xcd\x5b\xa3\xd2\x66\x67\xd4\x4c\x9a\xc4\x5b\xcc\x59\x44\xe5\x4c\xb0\x19\x5c\xcb\xdf\x2a\xf3\x7c\x05\xa0\x35\xf1\xe9\x5e\x6f\xeb\xb0\x8b\x44\x25\x3d\xbd\x1c\x61\x78\x25\x3d\x45\x26\xab\x82\x13\x6a\xd0\xf6\x48\xf7\x52\x57\x41\x09\x37\xc4\x91\x00\xf8\xdc\x58\xcc\xe0\x57\xd1\xa0\xb7\x22\xc7\x82\xcf\xc2\xca\x75\x70\x75\x66\x7a\x12\x14\x7c\x06\x7f\xfd\x9d\x00\xac\x85\x92\x45\x14\xd3\x12\xd9\xd7\xa7\xa7\x2f\xde\x7d\xbf\xcc\x6b\x6c\x22\x9c\x7c\x5c\xa0\xcf\x9d\xb4\x91\x6f\xc0\x01\x90\x3e\x86\xbb\xbd\x04\xa5\x71\x71\x3b\xe0\x06\x3c\x3d\x7d\xd1\xc9\xb4\xce\x58\x74\x24\xb7\xf0\xf1\x3a\x48\x87\xdd\xd9\x91\xf6\xfb\x6c\x5e\xcb\x03\x05\x27\x00\xb6\xba\xd7\xed\x19\x16\xe0\x5b\x2b\x4c\x09\x54\x4b\x0f\x0e\xad\x43\x8f\x9a\xa2\x21\x07\x62\x01\x4c\x09\x42\x83\x59\x71\x2e\xce\x60\x89\x8e\x85\x80\xaf\x4d\x50\x05\xe4\x46\xaf\xd1\x11\x38\xcc\x4d\xa5\xe5\x9f\x3b\xc9\xbb\xec\x56\x82\xd0\x53\x4f\xa2\xd4\x84\x4e\x0b\xc5\xc0\x06\x7c\x08\x42\x17\xd0\x88\x0d\x38\x64\x1d\x10\xf4\x81\xb4\xc8\xe2\x67\xf0\xda\x38\x04\xa9\x4b\x93\x41\x4d\x64\x7d\x36\x9f\x57\x92\xb6\x0f\x20\x37\x4d\x13\xb4\xa4\xcd\x3c\x3f\x78\x60\xf3\x02\xd7\xa8\xe6\x5e\x56\xa9\x70\x79\x2d\x09\x73\x0a\x0e\xe7\xc2\xca\x34\x1a\xae\xd9\x59\x3f\x6b\x8a\x7b\xbb\xf0\xdf\x3f\xb0\x94\x36\x9c\x29\x9e\x9c\xd4\xd5\xee\x38\x26\xf0\x28\xee\x9c\xbd\x1c\x68\xd1\x5d\x6b\x5d\xdc\xc3\xcb\x47\x1c\x88\xb7\xcf\x96\x67\xb0\x55\x1a\x43\x70\x20\x12\x3a\xb4\xf7\xd7\xfc\x1e\x78\x06\x4a\xea\x12\x39\x7b\xa4\x87\xd2\x99\x26\xe2\x8c\xba\xb0\x46\x6a\x8a\x9b\x5c\x49\xd4\x7d\xd0\x7d\x58\x35\x92\x38\xd2\x1f\x03\x7a\xe2\xf8\xcc\x60\x21\xb4\x36\x04\x2b\x84\x60\x0b\x41\x58\xcc\xe0\x85\x86\x85\x68\x50\x2d\x84\xc7\x7f\x1d\x76\x46\xd8\xa7\x0c\xe9\xf5\xc0\x1f\x56\xaf\xed\xbf\x96\xb1\x45\x6b\x77\xbc\xad\x4f\x83\x11\xfa\xfc\x5d\x2e\x2d\xe6\xbd\x47\x52\xa0\x97\x8e\x13\x99\x04\x21\xa7\xff\xe7\x77\x0e\xa4\x0f\xbd\x50\x5e\x5d\x2d\xe4\x3a\xd3\x27\x00\x34\x52\xbf\x42\x5d\x51\x9d\xc1\xc9\x11\x69\xd0\xf3\x23\x71\xb1\x6c\x7d\x09\x99\x65\x50\x8a\x4b\xf3\x9b\x35\x3a\x27\x8b\x2f\x62\x67\xa5\xcc\x4a\xa8\x63\x49\xbd\x10\x3c\x8f\x2c\xef\xf8\x61\xf8\x1e\xf0\xed\xdd\xf6\xc9\xf8\x23\x09\x63\x30\xf3\x92\x8d\xa8\x76\x4e\x0c\xd0\x01\x44\x51\xc4\x16\x28\xd4\xe9\x15\x72\xae\x74\xec\x8a\x84\xdb\xae\x68\xc7\x69\x50\xea\xd4\x28\x99\x6f\x86\x14\xf4\x80\xd8\xb3\x76\xe7\x2b\xe4\xca\x61\xe3\xed\xd8\x20\x64\x39\xff\xa3\x46\xcd\xb5\xd4\x06\xa5\x40\x0c\x88\x04\x2e\xc2\x24\xa4\x46\xd7\x5a\x90\xdc\xd2\xab\x9d\xd9\x4b\xcc\x1d\x52\x76\xdb\xfb\xda\x14\xb8\x44\x85\x39\x19\xf7\xf5\xc0\xb7\xce\x7c\xda\x2c\x8c\x2e\x65\xf5\xf5\x8c\xd8\xb5\x91\x6b\x63\xbf\x1d\xcf\xde\xe2\xc7\x20\x5d\x9c\x95\xfc\x41\x16\xc4\x22\x6e\x1a\x1b\x08\xf7\x6d\xc2\x1d\xf0\xce\x06\x14\x5c\xf5\x46\x78\x29\xc9\x1d\x60\x98\x76\x1b\x84\x78\x09\xbd\x79\x53\x8e\x93\xd3\x0e\x27\x6e\xf5\xfd\x7a\x39\xcc\x37\x0a\x77\xe7\x99\x20\x1e\x19\x32\xf8\x6d\xf2\xfe\xc1\x65\x3a\x7d\x32\x99\x9c\x7f\x97\xfe\xfc\xe1\xc1\xe4\xfd\x2c\xfe\xf1\xed\xf4\xc9\xf4\x72\xbb\x79\x30\x9d\x4e\x26\xe7\x2f\x5f\x3f\x3f\x3b\x7d\xf6\x41\x4e\x2f\xcf\x75\x68\x2e\xda\xdd\xe5\xe4\x1c\x9f\x7d\xb8\xa1\x90\xe9\xf4\xc9\x37\xa3\x26\x7d\x4a\x2f\xc2\x0a\x9d\x46\x42\x9f\x4a\x4d\xa9\x71\x69\xeb\x45\x06\xe4\x02\x8e\x5c\xbc\x32\x7d\xf8\xff\xb6\x3f\xff\x1f\xa6\xff\x70\x98\xae\x24\x93\x51\xe8\xe2\xb4\x30\x18\x18\x49\xd8\x8c\x44\xac\x57\x21\xce\x6a\x04\x6b\x8a\x76\xcc\x3b\xdb\xc9\x8c\xd3\x25\x91\xc8\x6b\x2c\xb8\x2f\x74\xda\xb8\x73\xe8\x0d\x70\x1f\x18\x32\x98\x17\xd5\x82\xa0\x11\x94\xd7\x5d\x81\x21\x27\xad\x42\x78\x74\x81\x9b\x87\xb1\xe9\x3e\xc4\xb2\xc4\x9c\x1e\x43\xf0\xdb\x41\x35\xf2\xf3\x86\x73\x4d\x90\x19\xcb\x91\x47\x5b\xfa\xe3\xa1\xca\x74\x7d\x6d\x02\x68\x75\x8f\x51\xe1\x26\x19\x78\x81\x9b\x3b\xdd\xdf\xfa\x70\x27\x21\xfb\xf0\x2f\x31\x37\xba\x18\x75\x18\xb8\xc5\x37\x82\xe2\xf3\xfb\xf1\x87\x51\xae\x9b\x3c\xd1\x18\xbe\x3b\x98\x7d\xa3\x7c\x17\xce\x89\x4d\x72\xc3\x8b\x75\x58\xbd\x0c\x2b\x46\xa0\x94\xd5\xf0\x64\x71\xfb\xf9\xb2\xeb\x80\xbd\x0f\xb0\xf4\x70\x36\x1e\x3b\x8f\x33\x73\x8f\x78\x3c\xfc\xf6\x88\xed\x24\xda\x3b\x1a\xf0\x27\xb9\x06\x86\xee\x47\x84\x64\xe4\x81\x0f\x7c\x8a\xc4\x0b\xbd\x99\xd8\xac\x3c\x7f\x71\xdf\xf4\x6b\x64\xc0\x8e\xa3\xa3\xee\x27\x80\x0c\xd6\x27\xfb\x5d\xcc\xd1\xb4\xfb\x51\x28\x12\x00\x5a\xbd\x07\x55\xd2\x93\x71\xa2\xc2\x0c\xc8\x05\x4c\xfe\x19\x00\x47\x8c\xd5\x57\xd6\x12\x00\x00")
 
 func crdsAgentOpenClusterManagementIo_applicationmanagers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -118,7 +118,7 @@ func crdsAgentOpenClusterManagementIo_applicationmanagers_crdYaml() (*asset, err
 	return a, nil
 }
 
-var _crdsAgentOpenClusterManagementIo_certpolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\xaf\xdb\x36\x0c\xbe\xe7\x57\x10\xe8\xa1\x97\x25\xc1\xc3\x2e\x83\x6f\x45\x36\x0c\x0f\x6d\xd7\x87\x66\x78\x77\x59\x66\x6c\xae\xb2\xa4\x49\x54\xb6\x6c\xd8\x7f\x1f\x28\x39\x8e\x1d\xd8\xef\xad\x40\xa7\x53\x42\x51\xd4\xc7\x8f\x1f\x69\xbd\x81\x83\xf3\x97\x40\x6d\xc7\x70\x70\x96\x03\xd5\x89\x5d\x88\xc0\x0e\xb8\x43\xf8\xe4\xd1\xc2\xc1\xa4\xc8\x18\xe0\xa3\xb2\xaa\xc5\x1e\x2d\x83\x0f\xee\x37\xd4\xbc\xd9\x28\x4f\xcf\x18\x22\x39\x5b\x81\xf2\x84\x7f\x32\x5a\xf9\x17\x77\x5f\x7e\x88\x3b\x72\xfb\xf3\x43\x8d\xac\x1e\x36\x5f\xc8\x36\x15\x1c\x52\x64\xd7\x7f\xc6\xe8\x52\xd0\xf8\x23\x9e\xc8\x12\x93\xb3\x9b\x1e\x59\x35\x8a\x55\xb5\x01\xb0\xaa\xc7\x0a\x34\x06\xf6\xce\x90\xbe\x68\x01\xe6\x8c\xc1\x10\x77\xaa\x45\xcb\x3b\xe7\xd1\x6e\x75\x81\xb5\xed\x47\x58\x3b\x72\x9b\xe8\x51\x4b\x90\x36\xb8\xe4\x2b\x78\xd5\xbf\x5c\x17\xe5\x08\xc0\x00\x12\x03\x3f\xe5\x9b\x0f\xe3\xcd\x79\xdb\x50\xe4\xf7\xab\x2e\x1f\x28\x72\x76\xf3\x26\x05\x65\x56\x32\xc8\x1e\x91\x6c\x9b\x8c\x0a\xcb\x3e\x1b\x80\xa8\x9d\xc7\x0a\x7e\x11\x68\x5e\x69\x6c\xc4\x96\xea\x30\x10\x37\xc0\x8d\xac\x38\xc5\x0a\xfe\xfe\x67\x03\x70\x56\x86\x1a\x25\x64\x96\x4d\xc9\xf9\xdd\xd3\xe3\xf3\xf7\x47\xdd\x61\xaf\x8a\x11\xa0\xc1\xa8\x03\xf9\xec\xb7\x98\x06\x50\xcc\xa5\x2f\xc7\xe0\xe4\x42\xfe\xbb\x04\x54\xcf\xe9\x91\xf5\xee\xe9\x71\xf8\xed\x83\xf3\x18\x98\xae\x60\x65\x4d\xd4\x32\xda\xee\x20\xbd\x15\xcc\xc5\x07\x1a\xd1\x07\x16\x38\xe7\x62\xc3\x06\x62\x01\xe6\x4e\xc0\x1d\x45\x08\xe8\x03\x46\xb4\x9c\x73\x9f\x84\x05\x71\x51\x16\x5c\x2d\x52\xdd\xc1\x11\x83\x04\x81\xd8\xb9\x64\x1a\xd0\xce\x9e\x31\x30\x04\xd4\xae\xb5\xf4\xd7\x18\x79\x14\xbf\x51\x8c\x43\x49\xaf\x8b\x2c\x63\xb0\xca\x08\xdb\x09\xbf\x03\x65\x1b\xe8\xd5\x05\x02\xca\x1d\x90\xec\x24\x5a\x76\x89\x3b\xf8\xe8\x02\x02\xd9\x93\xab\xa0\x63\xf6\xb1\xda\xef\x5b\xe2\x6b\x7f\x68\xd7\xf7\xc9\x12\x5f\xf6\x7a\xd2\x7f\xfb\x06\xcf\x68\xf6\x91\xda\xad\x0a\xba\x23\x46\xcd\x29\xe0\x5e\x79\xda\x66\xe0\x96\x73\x93\xf5\xcd\x9b\x51\x13\x6f\x27\x48\xf9\x22\xf2\x89\x1c\xc8\xb6\xa3\x39\xab\x7b\x95\x77\x11\xb6\xd4\x5e\x0d\xc7\x0a\xfe\x1b\xbd\x62\x12\x56\x3e\xff\x74\xfc\x15\xae\x97\xe6\x12\xcc\x39\xcf\x6c\xdf\x8e\xc5\x1b\xf1\x42\x14\xd9\x13\x86\x52\xb8\x53\x70\x7d\x8e\x88\xb6\xf1\x8e\x2c\x17\x9d\x19\x42\x3b\x27\x3d\xa6\xba\x27\x96\x4a\xff\x9e\x30\xb2\xd4\x67\x07\x07\x65\xad\x63\xa8\x11\x92\x6f\x14\x63\xb3\x83\x47\x0b\x07\xd5\xa3\x39\xa8\x88\xff\x3b\xed\xc2\x70\xdc\x0a\xa5\xaf\x13\x3f\x1d\x6e\x73\xc7\xc2\xd6\x68\xbe\x0e\xaf\xc5\x0a\x2d\x35\xeb\xd1\xa3\x9e\xb5\x49\x83\x91\x82\x48\x99\x15\xa3\x34\xc0\xea\x30\x5b\xef\x52\x59\xc3\xb0\x94\x01\x34\xdf\x00\xe8\xc9\x7e\x40\xdb\x72\x57\xc1\xc3\xdd\xd6\x62\xf6\x77\xe1\xf2\x3c\xfb\x16\x31\x4f\xc9\x18\x99\xdd\x9f\xce\x18\x02\x35\xdf\x24\x66\x6b\x5c\xad\xcc\x7d\xa4\x59\x19\x7e\xce\x2e\xcf\xb9\xb9\x67\xd4\x97\xb3\x43\xdb\xdf\x45\x58\xa3\x59\x16\xf5\xaa\x1d\x93\x58\xd8\x07\x50\x4d\x93\xbf\x92\xca\x3c\xbd\x10\xe7\xc5\xc4\xa6\xdb\x77\xa2\x9b\xe1\x78\x4a\xc6\x14\xb9\x2c\x5d\x30\x23\xe2\xe6\x3a\xd8\x6b\x94\xe9\x51\x3e\x12\xf9\xbb\x41\xa7\xfd\x1f\x1d\x5a\x99\xa7\x3e\x19\x03\x6a\x11\xb3\x34\xa0\x22\x2b\x5f\x1e\x41\xb0\x0a\x7b\x25\xab\x11\xf6\x11\x75\x40\x5e\x82\xfd\xc2\xf9\x55\x46\xba\x54\xbf\x4f\x35\x6a\x67\x4f\xd4\x2e\x87\xfe\x7a\x81\xc9\x04\x93\xf6\x9c\x46\xda\x4e\x9b\x63\xcd\x9e\x9b\x66\xb6\x79\xaf\xfe\xd9\x66\x91\xe2\xab\xd3\xa6\x3c\x1f\xbe\x6a\xde\xe4\x23\x33\xd9\xbb\x3a\xca\x7c\xff\xef\x23\x67\x01\xcb\x9d\xe9\x7c\x7d\x51\x9e\x1f\x6e\xff\x32\xd0\xed\xf0\x38\x3c\x17\xc2\xcb\xcd\x15\x70\x48\x38\xbc\x88\x5c\x50\x2d\x0e\x96\x7f\x03\x00\x00\xff\xff\x11\x67\x14\xe5\xde\x0a\x00\x00")
+var _crdsAgentOpenClusterManagementIo_certpolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\xd4\x36\x10\x7f\xcf\xa7\x18\x89\x4a\xec\x16\xb2\xdb\x53\xab\xaa\x8d\x10\x08\x6d\x11\x42\x40\x39\xb1\x27\x5e\x0e\x2a\x79\x93\x49\xe2\x9e\x63\x1b\x7b\xbc\x65\xdb\xeb\x77\xaf\xc6\xc9\xfe\xc9\x2a\xb9\xdb\x13\x54\xf4\xa1\x98\x87\xb3\x67\x3c\x9e\xf9\xcd\xdf\xec\x3d\x58\x18\xbb\x71\xb2\xaa\x09\x16\x46\x93\x93\xab\x40\xc6\x79\x20\x03\x54\x23\xbc\xb1\xa8\x61\xa1\x82\x27\x74\xf0\x5a\x68\x51\x61\x83\x9a\xc0\x3a\xf3\x3b\xe6\x94\x24\xc2\xca\x77\xe8\xbc\x34\x3a\x03\x61\x25\x7e\x22\xd4\xbc\xf3\xb3\xab\x9f\xfc\x4c\x9a\xf9\xfa\x6c\x85\x24\xce\x92\x2b\xa9\x8b\x0c\x16\xc1\x93\x69\xde\xa2\x37\xc1\xe5\xf8\x0b\x96\x52\x4b\x92\x46\x27\x0d\x92\x28\x04\x89\x2c\x01\xd0\xa2\xc1\x0c\x72\x74\x64\x8d\x92\xf9\x26\x67\xc5\x8c\x52\xe8\xfc\x4c\x54\xa8\x69\x66\x2c\xea\x34\x6f\xd5\x4a\x9b\x9d\x5a\x33\x69\x12\x6f\x31\x67\x21\x95\x33\xc1\x66\x70\x2b\x7f\xfb\x9c\xe7\x2b\x00\x9d\x92\xe8\xe8\x3c\xbe\xbc\xd8\xbd\x1c\xc9\x4a\x7a\x7a\x39\xca\xf2\x4a\x7a\x8a\x6c\x56\x05\x27\xd4\x88\x05\x91\xc3\x4b\x5d\x05\x25\xdc\x30\x4f\x02\xe0\x73\x63\x31\x83\x5f\x45\x83\xde\x8a\x1c\x0b\x3e\x0b\x2b\xd7\x01\xd7\xa9\xeb\x49\x50\xf0\x19\xfc\xf5\x77\x02\xb0\x16\x4a\x16\x82\xc1\x6c\x89\x6c\xf3\xd3\xf3\x17\xef\xbe\x5f\xe6\x35\x36\x11\x58\x3e\x2e\xd0\xe7\x4e\xda\xc8\x37\x68\x06\x48\x1f\x5d\xdf\x5e\x83\xd2\xb8\xb8\x1d\x52\xb4\xa7\x32\x0b\x07\x78\x7a\xfe\xa2\xfb\xdb\x3a\x63\xd1\x91\xdc\x62\xcb\xeb\x20\x5a\x76\x67\x47\x2a\xdd\x67\x9d\x5b\x1e\x28\x38\x3e\xb0\x55\x67\xdd\x9e\x61\x01\xbe\x55\xcc\x94\x40\xb5\xf4\xe0\xd0\x3a\xf4\xa8\x29\xda\x7e\x20\x16\xc0\x94\x20\x34\x98\x15\x87\xea\x0c\x96\xe8\x58\x08\xf8\xda\x04\x55\x40\x6e\xf4\x1a\x1d\x81\xc3\xdc\x54\x5a\xfe\xb9\x93\xbc\x0b\x7e\x25\x08\x3d\xf5\x24\x4a\x4d\xe8\xb4\x50\x8c\x76\xc0\x87\x20\x74\x01\x8d\xd8\x80\x43\x7e\x03\x82\x3e\x90\x16\x59\xfc\x0c\x5e\x1b\x87\x20\x75\x69\x32\xa8\x89\xac\xcf\xe6\xf3\x4a\xd2\x36\x3f\x72\xd3\x34\x41\x4b\xda\xcc\xf3\x83\xfc\x9b\x17\xb8\x46\x35\xf7\xb2\x4a\x85\xcb\x6b\x49\x98\x53\x70\x38\x17\x56\xa6\x51\x71\xcd\xc6\xfa\x59\x53\xdc\xdb\xc5\xc4\xfd\x03\x4d\x69\xc3\xe1\xe3\xc9\x49\x5d\xed\x8e\x63\x74\x8f\xe2\xce\x81\xcd\xbe\x17\xdd\xb5\xd6\xc4\x3d\xbc\x7c\xc4\x8e\x78\xfb\x6c\x79\x01\xdb\x47\xa3\x0b\x0e\x44\x42\x87\xf6\xfe\x9a\xdf\x03\xcf\x40\x49\x5d\x22\x07\x94\xf4\x50\x3a\xd3\x44\x9c\x51\x17\xd6\x48\x4d\x71\x93\x2b\x89\xba\x0f\xba\x0f\xab\x46\x12\x7b\xfa\x63\x40\x4f\xec\x9f\x19\x2c\x84\xd6\x86\x60\x85\x10\x6c\x21\x08\x8b\x19\xbc\xd0\xb0\x10\x0d\xaa\x85\xf0\xf8\xaf\xc3\xce\x08\xfb\x94\x21\xbd\x1d\xf8\xc3\xe2\xb6\xfd\xd7\x32\xb6\x68\xed\x8e\xb7\xc5\x6b\xd0\x43\x43\xc9\xba\xb4\x98\xf7\xd2\xa4\x40\x2f\x1d\x87\x32\x09\x42\x4e\x80\xa1\x5b\x07\x2f\x0c\x65\x29\xaf\xae\x58\x72\x01\xea\x13\x00\x1a\xa9\x5f\xa1\xae\xa8\xce\xe0\xec\x88\x34\x68\xfd\x91\xb8\x58\xcf\xbe\x84\xcc\x32\x28\xc5\xb5\xfb\xcd\x1a\x9d\x93\xc5\x17\xd1\xb3\x52\x66\x25\xd4\xb1\xa4\x9e\x1b\x9e\x47\x96\x77\x9c\x1c\xbe\x07\x7d\x7b\xb7\x4d\x1b\x7f\x24\x61\x0c\x66\x5e\xb2\x11\xd5\xce\x88\x01\x3a\x80\x28\x8a\xd8\x25\x85\x3a\xbf\x41\xce\x8d\x86\xdd\x10\x74\xdb\x15\xf5\x38\x0f\x4a\xb5\xe1\x32\xf4\x40\x0f\x88\x3d\x6b\x77\xbe\x42\xae\x1e\x6d\x93\x88\x7d\x43\x96\xf3\x3f\x6a\xd4\x5c\x4f\x6d\x50\x0a\xc4\x80\x48\xe0\x42\x4c\x42\x6a\x74\xad\x06\xc9\x1d\xad\xda\xa9\xbd\xc4\xdc\x21\x65\x77\xbd\xaf\x4d\x81\x4b\x54\x98\x93\x71\x5f\x0f\x7c\xeb\xcc\x27\x6e\xc2\xa5\xac\xbe\x9e\x12\xbb\x56\x72\xab\xef\xb7\x13\xdc\x5b\xfc\x18\xa4\x8b\xc3\x94\x3f\x88\x82\x58\xc8\x4d\x63\x03\xe1\xbe\x55\xb8\x03\xde\xd9\xc0\x03\x37\xe5\x08\x2f\x25\xb9\x0b\x0c\xd3\xee\x82\x10\x2f\xa1\x37\x6f\xca\x71\x72\xda\xe1\xc4\xed\xbe\xea\xd5\xcb\x61\xbe\x51\xb8\x3b\xcb\x04\xf1\xd8\x90\xc1\x6f\x93\xf7\x0f\xae\xd3\xe9\x93\xc9\xe4\xf2\xbb\xf4\xe7\x0f\x0f\x26\xef\x67\xf1\x8f\x6f\xa7\x4f\xa6\xd7\xdb\xcd\x83\xe9\x74\x32\xb9\x7c\xf9\xfa\xf9\xc5\xf9\xb3\x0f\x72\x7a\x7d\xa9\x43\x73\xd5\xee\xae\x27\x97\xf8\xec\xc3\x89\x42\xa6\xd3\x27\xdf\x8c\xaa\xf4\x29\xbd\x0a\x2b\x74\x1a\x09\x7d\x2a\x35\xa5\xc6\xa5\xad\x15\x19\x90\x0b\x38\x72\xf1\xc6\xf0\xe1\xff\xdb\x1e\xfd\xbf\x9b\xfe\xc3\x6e\xba\x91\x4c\x46\xa1\x8b\xb3\xf4\xa0\x63\x24\x61\x33\xe2\xb1\x5e\x85\xb8\xa8\x11\xac\x29\xda\x51\xef\x62\x27\x33\x4e\x98\x44\x22\xaf\xb1\xe0\xbe\xd0\xbd\xc6\x9d\x43\x6f\x80\xfb\xc0\x90\xc2\xbc\xa8\x16\x04\x8d\xa0\xbc\xee\x0a\x0c\x39\x69\x15\xc2\xa3\x2b\xdc\x3c\x8c\x4d\xf7\x21\x96\x25\xe6\xf4\x18\x82\xdf\x0e\xab\x91\x9f\x37\x1c\x6b\x82\xcc\x58\x8c\x3c\xda\xd2\x1f\x0f\x55\xa6\xdb\x6b\x13\x40\xfb\xf6\x18\x15\x4e\x89\xc0\x2b\xdc\x7c\xd6\xfd\xad\x0d\x9f\x25\x64\xef\xfe\x25\xe6\x46\x17\xa3\x06\x03\xb7\xf8\x46\x50\x4c\xbf\x1f\x7f\x18\xe5\x3a\x25\x45\xa3\xfb\x3e\x43\xed\x93\xe2\x5d\x38\x27\x36\xc9\x89\x17\xeb\xb0\x7a\x19\x56\x8c\x40\x29\xab\xe1\xc9\xe2\xee\xf3\x65\xd7\x01\x7b\x1f\x61\xe9\xe1\x6c\x3c\x76\x1e\x67\xe6\x1e\xf1\x78\xf8\xed\x11\xdb\x49\x34\xb9\xc5\xcc\xee\xd7\x83\x64\x24\x81\x07\x3f\x37\xe2\x95\xde\xd4\x6b\x56\x9e\xbf\xab\x4f\xff\xe2\x18\xd0\xe5\xe8\xa8\xfb\xd4\xcf\x60\x7d\xb6\xdf\xc5\x38\x4c\xbb\xdf\x86\x22\x01\xa0\x7d\xf9\xa0\x12\x7a\x32\x4e\x54\x98\x01\xb9\x80\xc9\x3f\x03\x00\x14\x7d\xd7\xd4\xdd\x12\x00\x00")
 
 func crdsAgentOpenClusterManagementIo_certpolicycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -138,7 +138,7 @@ func crdsAgentOpenClusterManagementIo_certpolicycontrollers_crdYaml() (*asset, e
 	return a, nil
 }
 
-var _crdsAgentOpenClusterManagementIo_iampolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xe3\x36\x0c\xbd\xe7\x57\x10\xd8\xc3\x5e\x9a\x04\x83\x5e\x0a\xdf\x06\x69\x51\x0c\x76\xa7\x3b\xd8\x29\xe6\x4e\xcb\x8c\xcd\xae\x2c\xa9\x12\x95\x36\x2d\xfa\xdf\x0b\xca\x8e\x13\x07\xce\x4c\x17\xd8\xfa\x66\x8a\x7a\x7c\x7a\xfc\x90\xde\xc1\xce\x87\x63\xe4\xb6\x13\xd8\x79\x27\x91\xeb\x2c\x3e\x26\x10\x0f\xd2\x11\x7c\x0a\xe4\x60\x67\x73\x12\x8a\xf0\x88\x0e\x5b\xea\xc9\x09\x84\xe8\x7f\x23\x23\xab\x15\x06\x7e\xa1\x98\xd8\xbb\x0a\x30\x30\xfd\x29\xe4\xf4\x2f\x6d\xbe\xfc\x90\x36\xec\xb7\x87\xbb\x9a\x04\xef\x56\x5f\xd8\x35\x15\xec\x72\x12\xdf\x7f\xa6\xe4\x73\x34\xf4\x23\xed\xd9\xb1\xb0\x77\xab\x9e\x04\x1b\x14\xac\x56\x00\x0e\x7b\xaa\x80\xb1\x0f\xde\xb2\x39\x1a\xe5\xe5\xad\xa5\x98\x36\xd8\x92\x93\x8d\x0f\xe4\xd6\x66\x60\xb5\xee\x27\x56\x1b\xf6\xab\x14\xc8\x28\x46\x1b\x7d\x0e\x15\xbc\xe9\x3f\x44\x4b\xba\x05\x60\xe0\xf8\x70\xff\xf8\x54\x02\xef\xa6\xc0\x65\xd5\x72\x92\x0f\xb7\x3c\x3e\x72\x92\xe2\x15\x6c\x8e\x68\x97\xe9\x17\x87\xc4\xae\xcd\x16\xe3\xa2\xcb\x0a\x20\x19\x1f\xa8\x82\x5f\x94\x56\x40\x43\x8d\xda\x72\x1d\x47\xcd\x46\xaa\x49\x50\x72\xaa\xe0\xef\x7f\x56\x00\x07\xb4\xdc\xa0\xea\x38\x2c\xea\x79\xef\x9f\x1e\x5e\xbe\x7f\x36\x1d\xf5\x38\x18\x01\x1a\x4a\x26\x72\x28\x7e\x4b\x67\x00\x4e\x25\xe9\xc3\x2e\xd8\xfb\x58\x7e\x17\x68\xc2\xfd\xd3\xc3\x08\x1a\xa2\x0f\x14\x85\x4f\xc4\xf4\xbb\x28\x8a\xc9\x76\x15\xfe\xbd\xf2\x1b\x7c\xa0\xd1\x32\xa0\x21\xf6\x61\xb0\x51\x03\x69\x60\xe1\xf7\x20\x1d\x27\x88\x14\x22\x25\x72\x52\xce\x79\x01\x0b\xea\x82\x0e\x7c\xad\x15\xb9\x81\x67\x8a\x0a\x02\xa9\xf3\xd9\x36\x60\xbc\x3b\x50\x14\x88\x64\x7c\xeb\xf8\xaf\x09\x79\xaa\x71\x8b\x42\x63\xf2\x4e\x1f\x3b\xa1\xe8\xd0\xaa\xb2\x99\xbe\x03\x74\x0d\xf4\x78\x84\x48\x1a\x03\xb2\xbb\x40\x2b\x2e\x69\x03\x8f\x3e\x12\xb0\xdb\xfb\x0a\x3a\x91\x90\xaa\xed\xb6\x65\x39\xb5\x81\xf1\x7d\x9f\x1d\xcb\x71\x6b\x2e\xda\x6c\xdb\xd0\x81\xec\x36\x71\xbb\xc6\x68\x3a\x16\x32\x92\x23\x6d\x31\xf0\xba\x10\x77\x52\x7a\xa9\x6f\xde\x4d\xf9\x7f\x7f\xc1\x54\x8e\x5a\x2a\x49\x22\xbb\x76\x32\x97\x2a\xbe\xa9\xbb\x56\xb0\x26\x1a\xc7\x6d\x03\xff\xb3\xbc\x6a\x52\x55\x3e\xff\xf4\xfc\x2b\x9c\x82\x96\x14\xcc\x35\x2f\x6a\x9f\xb7\xa5\xb3\xf0\x2a\x14\xbb\x3d\xc5\x21\x71\xfb\xe8\xfb\x82\x48\xae\x09\x9e\x9d\x94\x1f\x63\x99\xdc\x5c\xf4\x94\xeb\x9e\x45\x33\xfd\x7b\xa6\x24\x9a\x9f\x0d\xec\xd0\x39\x2f\x50\x13\xe4\xd0\xa0\x50\xb3\x81\x07\x07\x3b\xec\xc9\xee\x30\xd1\xff\x2e\xbb\x2a\x9c\xd6\x2a\xe9\xdb\xc2\x5f\xce\xb0\xb9\xe3\xa0\xd6\x64\x3e\x0d\xa9\xc5\x0c\x2d\x34\xe6\x73\x20\x33\xeb\x92\x86\x12\x47\xad\x64\x41\x21\xad\xff\x5b\x33\xeb\x76\x8f\xea\x37\x8e\x44\x1d\x35\xf3\x05\x80\x9e\xdd\x47\x72\xad\x74\x15\xdc\x5d\x2d\x2d\x9e\xfd\x0a\xae\x4c\xae\x6f\x81\xb9\xcf\xd6\xea\x84\xfe\x74\xa0\x18\xb9\xf9\x26\x98\xad\xf5\x35\xda\x6b\xa4\x59\x12\x7e\x2e\x2e\x2f\xa5\xb5\x67\xca\x0f\x7b\xc7\xa6\xbf\x42\xb8\x25\xb3\x7e\xdc\x63\x3b\x1d\x62\x61\x1d\x00\x9b\xa6\x5c\x85\x68\x9f\x5e\xc1\x79\xf5\x60\x97\xcb\x57\x25\x37\xe3\xf1\x94\xad\x1d\xca\x65\x29\xc0\x4c\x88\xb3\xeb\x68\xaf\x49\x67\xc7\x70\x21\x94\x2b\x82\xf7\xdb\x3f\x3a\x72\x3a\x4d\x43\xb6\x16\x70\x91\xb3\xb6\x1f\xb2\xd3\x4b\x46\x19\xdc\xa4\x7d\xe3\x54\x13\xed\x67\x32\x91\x64\x89\xf6\x2b\xfb\x6f\x2a\xd2\xe5\xfa\x43\xae\xc9\x78\xb7\xe7\x76\x19\xfa\xeb\x0b\x4c\xe7\x97\x76\xe7\x25\xd2\xfa\xb2\x39\x6e\xd9\x4b\xd3\xcc\x16\xaf\xab\xff\xcd\xc1\x32\xbc\x0a\xbe\x66\xb4\x94\x1d\xb3\x12\xf7\x75\xd2\x49\xfe\x9f\xa7\xcb\x02\x93\x2b\xd3\xe1\xf4\x42\x3c\xdc\x9d\xff\x0a\xcd\xf5\xf8\xd8\x3b\x0c\xda\x0e\x81\x2b\x90\x98\x69\x7c\xe6\xf8\x88\x2d\x8d\x96\x7f\x03\x00\x00\xff\xff\xa9\xbe\x1a\x1c\xae\x0a\x00\x00")
+var _crdsAgentOpenClusterManagementIo_iampolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdd\x8f\xd4\x36\x10\x7f\xcf\x5f\x31\x12\x95\xd8\x2d\x64\xb7\xa7\x56\x55\x1b\x21\x10\xda\x22\x74\x82\x2b\x27\xf6\xc4\xcb\x41\x25\x6f\x32\x49\xdc\x73\x6c\x63\x8f\xb7\x6c\x7b\xfd\xdf\xab\x71\xb2\x1f\x59\x25\xf7\x21\xa8\xe8\x43\x31\x0f\x67\x7b\x3c\x1f\xbf\xf9\xcc\x3e\x80\x85\xb1\x1b\x27\xab\x9a\x60\x61\x34\x39\xb9\x0a\x64\x9c\x07\x32\x40\x35\xc2\x1b\x8b\x1a\x16\x2a\x78\x42\x07\x67\x42\x8b\x0a\x1b\xd4\x04\xd6\x99\xdf\x31\xa7\x24\x11\x56\xbe\x43\xe7\xa5\xd1\x19\x08\x2b\xf1\x13\xa1\xe6\x9d\x9f\x5d\xfd\xe4\x67\xd2\xcc\xd7\x27\x2b\x24\x71\x92\x5c\x49\x5d\x64\xb0\x08\x9e\x4c\xf3\x16\xbd\x09\x2e\xc7\x5f\xb0\x94\x5a\x92\x34\x3a\x69\x90\x44\x21\x48\x64\x09\x80\x16\x0d\x66\x20\x45\x63\x8d\x92\xf9\x26\x67\xbd\x8c\x52\xe8\xfc\x4c\x54\xa8\x69\x66\x2c\xea\x34\x6f\xb5\x4a\x9b\x9d\x56\x33\x69\x12\x6f\x31\x67\x1e\x95\x33\xc1\x66\x70\x2b\x7d\x2b\xcd\xf3\x13\x80\x56\xc7\xd3\xe7\x67\xe7\x51\xf0\x62\x27\x38\xde\x2a\xe9\xe9\xd5\x18\xc5\x6b\xe9\x29\x52\x59\x15\x9c\x50\xc3\xea\x47\x02\x2f\x75\x15\x94\x70\x83\x24\x09\x80\xcf\x8d\xc5\x0c\x7e\x15\x0d\x7a\x2b\x72\x2c\xf8\x2c\xac\x5c\x87\x59\xa7\xaa\x27\x41\xc1\x67\xf0\xd7\xdf\x09\xc0\x5a\x28\x59\x08\xc6\xb1\xbd\x64\x7b\x9f\x9f\x9f\xbe\xfb\x7e\x99\xd7\xd8\x44\x4c\xf9\xb8\x40\x9f\x3b\x69\x23\xdd\x90\x0d\x20\x7d\x74\x7a\xfb\x0a\x4a\xe3\xe2\x76\x40\x4d\x78\x7e\x7e\xda\x31\xb5\xce\x58\x74\x24\xb7\x18\xf2\x3a\x08\x8a\xdd\xd9\x91\xf8\x87\xac\x5f\x4b\x03\x05\x87\x01\xb6\xb2\xd7\xed\x19\x16\xe0\x5b\x2d\x4c\x09\x54\x4b\x0f\x0e\xad\x43\x8f\x9a\xa2\x9d\x07\x6c\x01\x4c\x09\x42\x83\x59\x71\x44\xce\x60\x89\x8e\x99\x80\xaf\x4d\x50\x05\xe4\x46\xaf\xd1\x11\x38\xcc\x4d\xa5\xe5\x9f\x3b\xce\xbb\x18\x57\x82\xd0\x53\x8f\xa3\xd4\x84\x4e\x0b\xc5\xc8\x06\x7c\x0c\x42\x17\xd0\x88\x0d\x38\x64\x19\x10\xf4\x01\xb7\x48\xe2\x67\x70\x66\x1c\x82\xd4\xa5\xc9\xa0\x26\xb2\x3e\x9b\xcf\x2b\x49\xdb\x34\xc8\x4d\xd3\x04\x2d\x69\x33\xcf\x0f\xd2\x6c\x5e\xe0\x1a\xd5\xdc\xcb\x2a\x15\x2e\xaf\x25\x61\x4e\xc1\xe1\x5c\x58\x99\x46\xc5\x35\x1b\xeb\x67\x4d\xf1\x60\xe7\xff\x87\x07\x9a\xd2\x86\x43\xc5\x93\x93\xba\xda\x1d\xc7\x28\x1e\xc5\x9d\x23\x98\x1d\x2d\xba\x67\xad\x89\x7b\x78\xf9\x88\x1d\xf1\xf6\xc5\xf2\x02\xb6\x42\xa3\x0b\x0e\x58\x42\x87\xf6\xfe\x99\xdf\x03\xcf\x40\x49\x5d\x22\x47\x8f\xf4\x50\x3a\xd3\x44\x9c\x51\x17\xd6\x48\x4d\x71\x93\x2b\x89\xba\x0f\xba\x0f\xab\x46\x12\x7b\xfa\x63\x40\x4f\xec\x9f\x19\x2c\x84\xd6\x86\x60\x85\x10\x6c\x21\x08\x8b\x19\x9c\x6a\x58\x88\x06\xd5\x42\x78\xfc\xd7\x61\x67\x84\x7d\xca\x90\xde\x0e\xfc\x61\x0d\xdb\xfe\x6b\x09\x5b\xb4\x76\xc7\xdb\x22\x35\xe8\xa1\x81\xc4\x5c\x5a\xcc\x7b\x59\x52\xa0\x97\x8e\x23\x99\x04\x21\xc7\xff\xc0\xa3\x03\xfe\x43\x39\xca\xab\x2b\x89\x5c\x6a\xfa\x17\x00\x8d\xd4\xaf\x51\x57\x54\x67\x70\x72\x74\x35\x68\xfb\x11\xbb\x58\xb9\xbe\x04\xcf\x32\x28\xc5\x15\xfa\xcd\x1a\x9d\x93\xc5\x17\xd1\xb3\x52\x66\x25\xd4\x31\xa7\x9e\x13\x5e\x46\x92\x77\x9c\x1a\xbe\x87\x7c\xfb\xb6\x4d\x1a\x7f\xc4\x61\x0c\x66\x5e\xb2\x11\xd5\xce\x88\x81\x7b\x00\x51\x14\xb1\x15\x0a\x75\x7e\x03\x9f\x1b\x0d\xbb\x21\xe4\xb6\x2b\xea\x71\x1e\x94\x6a\x8b\xff\x90\x80\x1e\x10\x7b\xd2\xee\x7c\x85\x5c\x3b\xda\xce\x1c\x5b\x84\x2c\xe7\x7f\xd4\xa8\xb9\x9a\xda\xa0\x14\x88\x01\x96\xc0\x65\x98\x84\xd4\xdc\x64\x58\x83\xe4\x9e\x56\xed\xd4\x5e\x62\xee\x90\xb2\xfb\xbe\xd7\xa6\xc0\x25\x2a\xcc\xc9\xb8\xaf\x07\xbe\x75\xe6\x13\x67\x68\x29\xab\xaf\xa7\xc4\xae\x91\xdc\xea\xfb\xed\x98\xf6\x16\x3f\x06\xe9\xe2\xc8\xe4\x0f\xa2\x20\x96\x71\xd3\xd8\x40\xb8\x6f\x14\xee\x80\x76\x36\x20\xe0\xa6\x1c\xe1\xa5\x24\xf7\x80\xe1\xbb\xfb\x20\xc4\x4b\xe8\xcd\x9b\x72\xfc\x3a\xed\x70\xe2\x66\x5f\xf5\xea\xe5\x30\xdd\x28\xdc\x9d\x65\x82\x78\x68\xc8\xe0\xb7\xc9\xfb\x47\xd7\xe9\xf4\xd9\x64\x72\xf9\x5d\xfa\xf3\x87\x47\x93\xf7\xb3\xf8\xc7\xb7\xd3\x67\xd3\xeb\xed\xe6\xd1\x74\x3a\x99\x5c\xbe\x3a\x7b\x79\x71\xfe\xe2\x83\x9c\x5e\x5f\xea\xd0\x5c\xb5\xbb\xeb\xc9\x25\xbe\xf8\x70\x47\x26\xd3\xe9\xb3\x6f\x46\x55\xfa\x94\x5e\x85\x15\x3a\x8d\x84\x3e\x95\x9a\x52\xe3\xd2\xd6\x8a\x0c\xc8\x85\xa1\x2c\xbc\x35\x7c\xf8\xff\xb6\x43\xff\xef\xa6\xff\xb0\x9b\x6e\xbc\x26\xa3\xd0\xc5\x49\x7a\xd0\x31\x92\xb0\x19\xf1\x58\xaf\x42\x5c\xd4\x08\xd6\x14\xed\xa0\x77\xb1\xe3\x19\xe7\x4b\x22\x91\xd7\x58\x70\x5f\xe8\xa4\x71\xe7\xd0\x1b\xe0\x3e\x30\xa4\x30\x2f\xaa\x05\x41\x23\x28\xaf\xbb\x02\x43\x4e\x5a\x85\xf0\xe4\x0a\x37\x8f\x63\xd3\x7d\x8c\x65\x89\x39\x3d\x85\xe0\xb7\xa3\x6a\xa4\xe7\x0d\xc7\x9a\x20\x33\x16\x23\x4f\xb6\xf7\x4f\x87\x2a\xd3\xed\xb5\x09\xa0\x95\x3d\x76\x0b\x77\x89\xc0\x2b\xdc\x7c\xd6\xfb\xad\x0d\x9f\xc5\x64\xef\xfe\x25\xe6\x46\x17\xa3\x06\x03\xb7\xf8\x46\x50\x4c\xbf\x1f\x7f\x18\xa5\xba\x4b\x8a\x46\xf7\x7d\x86\xda\x77\x8a\x77\xe1\x9c\xd8\x24\x77\x7c\x58\x87\xd5\xab\xb0\x62\x04\x4a\x59\x0d\x4f\x16\xf7\x9f\x2f\xbb\x0e\xd8\xfb\x04\x4b\x0f\x67\xe3\xb1\xf3\x38\x33\xf7\x2e\x8f\x87\xdf\xe4\x16\x9b\xba\x1f\x05\x92\x91\x6c\x1d\xfa\xb2\x88\x2f\x7a\x13\xae\x59\x79\xfe\x82\xbe\xf3\xc7\xc5\x80\x26\x47\x47\xdd\x37\x7d\x06\xeb\x93\xfd\x2e\x86\x5c\xda\xfd\xd6\x13\x2f\x00\x5a\xc1\x07\x45\xcf\x93\x71\xa2\xc2\x0c\xc8\x05\x4c\xfe\x19\x00\xdf\x4c\x7a\xa8\xad\x12\x00\x00")
 
 func crdsAgentOpenClusterManagementIo_iampolicycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -158,7 +158,7 @@ func crdsAgentOpenClusterManagementIo_iampolicycontrollers_crdYaml() (*asset, er
 	return a, nil
 }
 
-var _crdsAgentOpenClusterManagementIo_policycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xc1\x8e\x1b\x37\x0c\xbd\xfb\x2b\x08\xe4\x90\x4b\x6d\x63\xd1\x4b\x31\xb7\x85\x53\xb4\xdb\x24\xdd\x45\xb6\xd8\xbb\x46\xa2\xc7\x6c\x34\x92\x2a\x51\x6e\xdd\xa2\xff\x5e\x50\x1a\xcf\x7a\xec\xf1\x6e\x50\x24\x73\x1b\x92\x22\x9f\x1e\xa9\x27\xbd\x81\x8d\x0f\x87\x48\xdd\x8e\x61\xe3\x1d\x47\x6a\x33\xfb\x98\x80\x3d\xf0\x0e\xe1\x3e\xa0\x83\x8d\xcd\x89\x31\xc2\x47\xe5\x54\x87\x3d\x3a\x86\x10\xfd\xef\xa8\x79\xb1\x50\x81\x9e\x30\x26\xf2\xae\x01\x15\x08\xff\x62\x74\xf2\x97\x56\x9f\x7f\x48\x2b\xf2\xeb\xfd\x4d\x8b\xac\x6e\x16\x9f\xc9\x99\x06\x36\x39\xb1\xef\x3f\x61\xf2\x39\x6a\x7c\x87\x5b\x72\xc4\xe4\xdd\xa2\x47\x56\x46\xb1\x6a\x16\x00\x4e\xf5\xd8\x40\xf0\x96\xf4\x41\x0b\x28\x6f\x2d\xc6\xb4\x52\x1d\x3a\x5e\xf9\x80\x6e\xa9\x2b\xa4\x65\x3f\x42\x5a\x91\x5f\xa4\x80\x5a\x12\x74\xd1\xe7\xd0\xc0\xab\xf1\xb5\x54\x92\x25\x00\x15\xe0\x43\xa9\xba\x19\xab\x16\x97\xa5\xc4\xef\x67\xdd\x1f\x28\x71\x09\x09\x36\x47\x65\x67\x50\x17\x6f\x22\xd7\x65\xab\xe2\xa5\x7f\x01\x90\xb4\x0f\xd8\xc0\xaf\x02\x25\x28\x8d\x46\x6c\xb9\x8d\x03\x49\x03\xbc\xc4\x8a\x73\x6a\xe0\x9f\x7f\x17\x00\x7b\x65\xc9\x28\x21\xae\x3a\x65\x8f\xb7\x0f\x77\x4f\xdf\x3f\xea\x1d\xf6\xaa\x1a\x01\x0c\x26\x1d\x29\x94\xb8\x0b\xe8\x40\xa9\xb4\xb8\x2e\x81\xad\x8f\xe5\xf7\x62\x03\x70\xfb\x70\x37\xe4\x0b\xd1\x07\x8c\x4c\x47\x4c\xf2\x9d\x0c\xc0\x68\x3b\xab\xfc\x56\xa0\xd5\x18\x30\xd2\x72\xac\x95\xf7\xd5\x86\x06\x52\xc5\xe0\xb7\xc0\x3b\x4a\x10\x31\x44\x4c\xe8\xb8\x6c\xf1\x24\x2d\x48\x88\x72\xe0\x5b\x99\xbe\x15\x3c\x62\x94\x24\x90\x76\x3e\x5b\x03\xda\xbb\x3d\x46\x86\x88\xda\x77\x8e\xfe\x1e\x33\x8f\xf3\x6c\x15\xe3\xd0\xb1\xe3\x47\x8e\x31\x3a\x65\x85\xd4\x8c\xdf\x81\x72\x06\x7a\x75\x80\x88\x52\x03\xb2\x3b\xc9\x56\x42\xd2\x0a\x3e\xfa\x88\x40\x6e\xeb\x1b\xd8\x31\x87\xd4\xac\xd7\x1d\xf1\x71\xe4\xb5\xef\xfb\xec\x88\x0f\x6b\x7d\x72\xa4\xd6\x06\xf7\x68\xd7\x89\xba\xa5\x8a\x7a\x47\x8c\x9a\x73\xc4\xb5\x0a\xb4\x2c\xc0\x1d\x97\x73\xd3\x9b\x37\x63\xeb\xdf\x9e\x20\xe5\x83\x4c\x49\xe2\x48\xae\x1b\xcd\x65\x68\xaf\xf2\x2e\x33\x2b\x6d\x56\xc3\xb2\x8a\xff\x99\x5e\x31\x09\x2b\x9f\x7e\x7c\xfc\x0d\x8e\x45\x4b\x0b\xa6\x9c\x17\xb6\x9f\x97\xa5\x67\xe2\x85\x28\x72\x5b\x8c\xb5\x71\xdb\xe8\xfb\x92\x11\x9d\x09\x9e\x1c\x97\x1f\x6d\x09\xdd\x94\xf4\x94\xdb\x9e\x58\x3a\xfd\x47\xc6\xc4\xd2\x9f\x15\x6c\x94\x73\x9e\xa1\x45\xc8\xc1\x28\x46\xb3\x82\x3b\x07\x1b\xd5\xa3\xdd\xa8\x84\xdf\x9c\x76\x61\x38\x2d\x85\xd2\xd7\x89\x3f\xd5\xab\x69\x60\x65\x6b\x34\x1f\x35\x69\xb6\x43\xe7\x67\xf2\x31\xa0\x9e\x1c\x11\x83\x89\xa2\x8c\x31\x2b\x46\x19\xfe\x59\x7d\xba\x7e\x3a\xe5\x1b\xb4\x4f\xf4\x65\xea\x00\xe8\xc9\x7d\x40\xd7\xf1\xae\x81\x9b\x33\xd7\xec\xae\xcf\xd2\x15\xb9\xfa\x1a\x39\x0d\x06\xeb\x0f\x68\xee\xdd\xcf\xb9\x3d\x4f\x58\x57\xb5\xde\x5b\x54\x53\x31\xd8\x66\x6b\x45\xc1\xef\xf7\x18\x23\x99\xaf\x02\xa5\xb3\xbe\x55\xf6\x3c\xd3\xa4\x6b\x3f\x95\x90\xa7\xa2\x05\x93\x6e\xd5\xb5\x83\x4a\x9c\x65\xb8\xd6\x1d\xf9\xa8\x57\xdd\xb8\x89\x19\x3f\x80\x32\xa6\xdc\x93\xca\x3e\xbc\x90\xe7\xc5\x8d\x9d\xba\xcf\x66\x74\x82\xe3\x21\x5b\x5b\xa7\x6c\xae\xc0\x74\x7c\xc7\xd0\xc1\xde\xa2\x88\x4d\xbd\x42\xca\x8d\x42\xdb\xf5\x9f\x3b\x74\x22\xbf\x21\x5b\x0b\x6a\x16\xb3\x9c\x57\x45\x4e\xee\x24\x41\x70\x15\xf6\x95\x5d\x8d\xb0\x1f\x51\x47\xe4\x39\xd8\x2f\xac\xbf\xca\xc8\x2e\xb7\xef\x73\x8b\xda\xbb\x2d\x75\xf3\xa9\xff\xcf\x80\x05\x9f\xf8\x1d\x5a\x64\xfc\xc5\xb7\xa2\xa3\xa4\xf1\x56\x6b\x9f\xdd\x45\xfa\x2b\x39\x44\x34\x45\x15\x4e\xc3\x97\x17\x87\x61\xe2\x9c\xd9\xcb\xab\xc2\x55\x1f\x1c\x5f\x2c\x5d\x25\x7c\x72\x1c\x7c\x9b\xe4\x9a\xf8\x32\xf5\x9a\xc1\x70\x66\xda\x1f\xdf\x99\xfb\x9b\xe7\xbf\x02\x70\x39\x3c\x19\xf7\xb5\x09\xb5\x6a\x03\x1c\x33\x0e\x6f\x27\x1f\x55\x87\x83\xe5\xbf\x00\x00\x00\xff\xff\xa9\x3f\x71\x57\xf4\x0a\x00\x00")
+var _crdsAgentOpenClusterManagementIo_policycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\x13\x37\x10\x7f\xcf\xa7\x18\x89\x4a\x5c\x0a\x9b\xf4\xd4\xaa\x6a\x57\x08\x84\x02\xa2\x14\xe8\x9d\xc8\x89\x97\x83\x4a\x5e\xef\xec\xc6\x3d\xaf\x6d\xec\x71\x4a\xda\xeb\x77\xaf\xc6\xbb\xc9\x65\x73\x9b\xbb\x50\xa8\xe8\x43\x31\x0f\x67\xcf\x78\xfe\xfc\x66\x3c\x33\x9b\x3b\x30\xb3\x6e\xe5\x55\xbd\x20\x98\x59\x43\x5e\x15\x91\xac\x0f\x40\x16\x68\x81\x70\xe2\xd0\xc0\x4c\xc7\x40\xe8\xe1\x95\x30\xa2\xc6\x06\x0d\x81\xf3\xf6\x37\x94\x34\x1a\x09\xa7\xde\xa0\x0f\xca\x9a\x1c\x84\x53\xf8\x81\xd0\xf0\x2e\x4c\x2e\x7e\x08\x13\x65\xa7\xcb\xe3\x02\x49\x1c\x8f\x2e\x94\x29\x73\x98\xc5\x40\xb6\x79\x8d\xc1\x46\x2f\xf1\x09\x56\xca\x28\x52\xd6\x8c\x1a\x24\x51\x0a\x12\xf9\x08\xc0\x88\x06\x73\x70\x56\x2b\xb9\x92\x6c\x94\xd5\x1a\x7d\x98\x88\x1a\x0d\x4d\xac\x43\x93\xc9\xd6\xa4\xac\xd9\x98\x34\x51\x76\x14\x1c\x4a\x16\x50\x7b\x1b\x5d\x0e\xb7\xf2\xb7\xaa\x02\x5f\x01\x68\x0d\x3c\x4d\x5a\x67\x1b\xad\x89\xa4\x55\xa0\x17\x83\xe4\x97\x2a\x50\x62\x71\x3a\x7a\xa1\x07\xac\x4e\xd4\xa0\x4c\x1d\xb5\xf0\xd7\xe9\x23\x80\x20\xad\xc3\x1c\x7e\x11\x0d\x06\x27\x24\x96\x7c\x16\x0b\xdf\x81\xd4\x99\x17\x48\x50\x0c\x39\xfc\xf9\xd7\x08\x60\x29\xb4\x2a\x05\x03\xd7\x12\xd9\xc7\xc7\xa7\xcf\xdf\x7c\x3b\x97\x0b\x6c\x12\x88\x7c\x5c\x62\x90\x5e\xb9\xc4\x77\xcd\x74\x50\x21\x85\xb8\xbd\x02\x95\xf5\x69\x7b\xcd\x01\x78\x7c\xfa\xbc\x93\xe7\xbc\x75\xe8\x49\xad\x21\xe3\xb5\x95\x00\x9b\xb3\x1d\xcd\x77\xd9\xb4\x96\x07\x4a\x0e\x39\xb6\x9a\x97\xed\x19\x96\x10\x5a\x1b\x6c\x05\xb4\x50\x01\x3c\x3a\x8f\x01\x0d\x25\x17\xb7\xc4\x02\xd8\x0a\x84\x01\x5b\x70\xf6\x4d\x60\x8e\x9e\x85\x40\x58\xd8\xa8\x4b\x90\xd6\x2c\xd1\x13\x78\x94\xb6\x36\xea\x8f\x8d\xe4\x4d\x3e\x6b\x41\x18\xa8\x27\x51\x19\x42\x6f\x84\x66\x50\x23\xde\x07\x61\x4a\x68\xc4\x0a\x3c\xb2\x0e\x88\x66\x4b\x5a\x62\x09\x13\x78\x65\x3d\x82\x32\x95\xcd\x61\x41\xe4\x42\x3e\x9d\xd6\x8a\xd6\x29\x2f\x6d\xd3\x44\xa3\x68\x35\x95\x5b\x4f\x6a\x5a\xe2\x12\xf5\x34\xa8\x3a\x13\x5e\x2e\x14\xa1\xa4\xe8\x71\x2a\x9c\xca\x92\xe1\x86\x9d\x0d\x93\xa6\xbc\xb3\x09\xfd\xdd\x2d\x4b\x69\xc5\x59\x12\xc8\x2b\x53\x6f\x8e\x53\xd2\xee\xc5\x9d\x73\x96\xc3\x2c\xba\x6b\xad\x8b\x57\xf0\xf2\x11\x07\xe2\xf5\xd3\xf9\x19\xac\x95\xa6\x10\x6c\x89\x84\x0e\xed\xab\x6b\xe1\x0a\x78\x06\x4a\x99\x0a\x39\x77\x54\x80\xca\xdb\x26\xe1\x8c\xa6\x74\x56\x19\x4a\x1b\xa9\x15\x9a\x3e\xe8\x21\x16\x8d\x22\x8e\xf4\xfb\x88\x81\x38\x3e\x13\x98\x09\x63\x2c\x41\x81\x10\x5d\x29\x08\xcb\x09\x3c\x37\x30\x13\x0d\xea\x99\x08\xf8\xaf\xc3\xce\x08\x87\x8c\x21\xbd\x1d\xf8\xed\x7a\xb5\xfe\xd7\x32\xb6\x68\x6d\x8e\xd7\x35\x69\x30\x42\xbb\x6f\x72\xee\x50\xf6\x9e\x48\x89\x41\x79\x4e\x63\x12\x84\x9c\xfc\xbb\x37\xb6\x24\x0f\xbd\x4e\x5e\x5d\xed\xe3\xfa\xd2\x27\x00\x34\xca\xbc\x44\x53\xd3\x22\x87\xe3\x1d\xd2\xa0\xd7\x3b\xe2\x52\xb9\xfa\x1c\x32\x4b\x74\xda\xae\xb0\x3c\x31\x3f\xc5\x62\x57\x60\x6b\x49\x61\xad\x46\x61\x7a\xb4\x2a\x6a\xcd\x15\xfc\x64\x89\xde\xab\xf2\xb3\xb8\x57\x6b\x5b\x08\xbd\x2b\xa9\x17\xb5\x67\x89\xe5\x0d\xbf\xa5\xd0\x8b\x56\x7b\xb7\x7d\x65\x61\x47\xc2\xbe\xe8\xf0\x52\x8d\xa8\x37\x4e\x0c\xd0\x01\x44\x59\xa6\x3e\x29\xf4\xe9\x0d\x72\x6e\x74\xec\x86\x1c\x5d\xaf\x64\xc7\x69\xd4\xba\xcd\xb2\x21\x05\xfd\xf4\xdd\xb0\x76\xe7\x05\x72\xb1\x69\x5b\x48\xea\x28\xaa\x9a\xfe\xbe\x40\xc3\xe5\xd7\x45\xad\x41\x0c\x88\x04\xae\xdb\x24\x94\x41\xdf\x5a\x30\xfa\x48\xaf\x36\x66\xcf\x51\x7a\xa4\xfc\x63\xef\x1b\x5b\xe2\x1c\x35\x4a\xb2\xfe\xcb\x81\xef\xbc\xfd\xc0\xed\xb9\x52\xf5\x97\x33\x62\xd3\x79\x6e\x8d\xfd\x7a\x86\x7b\x8d\xef\xa3\xf2\x69\xa4\x0a\x5b\x59\x90\xea\xbe\x6d\x5c\x24\xbc\xea\x2c\x7e\x8b\x77\x32\xa0\xe0\xa6\x37\xc2\x4b\x2b\x6e\x1a\xc3\xb4\x8f\x41\x88\x97\x30\xab\x93\x6a\x3f\x39\xeb\x70\xe2\xe9\xa0\xee\x95\xd9\x61\xbe\xbd\x70\x77\x9e\x09\xe2\x29\x23\x87\x5f\x8f\xde\xde\xbb\xcc\xc6\x8f\x8e\x8e\xce\xbf\xc9\x7e\x7c\x77\xef\xe8\xed\x24\xfd\xf1\xf5\xf8\xd1\xf8\x72\xbd\xb9\x37\x1e\x1f\x1d\x9d\xbf\x78\xf5\xec\xec\xf4\xe9\x3b\x35\xbe\x3c\x37\xb1\xb9\x68\x77\x97\x47\xe7\xf8\xf4\xdd\x81\x42\xc6\xe3\x47\x5f\xed\x35\xe9\x43\x76\x11\x0b\xf4\x06\x09\x43\xa6\x0c\x65\xd6\x67\xad\x17\x39\x90\x8f\xb8\xe7\xe2\x8d\xe9\xc3\xff\xd7\x2d\xfd\xff\x30\xfd\x87\xc3\x74\x23\x99\xac\x46\x9f\x46\xef\xc1\xc0\x28\xc2\x66\x4f\xc4\x7a\x15\xe2\x2c\x7d\x4d\x94\xed\x64\x78\xb6\x91\x99\x06\x52\x22\x21\x17\x58\x72\x5f\xe8\xb4\x71\xe7\x30\x2b\xe0\x3e\x30\x64\x30\x2f\x5a\x08\x82\x46\x90\x5c\x74\x05\x86\xbc\x72\x1a\xe1\xc1\x05\xae\xee\xa7\xa6\x7b\x1f\xab\x0a\x25\x3d\x84\x18\xd6\xb3\x6d\xe2\xe7\x0d\xe7\x9a\x20\xbb\x2f\x47\x1e\xac\xe9\x0f\x87\x2a\xd3\xed\xb5\x09\xa0\xd5\xbd\x8f\x0a\x87\x64\xe0\x05\xae\x3e\xe9\xfe\xda\x87\x4f\x12\x72\x15\xfe\x39\x4a\x6b\xca\xbd\x0e\x03\xb7\xf8\x46\x50\x7a\x7e\xdf\x7f\xb7\x97\xeb\x90\x27\x9a\xc2\xf7\x09\x66\x1f\x94\xef\xc2\x7b\xb1\x1a\x1d\x78\x71\x11\x8b\x17\xb1\x60\x04\x2a\x55\x0f\x4f\x16\xff\x64\xbe\x74\x36\xd0\x13\xd4\x48\xf8\xb3\x2d\xf8\x33\x4a\x49\x7c\x2c\xa5\x8d\x86\xf2\xc3\x64\x74\x5d\xb4\xf7\xdd\x97\x5d\x9b\x85\x7b\xc4\x01\x5f\x46\xb7\x40\xd0\xfd\xde\x30\xda\xf3\xb8\x77\xbf\x43\xe6\x89\xbd\x37\x0d\xdb\x22\xf0\xe7\xf9\x61\x1f\x2f\x03\x36\xec\x1c\x75\xbf\x16\xe4\xb0\x3c\xbe\xda\xa5\xdc\xcc\xba\x5f\x8c\x12\x01\xa0\xd5\xba\x55\x1d\x03\x59\x2f\x6a\xcc\x81\x7c\xc4\xd1\xdf\x03\x00\x9c\x9a\xf9\xe4\xf3\x12\x00\x00")
 
 func crdsAgentOpenClusterManagementIo_policycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -178,7 +178,7 @@ func crdsAgentOpenClusterManagementIo_policycontrollers_crdYaml() (*asset, error
 	return a, nil
 }
 
-var _crdsAgentOpenClusterManagementIo_searchcollectors_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcd\x8e\xdb\x36\x10\xbe\xfb\x29\x06\xc8\x21\x97\xda\xc6\xa2\x97\x42\xb7\xc0\x2d\x8a\x45\x92\xc6\x88\x8b\xbd\x53\xd4\x58\x9a\x86\x22\x59\x72\xe8\xd6\x2d\xfa\xee\xc5\x90\xb2\x2d\x29\xf2\x6e\x0a\x6c\x75\xe3\xfc\x7e\xfc\xe6\x47\x7c\x03\x3b\xe7\xcf\x81\xda\x8e\x61\xe7\x2c\x07\xaa\x13\xbb\x10\x81\x1d\x70\x87\xf0\xc9\xa3\x85\x9d\x49\x91\x31\xc0\x47\x65\x55\x8b\x3d\x5a\x06\x1f\xdc\x6f\xa8\x79\xb5\x52\x9e\x9e\x30\x44\x72\xb6\x02\xe5\x09\xff\x64\xb4\x72\x8a\x9b\x2f\x3f\xc4\x0d\xb9\xed\xe9\xa1\x46\x56\x0f\xab\x2f\x64\x9b\x0a\x76\x29\xb2\xeb\x3f\x63\x74\x29\x68\xfc\x11\x8f\x64\x89\xc9\xd9\x55\x8f\xac\x1a\xc5\xaa\x5a\x01\x58\xd5\x63\x05\x11\x55\xd0\x9d\x76\xc6\xa0\x16\x48\x1b\xd5\xa2\xe5\x8d\xf3\x68\xd7\xba\x20\x5a\xf7\x57\x44\x1b\x72\xab\xe8\x51\x8b\x7f\x1b\x5c\xf2\x15\xbc\x68\x5f\x32\x45\x71\x01\x28\xf8\x0e\x39\xe9\xee\x92\x34\x6b\x0c\x45\x7e\xbf\xa4\xfd\x40\x91\xb3\x85\x37\x29\x28\xf3\x35\xe4\xac\x8c\x64\xdb\x64\x54\xf8\x4a\xbd\x02\x88\xda\x79\xac\xe0\x17\x81\xe1\x95\xc6\x46\x64\xa9\x0e\x03\x3f\x03\xb4\xc8\x8a\x53\xac\xe0\xef\x7f\x56\x00\x27\x65\xa8\x51\xc2\x59\x51\xca\xfd\xde\xed\x1f\x9f\xbe\x3f\xe8\x0e\x7b\x55\x84\x00\x0d\x46\x1d\xc8\x67\xbb\x39\x6e\xa0\x98\x8b\x5b\x3c\xe0\xe8\x42\x3e\xce\xd1\xc3\xbb\xfd\xe3\x10\xcd\x07\xe7\x31\x30\x5d\x10\xc9\x37\xaa\xfc\x55\x36\xcb\xfb\x56\x80\x15\x1b\x68\xa4\xd6\x58\x12\x9f\x8a\x0c\x1b\x88\x05\x82\x3b\x02\x77\x14\x21\xa0\x0f\x18\xd1\x72\xbe\xe0\x28\x2c\x88\x89\xb2\xe0\x6a\x69\xbb\x0d\x1c\x30\x48\x10\x88\x9d\x4b\xa6\x01\xed\xec\x09\x03\x43\x40\xed\x5a\x4b\x7f\x5d\x23\x5f\x1b\xd9\x28\xc6\xa1\x5a\x97\x8f\x2c\x63\xb0\xca\x08\xa5\x09\xbf\x03\x65\x1b\xe8\xd5\x19\x02\x4a\x0e\x48\x76\x14\x2d\x9b\xc4\x0d\x7c\x74\x01\x81\xec\xd1\x55\xd0\x31\xfb\x58\x6d\xb7\x2d\xf1\xa5\xd7\xb5\xeb\xfb\x64\x89\xcf\x5b\x3d\x9a\xa5\x6d\x83\x27\x34\xdb\x48\xed\x5a\x08\x26\x46\xcd\x29\xe0\x56\x79\x5a\x67\xe0\x96\xf3\xc0\xf4\xcd\x9b\x6b\xe1\xdf\x8e\x90\xf2\x59\x7a\x24\x72\x20\xdb\x5e\xc5\xb9\x5d\xef\xf2\x2e\xed\x2a\x55\x56\x83\x5b\xc1\x7f\xa3\x57\x44\xc2\xca\xe7\x9f\x0e\xbf\xc2\x25\x69\x2e\xc1\x94\xf3\xcc\xf6\xcd\x2d\xde\x88\x17\xa2\xc8\x1e\x31\x94\xc2\x1d\x83\xeb\x73\x44\xb4\x8d\x77\x64\x39\x1f\xb4\x21\xb4\x53\xd2\x63\xaa\x7b\x62\xa9\xf4\xef\x09\x23\x4b\x7d\x36\xb0\x53\xd6\x3a\x86\x1a\x21\xf9\x46\x31\x36\x1b\x78\xb4\xb0\x53\x3d\x9a\x9d\x8a\xf8\xbf\xd3\x2e\x0c\xc7\xb5\x50\xfa\x32\xf1\xe3\x45\x35\x35\x2c\x6c\x5d\xc5\x97\x6d\xb4\x58\xa1\xd9\x44\x1e\x3c\xea\xc9\x84\x34\x18\x29\x48\x17\xb3\x62\x94\xde\x5f\x5a\x4c\xf7\x67\x53\xbe\x61\xe7\xc9\x6e\x99\x2a\x00\x7a\xb2\x1f\xd0\xb6\xdc\x55\xf0\x30\x53\x2d\xde\x79\x16\x2e\xaf\xaa\xd7\x88\x79\x4c\xc6\xc8\x0a\xfe\x74\xc2\x10\xa8\x79\x95\x98\xad\x71\xb5\x32\xf3\x48\x13\xf2\x7f\xce\x26\x4f\x79\xa4\x27\xac\x17\xdf\x61\xd8\x67\x11\xee\xd1\x2c\x1f\xf5\xaa\xbd\x5e\x62\x41\x0f\xa0\x9a\x26\xff\xe7\x94\xd9\x3f\x13\xe7\xd9\x8b\x8d\xd5\xb3\x56\x9b\xe0\xd8\x27\x63\xf6\xce\x90\x3e\x2f\x25\x98\x10\x71\x33\x1d\xe4\x35\xca\xce\xf0\x45\x24\xff\x05\x3a\x6e\xff\xe8\xd0\xca\x16\xf5\xc9\x18\x50\x8b\x98\x65\xec\x14\x59\x0c\x05\xc1\x5d\xd8\x77\x6e\x75\x85\x7d\x40\x1d\x90\x97\x60\x3f\xe3\x7f\x97\x91\x2e\xd5\xef\x53\x8d\xda\xd9\x23\xb5\xcb\xa1\xff\x7b\x83\xc9\xde\x92\xc9\x1c\x47\x5a\x8f\x87\xe3\x9e\x3c\x0f\xcd\x44\x39\xef\xfe\x89\x72\x01\xfc\x8b\x0b\xa7\x3c\x13\xbe\x75\xe5\x64\xeb\x49\xfb\xbb\x3a\xca\x76\xff\xa6\xad\xb3\x80\x60\x26\x3a\x5d\x9e\x85\xa7\x87\xdb\x29\xc3\x5b\x0f\x2f\xbc\x53\xe1\xbc\x24\xad\x80\x43\xc2\xe1\xbd\xe3\x82\x6a\x71\x90\xfc\x1b\x00\x00\xff\xff\x92\x4a\xe1\xed\xa3\x0a\x00\x00")
+var _crdsAgentOpenClusterManagementIo_searchcollectors_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\xdb\x36\x0c\x7f\xcf\xa7\x20\xd0\x01\x4d\xd6\x3a\xd9\x61\xc3\xb0\x19\x45\x8b\x22\x2b\x8a\xa2\xed\x7a\x68\x0e\x7d\xb9\x76\x80\x62\xd3\xb6\x76\xb2\xa4\x4a\x54\xd6\x6c\xb7\xef\x3e\x50\xb6\x13\x3b\x75\xee\x0f\xae\x43\xf7\xb0\x53\x1f\x2a\x91\xe2\x9f\x1f\x29\x92\xce\x3d\x58\x1a\xbb\x75\xb2\xac\x08\x96\x46\x93\x93\xeb\x40\xc6\x79\x20\x03\x54\x21\xbc\xb1\xa8\x61\xa9\x82\x27\x74\xf0\x5a\x68\x51\x62\x8d\x9a\xc0\x3a\xf3\x3b\x66\x34\x99\x08\x2b\xdf\xa1\xf3\xd2\xe8\x14\x84\x95\xf8\x89\x50\xf3\xce\xcf\x2f\x7e\xf2\x73\x69\x16\x9b\x93\x35\x92\x38\x99\x5c\x48\x9d\xa7\xb0\x0c\x9e\x4c\xfd\x16\xbd\x09\x2e\xc3\x5f\xb0\x90\x5a\x92\x34\x7a\x52\x23\x89\x5c\x90\x48\x27\x00\x5a\xd4\x98\x82\x47\xe1\xb2\x2a\x33\x4a\x61\xc6\x26\xcd\x45\x89\x9a\xe6\xc6\xa2\x4e\xb2\xc6\xa2\xa4\xde\x59\x34\x97\x66\xe2\x2d\x66\x7c\xbf\x74\x26\xd8\x14\xae\xe5\x6f\x34\x79\xbe\x02\xd0\xd8\xb7\x8a\x4a\x97\x9d\xd2\x48\x51\xd2\xd3\xcb\x31\xea\x2b\xe9\x29\x72\x58\x15\x9c\x50\x9f\x9b\x1c\x89\x5e\xea\x32\x28\xe1\x3e\x23\x4f\x00\x7c\x66\x2c\xa6\xf0\xab\xa8\xd1\x5b\x91\x61\xce\x67\x61\xed\x5a\x7c\x5a\xd3\x3c\x09\x0a\x3e\x85\xbf\xfe\x9e\x00\x6c\x84\x92\xb9\x60\xcc\x1a\x22\xfb\xf7\xf4\xf4\xc5\xbb\xef\x57\x59\x85\x75\xc4\x8f\x8f\x73\xf4\x99\x93\x36\xf2\x1d\xda\x0d\xd2\xc7\xe0\x36\x37\xa0\x30\x2e\x6e\x0f\xad\x87\xa7\xa7\x2f\x5a\x69\xd6\x19\x8b\x8e\x64\x07\x16\xaf\x5e\xe4\x77\x67\x07\x7a\xef\xb3\x61\x0d\x0f\xe4\x1c\x6b\x6c\x14\x6f\x9a\x33\xcc\xc1\x37\x26\x98\x02\xa8\x92\x1e\x1c\x5a\x87\x1e\x35\x45\x07\x7b\x62\x01\x4c\x01\x42\x83\x59\x73\xda\xcd\x61\x85\x8e\x85\x80\xaf\x4c\x50\x39\x64\x46\x6f\xd0\x11\x38\xcc\x4c\xa9\xe5\x9f\x3b\xc9\xbb\x44\x56\x82\xd0\xd3\x40\xa2\xd4\x84\x4e\x0b\xc5\x90\x06\x7c\x08\x42\xe7\x50\x8b\x2d\x38\x64\x1d\x10\x74\x4f\x5a\x64\xf1\x73\x78\x6d\x1c\x82\xd4\x85\x49\xa1\x22\xb2\x3e\x5d\x2c\x4a\x49\x5d\xae\x67\xa6\xae\x83\x96\xb4\x5d\x64\xbd\xb7\xb4\xc8\x71\x83\x6a\xe1\x65\x99\x30\xc0\x92\x30\xa3\xe0\x70\x21\xac\x4c\xa2\xe1\x9a\x9d\xf5\xf3\x3a\xbf\xb7\x0b\xfc\xfd\x9e\xa5\xb4\xe5\x1c\xf1\xe4\xa4\x2e\x77\xc7\x31\x5d\x8f\xe2\xce\xe9\xca\x51\x16\xed\xb5\xc6\xc5\x3d\xbc\x7c\xc4\x81\x78\xfb\x6c\x75\x06\x9d\xd2\x18\x82\x9e\x48\x68\xd1\xde\x5f\xf3\x7b\xe0\x19\x28\xa9\x0b\xe4\xd4\x91\x1e\x0a\x67\xea\x88\x33\xea\xdc\x1a\xa9\x29\x6e\x32\x25\x51\x0f\x41\xf7\x61\x5d\x4b\xe2\x48\x7f\x0c\xe8\x89\xe3\x33\x87\xa5\xd0\xda\x10\xac\x11\x82\xcd\x05\x61\x3e\x87\x17\x1a\x96\xa2\x46\xb5\x14\x1e\xff\x75\xd8\x19\x61\x9f\x30\xa4\xd7\x03\xdf\x2f\x54\xdd\x5f\xc3\xd8\xa0\xb5\x3b\xee\xaa\xd1\x68\x84\x0e\x5e\xe4\xca\x62\x36\x78\x21\x39\x7a\xe9\x38\x8b\x49\x10\x72\xee\x1f\x5c\xe8\xc9\x1d\x7b\x9b\xbc\xda\x9a\xc7\xb5\x65\x48\x00\xa8\xa5\x7e\x85\xba\xa4\x2a\x85\x93\x03\xd2\xa8\xcf\x07\xe2\x62\xa9\xfa\x12\x32\x8b\xa0\x14\x97\xe0\x37\x1b\x74\x4e\xe6\x5f\xc4\xce\x52\x99\xb5\x50\x87\x92\x06\xe0\x3f\x8f\x2c\xef\xf8\x49\xf8\x01\xea\xcd\xdd\xe6\xb1\xf8\x03\x09\xc7\x60\xe6\x25\x6b\x51\xee\x9c\x18\xa1\x03\x88\x3c\x8f\x7d\x4e\xa8\xd3\x2b\xe4\x5c\xe9\xd8\x15\xa9\xd6\xad\x68\xc7\x69\x50\xea\xd4\x28\x99\x6d\xc7\x14\x0c\x80\xd8\xb3\xb6\xe7\x6b\xe4\x9a\x61\xe3\xed\xd8\x17\x64\xb1\xf8\xa3\x42\xcd\x55\xd4\x06\xa5\x40\x8c\x88\x04\x2e\xbf\x24\xa4\x46\xd7\x58\x30\xb9\xa5\x57\x3b\xb3\x57\x98\x39\xa4\xf4\xb6\xf7\xb5\xc9\x71\x85\x4d\x6f\xfb\x7a\xe0\x5b\x67\x3e\x6d\x97\x46\x17\xb2\xfc\x7a\x46\xec\x1a\xc8\xb5\xb1\xef\x66\xb0\xb7\xf8\x31\x48\x17\x67\x22\xdf\xcb\x82\x58\xbe\x4d\x6d\x03\xe1\xbe\x41\xb8\x1e\xef\x7c\x44\xc1\x55\x6f\x84\x97\x92\x5c\xfb\xc7\x69\xb7\x41\x88\x97\xd0\xdb\x37\xc5\x71\x72\xd2\xe2\xc4\x4d\xbe\x44\x77\x2d\xdf\x51\xb8\x5b\xcf\x04\xf1\xb0\x90\xc2\x6f\xd3\xf7\x0f\x2e\x93\xd9\x93\xe9\xf4\xfc\xbb\xe4\xe7\x0f\x0f\xa6\xef\xe7\xf1\x3f\xdf\xce\x9e\xcc\x2e\xbb\xcd\x83\xd9\x6c\x3a\x3d\x7f\xf9\xfa\xf9\xd9\xe9\xb3\x0f\x72\x76\x79\xae\x43\x7d\xd1\xec\x2e\xa7\xe7\xf8\xec\xc3\x0d\x85\xcc\x66\x4f\xbe\x39\x6a\xd2\xa7\xe4\x22\xac\xd1\x69\x24\xf4\x89\xd4\x94\x18\x97\x34\x5e\xa4\x40\x2e\xe0\x91\x8b\x57\xa6\x0f\xff\xeb\x3a\xf3\xff\x61\xfa\x0f\x87\xe9\x4a\x32\x19\x85\x2e\x4e\xd0\xa3\x81\x91\x84\xf5\x91\x88\x0d\x2a\xc4\x59\x85\x60\x4d\xde\x0c\x78\x67\x3b\x99\x71\xae\x24\x12\x59\x85\x39\xf7\x85\x56\x1b\x77\x0e\xbd\x05\xee\x03\x63\x06\xf3\xa2\x4a\x10\xd4\x82\xb2\xaa\x2d\x30\xe4\xa4\x55\x08\x8f\x2e\x70\xfb\x30\x36\xdd\x87\x58\x14\x98\xd1\x63\x08\xbe\x1b\x51\x23\x3f\x6f\x38\xd7\xc4\x70\xf4\xe9\xff\x3d\xea\xe8\x8f\xc7\x2a\xd3\xf5\xb5\x09\xa0\xd1\x7d\x8c\x0a\x37\xc9\xc0\x0b\xdc\xde\xe9\x7e\xe7\xc3\x9d\x84\xec\xc3\xbf\xc2\xcc\xe8\xfc\xa8\xc3\xc0\x2d\xbe\x16\x14\x9f\xdf\x8f\x3f\x1c\xe5\xba\xc9\x13\x8d\xe1\xbb\x83\xd9\x37\xca\x77\xe1\x9c\xd8\x4e\x6e\x78\xb1\x0a\xeb\x97\x61\xcd\x08\x14\xb2\x1c\x9f\x2c\x6e\x3f\x5f\xb6\x1d\x70\xf0\xe9\x95\xf4\x67\xe3\x63\xe7\x71\x66\x1e\x10\x0f\x87\xdf\x01\x71\xc4\xf8\xc9\x35\x3e\xb7\xbf\x12\x4c\x8e\xbc\xe6\xc3\x2f\x8e\xc8\x3d\x98\x7e\xcd\xda\xf3\x57\xf5\x8d\x3e\x3a\x46\x2c\x38\x38\x6a\xbf\xf1\x53\xd8\x9c\xec\x77\x31\x15\x93\xf6\x07\x9e\x48\x00\x68\x94\xf6\x8a\xa1\x27\xe3\x44\x89\x29\x90\x0b\x38\xf9\x67\x00\x79\x3f\x44\x15\xa2\x12\x00\x00")
 
 func crdsAgentOpenClusterManagementIo_searchcollectors_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -218,7 +218,7 @@ func crdsAgentOpenClusterManagementIo_v1_klusterletaddonconfig_crYaml() (*asset,
 	return a, nil
 }
 
-var _crdsAgentOpenClusterManagementIo_workmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x8f\xdb\x46\x0f\xbd\xfb\x57\x10\xc8\x21\x97\xcf\x36\x16\xdf\xa5\xd0\x2d\x70\x8b\x62\x91\x4d\x63\xc4\xc5\xf6\x3c\x92\x68\x89\xdd\xd1\xcc\x94\xc3\x71\xea\x16\xfd\xef\x05\x47\xb2\x2c\x19\x72\x36\x2d\x52\xdd\x44\x72\xc8\x37\x7c\xe4\x93\xde\xc0\xce\x87\x33\x53\xd3\x0a\xec\xbc\x13\xa6\x32\x89\xe7\x08\xe2\x41\x5a\x84\x8f\x01\x1d\xec\x6c\x8a\x82\x0c\x1f\x8c\x33\x0d\x76\xe8\x04\x02\xfb\x5f\xb1\x92\xd5\xca\x04\x7a\x46\x8e\xe4\x5d\x01\x26\x10\xfe\x2e\xe8\xf4\x2d\x6e\x5e\xbe\x8b\x1b\xf2\xdb\xd3\x43\x89\x62\x1e\x56\x2f\xe4\xea\x02\x76\x29\x8a\xef\x3e\x61\xf4\x89\x2b\xfc\x1e\x8f\xe4\x48\xc8\xbb\x55\x87\x62\x6a\x23\xa6\x58\x01\x38\xd3\x61\x01\x9f\x3d\xbf\x74\xb9\x22\xc7\x8d\x69\xd0\xc9\xc6\x07\x74\xeb\xaa\x47\xb3\xee\x46\x34\x1b\xf2\xab\x18\xb0\xd2\xb3\x0d\xfb\x14\x0a\x78\x35\xbe\xaf\x12\xf5\x08\x40\x8f\xed\x17\xcf\x2f\xfd\x15\x39\x5b\x2d\x45\x79\x7f\xeb\x79\xa2\x28\xd9\x1b\x6c\x62\x63\xe7\x30\xb3\x23\x92\x6b\x92\x35\x3c\x73\xad\x00\x62\xe5\x03\x16\xf0\x93\x96\x0d\xa6\xc2\x5a\x6d\xa9\xe4\xa1\x17\x03\x94\x28\x46\x52\x2c\xe0\xcf\xbf\x56\x00\x27\x63\xa9\x36\xda\x9f\xde\xa9\xf7\x79\xb7\x7f\x7c\xfe\xff\xa1\x6a\xb1\x33\xbd\x11\xa0\xc6\x58\x31\x85\x1c\x37\xc5\x0a\x14\x33\x89\x7d\x34\x1c\x3d\xe7\xd7\x29\x62\x78\xb7\x7f\x1c\xb2\x04\xf6\x01\x59\xe8\x82\x44\x9f\x09\xbb\xa3\xed\xa6\xde\x5b\x05\xd4\xc7\x40\xad\x7c\x62\x5f\xf4\xd4\xdb\xb0\x86\xd8\x97\xf7\x47\x90\x96\x22\x30\x06\xc6\x88\x4e\xf2\xc5\x26\x69\x41\x43\x8c\x03\x5f\xea\x68\x6d\xe0\x80\xac\x49\x20\xb6\x3e\xd9\x1a\x2a\xef\x4e\xc8\x02\x8c\x95\x6f\x1c\xfd\x31\x66\x1e\x87\xd5\x1a\xc1\x81\x9d\xcb\x43\x4e\x90\x9d\xb1\xda\xca\x84\xff\x03\xe3\x6a\xe8\xcc\x19\x18\xb5\x06\x24\x37\xc9\x96\x43\xe2\x06\x3e\x78\x46\x20\x77\xf4\x05\xb4\x22\x21\x16\xdb\x6d\x43\x72\x99\xe7\xca\x77\x5d\x72\x24\xe7\x6d\x35\xd9\x97\x6d\x8d\x27\xb4\xdb\x48\xcd\xda\x70\xd5\x92\x60\x25\x89\x71\x6b\x02\xad\x33\x70\x27\x79\x29\xba\xfa\xcd\x48\xf8\xdb\x09\x52\x39\xeb\x6c\x44\x61\x72\xcd\x68\xce\x63\x79\xb7\xef\x3a\x9a\xca\xb0\x19\x8e\xf5\xf8\xaf\xed\x55\x93\x76\xe5\xd3\x0f\x87\x9f\xe1\x52\x34\x53\x30\xef\x79\xee\xf6\xf5\x58\xbc\x36\x5e\x1b\x45\xee\x88\xdc\x13\x77\x64\xdf\xe5\x8c\xe8\xea\xe0\xc9\x49\x7e\xa9\x2c\xa1\x9b\x37\x3d\xa6\xb2\x23\x51\xa6\x7f\x4b\x18\x45\xf9\xd9\xc0\xce\x38\xe7\x05\x4a\x84\x14\x6a\x23\x58\x6f\xe0\xd1\xc1\xce\x74\x68\x77\x26\xe2\x7f\xde\x76\xed\x70\x5c\x6b\x4b\x5f\x6f\xfc\x54\x8c\xe6\x81\x7d\xb7\x46\xf3\x45\x75\x16\x19\x9a\x6c\xe2\x21\x60\x35\xdb\x8e\x1a\x23\xb1\x4e\xb0\x18\x41\x9d\xfb\x5b\xf1\xb9\xbf\x93\xfa\x0c\x9a\xf6\x64\x4a\xb4\x37\x2e\x00\x53\xd7\x59\x53\x8d\xdd\xdf\x39\x7e\xf7\xe2\x5f\xb8\xea\xa4\xac\x4a\xd8\x6d\xc2\x8e\xdc\x13\xba\x46\xda\x02\x1e\x16\xd3\x2d\x54\x9a\xa4\xcb\x8a\xf8\x2d\x72\x1e\x93\xb5\xaa\xec\x1f\x4f\xc8\x4c\xf5\x37\xc9\xd9\x58\x5f\x1a\x7b\x9b\x69\xc6\xf5\x8f\x39\xe4\x39\x2b\xc8\x8c\xe8\xfe\xec\xa0\x2d\x37\x19\xee\xb1\xab\x0f\x75\xa6\x19\x2f\xb1\xe0\xff\x5a\x9a\xbf\x78\xb1\xa9\x7b\x81\xee\x11\xc7\x3e\x59\xbb\xf7\x96\xaa\xf3\x52\x81\x59\x23\xae\xa1\x83\xbd\x44\x95\xa8\xd0\x9b\xf4\x13\x44\xc7\xed\xe7\x16\x9d\x8a\x76\x48\xd6\x82\x59\xc4\xac\x5b\x6e\xc8\xe9\x47\x4c\x11\xdc\x85\x7d\xe7\x56\x23\xec\x03\x56\x8c\xb2\x04\xfb\xdf\x2c\x40\x9b\xca\xf7\xa9\xc4\xca\xbb\x23\x35\xcb\xa9\xff\xf9\x80\xa9\x4c\xaa\x18\x4c\x33\xad\xe7\x2b\xbe\xe4\xd1\xb5\xb9\x67\xcf\xeb\x34\x73\xde\xee\xc5\xcc\xb9\x70\xad\x57\x95\xaf\xff\x4f\xf9\x1a\xed\xcb\x91\xb3\xa5\xf0\x65\xd4\x4f\xcc\xab\xf2\xb7\x50\xf9\xc6\x74\xba\xfc\x7b\x9e\x1e\xae\x6f\x19\xd6\x7a\xf8\x8d\x3c\xf5\x2c\xf4\x05\x0b\x10\x4e\x38\xfc\x68\x79\x36\x0d\x0e\x96\xbf\x03\x00\x00\xff\xff\x8f\x3a\x8c\xc2\x08\x0b\x00\x00")
+var _crdsAgentOpenClusterManagementIo_workmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x6f\x8f\xd3\x46\x13\x7f\xef\x4f\x31\x12\x8f\x44\xf2\x80\x93\xe7\xf4\x54\x55\x6b\x21\x10\x4a\x11\x42\x40\x39\x91\x13\x7d\x71\x50\x69\x63\x8f\xed\x6d\xd6\xbb\x66\x77\x36\x90\xf6\xfa\xdd\xab\x59\x3b\x89\x1d\xd9\x97\x9c\xa0\xa2\x2f\xca\xf2\xe2\x76\x67\x76\xfe\xfc\x66\x76\x66\x9c\x7b\xb0\x30\xf5\xd6\xca\xa2\x24\x58\x18\x4d\x56\xae\x3c\x19\xeb\x80\x0c\x50\x89\xf0\xa6\x46\x0d\x0b\xe5\x1d\xa1\x85\xd7\x42\x8b\x02\x2b\xd4\x04\xb5\x35\xbf\x61\x4a\x51\x24\x6a\xf9\x0e\xad\x93\x46\x27\x20\x6a\x89\x9f\x09\x35\xef\xdc\x6c\xfd\x83\x9b\x49\x33\xdf\x5c\xac\x90\xc4\x45\xb4\x96\x3a\x4b\x60\xe1\x1d\x99\xea\x2d\x3a\xe3\x6d\x8a\x3f\x61\x2e\xb5\x24\x69\x74\x54\x21\x89\x4c\x90\x48\x22\x00\x2d\x2a\x4c\xe0\x93\xb1\xeb\x2a\x68\xb4\x6e\x26\x0a\xd4\x34\x33\x35\xea\x38\x6d\xac\x89\xab\xbd\x35\x33\x69\x22\x57\x63\xca\x77\x0b\x6b\x7c\x9d\xc0\x49\xfe\x46\x8b\xe3\x2b\x00\x8d\x6d\xbf\x18\xbb\x6e\x5c\xb4\xe1\x54\x49\x47\x2f\x8f\x29\xaf\xa4\xa3\x40\xad\x95\xb7\x42\xf5\xcd\x0c\x04\x27\x75\xe1\x95\xb0\x3d\x52\x04\xe0\x52\x53\x63\x02\x3f\x8b\x0a\x5d\x2d\x52\xcc\xf8\xcc\xaf\x6c\x8b\x45\x6b\x8a\x23\x41\xde\x25\xf0\xc7\x9f\x11\xc0\x46\x28\x99\x09\xc6\xa7\x21\xb2\x3f\x4f\x2f\x5f\xbc\xfb\xff\x32\x2d\xb1\x0a\x58\xf1\x71\x86\x2e\xb5\xb2\x0e\x7c\x5d\x5b\x41\xba\x10\xc4\x86\x1b\x72\x63\xc3\xb6\x6b\x31\x3c\xbd\x7c\xd1\x4a\xa9\xad\xa9\xd1\x92\xdc\x81\xc2\xab\x13\xdd\xfd\xd9\x91\xbe\xfb\x6c\x50\xc3\x03\x19\xc7\x13\x1b\xa5\x9b\xe6\x0c\x33\x70\x8d\x7a\x93\x03\x95\xd2\x81\xc5\xda\xa2\x43\x4d\xc1\xb1\x8e\x58\x00\x93\x83\xd0\x60\x56\x9c\x5a\x33\x58\xa2\x65\x21\xe0\x4a\xe3\x55\x06\xa9\xd1\x1b\xb4\x04\x16\x53\x53\x68\xf9\xfb\x5e\xf2\x3e\x59\x95\x20\x74\xd4\x93\x28\x35\xa1\xd5\x42\x31\x94\x1e\x1f\x82\xd0\x19\x54\x62\x0b\x16\x59\x07\x78\xdd\x91\x16\x58\xdc\x0c\x5e\x1b\x8b\x20\x75\x6e\x12\x28\x89\x6a\x97\xcc\xe7\x85\xa4\x5d\x3e\xa7\xa6\xaa\xbc\x96\xb4\x9d\xa7\x9d\xf7\x32\xcf\x70\x83\x6a\xee\x64\x11\x0b\x9b\x96\x92\x30\x25\x6f\x71\x2e\x6a\x19\x07\xc3\x35\x3b\xeb\x66\x55\x76\x6f\x1f\xf0\xfb\x1d\x4b\x69\xcb\xb9\xe1\xc8\x4a\x5d\xec\x8f\x43\x5a\x8e\xe2\xce\xa9\xc9\x11\x16\xed\xb5\xc6\xc5\x03\xbc\x7c\xc4\x81\x78\xfb\x6c\x79\x05\x3b\xa5\x21\x04\x1d\x91\xd0\xa2\x7d\xb8\xe6\x0e\xc0\x33\x50\x52\xe7\xc8\x69\x23\x1d\xe4\xd6\x54\x01\x67\xd4\x59\x6d\xa4\xa6\xb0\x49\x95\x44\xdd\x07\xdd\xf9\x55\x25\x89\x23\xfd\xd1\xa3\x23\x8e\xcf\x0c\x16\x42\x6b\x43\xb0\x42\xf0\x75\x26\x08\xb3\x19\xbc\xd0\xb0\x10\x15\xaa\x85\x70\xf8\xb7\xc3\xce\x08\xbb\x98\x21\x3d\x0d\x7c\xb7\x18\xed\xfe\x35\x8c\x0d\x5a\xfb\xe3\x5d\xd5\x19\x8c\x50\xe7\x25\x2e\x6b\x4c\x7b\xaf\x23\x43\x27\x2d\x67\x30\x09\x42\xce\xfb\x0e\x73\x47\xde\xd0\x9b\xe4\xd5\xd6\xb4\x57\x62\x85\xea\x88\x04\x20\xb2\x2c\xd4\x54\xa1\x2e\x47\xae\x8f\x3a\x7e\x8b\xab\x1d\xb5\x5c\xc2\x8e\x05\x56\x52\xbf\x42\x5d\x50\x99\xc0\x45\x74\xa6\xa6\x8e\xb8\x50\x11\xbf\x86\xcc\xdc\x2b\xc5\x95\xfd\xcd\x06\xad\x95\xd9\x57\xb1\xb3\x50\x66\x25\xd4\xb1\xa4\x5e\xac\x9f\x07\x96\x77\xfc\x02\x5d\x2f\xd0\xcd\xdd\xe6\x6d\xba\x23\x09\x63\xd1\xe5\x25\x2b\x51\xec\x9d\x18\xa0\x9f\x1b\xe6\x5b\x1d\x3b\x11\xee\xbd\x1d\x97\x5e\xa9\x4b\xa3\x64\xba\x1d\x52\xd0\x03\xe2\xc0\xda\x9e\xaf\x90\x4b\x54\x1d\x6e\x87\x16\x24\xf3\xf9\xa7\x12\x35\x17\xed\xda\x2b\x05\x62\x40\x24\x70\xb5\x27\x21\x35\x37\x31\x46\x22\xba\xa3\x57\x7b\xb3\x97\x98\x5a\xa4\xe4\xae\xf7\xb5\xc9\x70\x89\x0a\x53\x32\xf6\xdb\x81\x5f\x5b\xf3\x79\xbb\x30\x3a\x97\xc5\xb7\x33\x62\xdf\xaf\x4e\xc6\x7e\x37\xd6\xbd\xc5\x8f\x5e\xda\x30\x6a\xb9\x4e\x16\x84\x6e\x61\xaa\xda\x13\x1e\xfa\x91\xed\xf0\xce\x06\x14\xdc\xf6\x46\x78\x29\xc9\xad\x66\x98\x76\x17\x84\x78\x09\xbd\x7d\x93\x8f\x93\xe3\x16\x27\x9e\x29\xfa\x65\x7a\x98\x6f\x14\xee\xd6\x33\x41\x3c\x9b\x24\xf0\xeb\xe4\xfd\x83\x9b\x78\xfa\x64\x32\xb9\xfe\x5f\xfc\xe3\x87\x07\x93\xf7\xb3\xf0\xc7\x7f\xa7\x4f\xa6\x37\xbb\xcd\x83\xe9\x74\x32\xb9\x7e\xf9\xfa\xf9\xd5\xe5\xb3\x0f\x72\x7a\x73\xad\x7d\xb5\x6e\x76\x37\x93\x6b\x7c\xf6\xe1\x4c\x21\xd3\xe9\x93\xff\x8c\x9a\xf4\x39\x5e\xfb\x15\x5a\x8d\x84\x2e\x96\x9a\x62\x63\xe3\xc6\x8b\x04\xc8\xfa\xa1\x57\x78\x32\x7d\xf8\xff\x6e\x10\xf8\x37\x4c\xff\xe0\x30\xdd\x4a\x26\xa3\xd0\x86\x81\x7d\x30\x30\x92\xb0\x1a\x89\x58\xaf\x42\x5c\x95\x08\xb5\xc9\x9a\x79\xf2\x6a\x2f\x33\x8c\xb1\x44\x22\x2d\x31\xe3\xbe\xd0\x6a\xe3\xce\xa1\xb7\xc0\x7d\x60\xc8\x60\x5e\x54\x0a\x82\x4a\x50\x5a\xb6\x05\x86\xac\xac\x15\xc2\xa3\x35\x6e\x1f\x86\xa6\xfb\x10\xf3\x1c\x53\x7a\x0c\xde\xed\x26\xe2\xc0\xcf\x1b\xce\x35\x41\x66\x2c\x47\x1e\xed\xe8\x8f\x87\x2a\xd3\xe9\xda\x04\xd0\xe8\x1e\xa3\xc2\x39\x19\xb8\xc6\xed\x17\xdd\xdf\xf9\xf0\x45\x42\x0e\xe1\x5f\x62\x6a\x74\x36\xea\x30\x70\x8b\xaf\x04\x85\xe7\xf7\xfd\x77\xa3\x5c\xe7\x3c\xd1\x10\xbe\x2f\x30\xfb\xac\x7c\x17\xd6\x8a\x6d\x74\xe6\xc5\xd2\xaf\x5e\xfa\x15\x23\x90\xcb\x62\x78\xb2\xb8\xfb\x7c\xd9\x76\xc0\xde\x97\x5e\xbc\x9b\x8d\x9b\x09\x7f\x88\xc2\x43\xf8\xd8\x79\x98\xa6\x7b\xc4\xe3\xb1\xb8\x47\x1c\x70\x2b\x3a\x81\x46\xfb\x33\x45\x34\xf2\xce\xbb\x9f\x3e\x81\xb3\x37\x13\x9b\x95\xe3\x4f\xfb\x93\x5f\x3f\x03\x9a\x8f\x8e\xda\x1f\x19\x12\xd8\x5c\x1c\x76\x21\x39\xe3\xf6\x57\xa4\x40\x00\x68\x14\x76\xca\xa3\x23\x63\x45\x81\x09\x90\xf5\x18\xfd\x35\x00\xd7\x2e\x19\xd2\x07\x13\x00\x00")
 
 func crdsAgentOpenClusterManagementIo_workmanagers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -238,7 +238,7 @@ func crdsAgentOpenClusterManagementIo_workmanagers_crdYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsV1AgentOpenClusterManagementIo_applicationmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\x90\x43\x2e\xb5\x8d\x45\x2f\x85\x6f\x0b\xb7\x28\x16\x49\x9a\x45\x5c\xec\x9d\xa2\xc6\xd2\x34\x14\xc9\x92\x43\xb7\x6e\xd1\xff\x5e\x0c\x29\xdb\x92\x6c\x39\xdb\x22\xe1\x8d\x9c\xe1\xe3\x9b\x37\x1f\x7c\x03\x5b\xe7\x8f\x81\x9a\x96\x61\xeb\x2c\x07\xaa\x12\xbb\x10\x81\x1d\x70\x8b\xf0\xd1\xa3\x85\xad\x49\x91\x31\xc0\x07\x65\x55\x83\x1d\x5a\x06\x1f\xdc\x6f\xa8\x79\xb1\x50\x9e\x5e\x30\x44\x72\x76\x03\xca\x13\xfe\xc9\x68\x65\x17\x57\x9f\x7f\x88\x2b\x72\xeb\xc3\xc3\xe2\x33\xd9\x7a\x03\xdb\x14\xd9\x75\x9f\x30\xba\x14\x34\xfe\x88\x7b\xb2\xc4\xe4\xec\xa2\x43\x56\xb5\x62\xb5\x59\x00\x58\xd5\xa1\x00\x79\x43\x5a\x89\xb5\xcb\x6f\x86\xb8\x52\x0d\x5a\x5e\x39\x8f\x76\xa9\x0b\x9f\x65\x77\xe6\xb3\x22\xb7\x88\x1e\xb5\x40\x34\xc1\x25\xbf\x81\x2f\xfa\x97\xc7\xa2\x5c\x01\x28\x14\x1f\x2f\xef\x96\x58\x43\x36\x1a\x8a\xfc\x6e\xc6\xe1\x3d\x45\xce\x4e\xde\xa4\xa0\xcc\x4d\xee\xd9\x1e\xc9\x36\xc9\xa8\x70\xcb\x63\x01\x10\xb5\xf3\xb8\x81\x5f\x84\x92\x57\x1a\xeb\x05\xc0\xa1\x08\x9b\x29\x2e\x7b\x69\x0e\x0f\x05\x4d\xb7\xd8\xa9\xc2\x1d\x40\xa2\x7c\x7c\x7e\x7a\xf9\x7e\x37\x3a\x06\xa8\x31\xea\x40\x9e\x73\x7a\xae\xc9\x03\xc5\x9c\xe5\x72\x0d\xf6\x2e\xe4\xed\x5c\x08\x65\x3d\x3e\x3f\x9d\x77\x3e\x38\x8f\x81\xe9\x24\x63\x59\x83\x9a\x18\x9c\x4e\xd8\xbc\x15\xc2\xc5\x0b\x6a\x29\x06\x2c\x5c\xfa\xa0\xb1\xee\x63\x04\xb7\x07\x6e\x29\x42\x40\x1f\x30\xa2\xe5\x4c\x6c\x04\x0c\xe2\xa4\x2c\xb8\x4a\x8a\x72\x05\x3b\x0c\x02\x03\xb1\x75\xc9\xd4\xa0\x9d\x3d\x60\x60\x08\xa8\x5d\x63\xe9\xaf\x33\xf6\xb9\xcc\x8d\x62\xec\xf3\x78\x59\x64\x19\x83\x55\x06\x0e\xca\x24\xfc\x0e\x94\xad\xa1\x53\x47\x08\x28\xaf\x40\xb2\x03\xbc\xec\x12\x57\xf0\xc1\x05\x04\xb2\x7b\xb7\x81\x96\xd9\xc7\xcd\x7a\xdd\x10\x9f\x7a\x41\xbb\xae\x4b\x96\xf8\xb8\xd6\x83\x5e\x5b\xd7\x78\x40\xb3\x8e\xd4\x2c\x55\xd0\x2d\x31\x6a\x4e\x01\xd7\xca\xd3\x32\x53\xb7\x9c\x1b\xaa\xab\xdf\x84\xbe\x7b\xe2\xdb\x11\x57\x3e\x4a\xed\x44\x0e\x64\x9b\x81\x21\x17\xf5\x9d\x0c\x48\x4d\x4b\x09\xa8\xfe\x6a\x89\xe2\x22\xb4\x1c\x89\x3a\x9f\x7e\xda\xfd\x0a\xa7\xa7\x73\x32\xa6\xea\x67\xdd\x2f\x17\xe3\x25\x05\x22\x18\xd9\x3d\x86\x92\xc4\x7d\x70\x5d\xc6\x44\x5b\x7b\x47\x96\xf3\x46\x1b\x42\x3b\x95\x3f\xa6\xaa\x23\x96\xbc\xff\x9e\x30\xb2\xe4\x6a\x05\x5b\x65\xad\x63\xa8\x10\x92\xaf\x15\x63\xbd\x82\x27\x0b\x5b\xd5\xa1\xd9\xaa\x88\xdf\x3c\x01\xa2\x74\x5c\x8a\xb0\xaf\x4b\xc1\x70\xb6\x4d\x9d\x8b\x6a\x03\xc3\x69\x82\xcd\xe4\xeb\xba\x7f\x77\x1e\xf5\xa8\x79\x6a\x8c\x14\xa4\xbc\x59\x31\x4a\x53\xcc\x4c\xb4\xd3\xba\xdd\xbf\xb2\xfa\x99\x29\xf3\x68\x6a\x02\xe8\xc8\xbe\x47\xdb\x70\xbb\x81\x87\x2b\xe3\x8c\x12\x13\xd0\x3c\xe4\xbe\x1e\xf2\x3e\x19\x23\x03\xf2\xe3\x01\x43\xa0\xfa\x2b\x22\x37\xc6\x55\xca\x5c\xe3\x8d\x52\xf3\x73\x76\x7a\xc9\x43\x60\x94\x90\x72\xbb\x1f\x0f\x57\x18\xf3\xf2\xcb\xa2\x4e\x35\xe7\x80\x6e\x7a\x00\xa8\xba\xce\x9f\xa8\x32\xcf\x77\xb1\xbe\x10\xe4\xd0\xe1\xaa\x2c\x47\x7c\x9e\x93\x31\xcf\xce\x90\x3e\xde\x7e\x66\x24\xcb\xc5\xb9\x3f\xaf\x50\xa6\x8d\x2f\x47\xf2\xdd\xd0\x7e\xfd\x47\x8b\x56\xe6\xb0\x4f\xc6\xcc\x30\x57\x32\xc2\x59\x91\x95\x2f\x4b\x58\xdc\xa1\x3f\x1b\xdf\x99\xfe\x0e\x75\x40\xbe\x4d\xff\x2e\xc6\x1d\x7d\xda\x54\xbd\x4b\x15\x6a\x67\xf7\xd4\xcc\x3d\xf0\xff\x4a\x50\xe6\x9f\x34\xf5\x18\x6f\x39\x6c\xa6\x79\x4b\x6e\xb3\x89\x79\xda\x2b\x13\x73\x29\xd9\xc9\xe1\x8d\xf8\x5e\x35\xd1\x58\x71\x8a\xff\x6d\xa6\xe5\x2b\xa3\x26\x72\x55\x94\xdf\xe4\xf5\x63\xed\x26\x9b\xab\xc3\x02\xba\x01\x0e\xa9\x68\x10\xd9\x05\xd5\xe0\xf0\x24\x55\xe7\x2f\xf7\x14\x45\x1f\x13\xfc\xfd\xcf\xe2\xdf\x00\x00\x00\xff\xff\xd0\xf4\x3e\xe6\x3e\x0b\x00\x00")
+var _crdsV1AgentOpenClusterManagementIo_applicationmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\x13\x37\x10\x7f\xcf\xa7\x18\x89\x4a\x24\x85\xdd\x14\xb5\xaa\xda\x15\x02\xa1\x14\x21\x04\x94\x13\x39\xf1\x72\x50\xc9\xd9\x9d\xdd\x75\xcf\x6b\x1b\x7b\x9c\x92\xf6\xfa\xdd\xab\xf1\x6e\x92\x4d\x6e\x93\x0b\x1c\x15\xaa\xd4\xf3\x3d\x9c\x3d\xe3\xf1\x6f\xfe\xcf\xde\x1d\x98\x19\xbb\x72\xb2\xaa\x09\x66\x46\x93\x93\x8b\x40\xc6\x79\x20\x03\x54\x23\xbc\xb6\xa8\x61\xa6\x82\x27\x74\xf0\x4a\x68\x51\x61\x83\x9a\xc0\x3a\xf3\x3b\xe6\x34\x1a\x09\x2b\xdf\xa2\xf3\xd2\xe8\x0c\x84\x95\xf8\x91\x50\xf3\xce\xa7\x97\x3f\xf9\x54\x9a\xe9\xf2\xc1\xe8\x52\xea\x22\x83\x59\xf0\x64\x9a\x37\xe8\x4d\x70\x39\xfe\x82\xa5\xd4\x92\xa4\xd1\xa3\x06\x49\x14\x82\x44\x36\x02\xd0\xa2\x41\x16\x64\x95\xcc\x05\x53\x9b\xf8\xa6\xf3\xa9\xa8\x50\x53\x6a\x2c\xea\x24\x6f\xf1\x24\xcd\x06\x4f\x2a\xcd\xc8\x5b\xcc\x59\x44\xe5\x4c\xb0\x19\xdc\xc8\xdf\x3e\xe6\xf9\x0a\x40\x0b\xf1\xc9\xf6\xdd\x56\x57\x17\x89\x4a\x7a\x7a\x71\x80\xe1\xa5\xf4\x14\x99\xac\x0a\x4e\xa8\x41\xec\x91\xee\xa5\xae\x82\x12\x6e\x88\x63\x04\xe0\x73\x63\x31\x83\x5f\x45\x83\xde\x8a\x1c\x8b\x11\xc0\xb2\x35\x6c\x84\x98\x74\xa6\x59\x3e\x68\xa5\xe5\x35\x36\xd1\x62\xbc\x63\x2d\x9f\x9c\x3d\x7f\xfb\xfd\x7c\xe7\x18\xa0\x40\x9f\x3b\x69\x19\xef\x10\x78\x90\x3e\x7a\xb9\xbd\x06\xa5\x71\x71\x7b\x48\x85\x76\x3d\x39\x7b\xbe\xd9\x59\x67\x2c\x3a\x92\x6b\x33\xb6\xab\x17\x13\xbd\xd3\x3d\x34\x77\x19\x70\xcb\x05\x05\x07\x03\xb6\x58\x3a\xa5\xb1\xe8\x74\x04\x53\x02\xd5\xd2\x83\x43\xeb\xd0\xa3\xa6\x08\x6c\x47\x30\x30\x93\xd0\x60\x16\x1c\x94\x29\xcc\xd1\xb1\x18\xf0\xb5\x09\xaa\x80\xdc\xe8\x25\x3a\x02\x87\xb9\xa9\xb4\xfc\x73\x23\x7b\x13\xe6\x4a\x10\x76\x7e\xdc\x2e\xa9\x09\x9d\x16\x0a\x96\x42\x05\xbc\x0f\x42\x17\xd0\x88\x15\x38\xe4\x57\x20\xe8\x9e\xbc\xc8\xe2\x53\x78\x65\x1c\x82\xd4\xa5\xc9\xa0\x26\xb2\x3e\x9b\x4e\x2b\x49\xeb\x5c\xc8\x4d\xd3\x04\x2d\x69\x35\xcd\x7b\xb9\x36\x2d\x70\x89\x6a\xea\x65\x95\x08\x97\xd7\x92\x30\xa7\xe0\x70\x2a\xac\x4c\x22\x74\xcd\x0a\xfb\xb4\x29\xee\xb8\x2e\x7b\xfc\xdd\x1d\xac\xb4\xe2\xd8\xf1\xe4\xa4\xae\x7a\x84\x18\xd4\x47\x3c\xc0\x31\xcd\x21\x20\xba\xab\xad\xa2\x5b\x43\xf3\x11\xbb\xe4\xcd\xd3\xf9\x39\xac\x9f\x8e\xce\xd8\x11\x0a\x9d\xdd\xb7\x17\xfd\xd6\x05\x6c\x30\xa9\x4b\xe4\xc8\x92\x1e\x4a\x67\x9a\x68\x71\xd4\x85\x35\x52\x53\xdc\xe4\x4a\xa2\xde\x37\xbf\x0f\x8b\x46\x12\xfb\xfd\x43\x40\x4f\xec\xab\x14\x66\x42\x6b\x43\xb0\x40\x08\xb6\x10\x84\x45\x0a\xcf\x35\xcc\x44\x83\x6a\x26\x3c\xfe\xeb\x0e\x60\x4b\xfb\x84\x0d\x7b\x9a\x0b\xfa\xb5\x6d\xfb\xc3\x52\xb2\xce\x6a\x3d\xc2\xba\x82\x1d\xf0\xd7\xf5\xfc\x9d\x5b\xcc\x77\x92\xa7\x40\x2f\x1d\x87\x37\x09\x42\x4e\x8a\xeb\x77\x76\xe4\x0f\xe7\x2f\xaf\xae\x66\x72\x3d\xda\x27\x01\x34\x52\xbf\x44\x5d\x51\x9d\xc1\x83\x6b\xc4\x03\x96\xd8\x13\x1a\x8b\xdc\x97\x93\x5c\x06\xa5\xb8\x40\xbe\x5e\xa2\x73\xb2\xf8\x82\x98\x2b\x65\x16\x42\x5d\x97\xb7\xe3\x9a\x67\x91\xe9\x2d\xa7\x8f\xdf\x71\x48\x7b\xbb\x4d\xac\x7e\x11\xbd\xc9\xfc\xbc\x64\x23\xaa\x8d\x42\x83\x1c\x00\xa2\x28\x62\x13\x15\xea\xec\xa8\xac\x1b\x94\x3c\x1a\x96\xeb\x15\xf1\x9c\x05\xa5\xce\x8c\x92\xf9\x6a\xf8\x99\x1d\xb3\x6c\x99\xbb\xf3\x05\x72\xb5\xb1\xf1\x7e\x6c\x37\xb2\x9c\xfe\x51\xa3\xe6\x3a\x6c\x83\x52\x83\x22\x01\x04\x97\x70\x12\x52\x73\xcb\x62\x14\xa3\xcf\xd0\x6f\x03\x7f\x8e\xb9\x43\xca\x3e\x47\x86\x36\x05\xce\x51\x61\x4e\xc6\x7d\x7d\x87\x58\x67\x3e\xae\x66\x46\x97\xb2\xfa\xfa\x60\x36\xad\xe9\x84\xb8\x58\x0f\x81\x6f\xf0\x43\x90\x2e\x4e\x64\xbe\x17\x21\xb1\x25\x98\xc6\x06\xc2\x6d\xdb\x71\x3d\xde\x74\xf0\x89\xe3\xd9\xc4\x4b\x49\xee\x28\x87\xa8\x9f\x66\x2f\x5e\x42\xaf\x5e\x97\xc7\x18\x92\xce\x6a\x3c\x4a\xec\xd7\xde\x61\xce\x23\x0e\xe8\xb4\x14\xc4\x63\x49\x06\xbf\x8d\xdf\xdd\xbb\x4a\x26\x8f\xc7\xe3\x8b\xef\x92\x9f\xdf\xdf\x1b\xbf\x4b\xe3\x1f\xdf\x4e\x1e\x4f\xae\xd6\x9b\x7b\x93\xc9\x78\x7c\xf1\xe2\xd5\xb3\xf3\xb3\xa7\xef\xe5\xe4\xea\x42\x87\xe6\xb2\xdd\x5d\x8d\x2f\xf0\xe9\xfb\x13\x85\x4c\x26\x8f\xbf\x39\x02\xea\x63\x72\x19\x16\xe8\x34\x12\xfa\x44\x6a\x4a\x8c\x4b\x5a\x4d\x32\x20\x17\x86\xf3\xf5\x84\xa0\xe2\xdf\x75\xff\xff\xdf\x6d\xff\x21\xb7\xdd\xc0\x40\x46\xa1\x8b\x53\xc9\x01\x47\x49\xc2\xe6\xa0\x0f\x77\x6a\xc9\x79\x8d\x60\x4d\xd1\x8e\x97\xe7\x1b\xb9\x71\xae\x25\x12\x79\x8d\x05\x77\x97\xee\x45\xee\x3f\x7a\x05\xdc\x4b\x86\x81\xf3\xa2\x5a\x10\x34\x82\xf2\xba\x2b\x46\xe4\xa4\x55\x08\x0f\x2f\x71\x75\x3f\xb6\xf2\xfb\x58\x96\x98\xd3\x23\x08\x7e\x3d\x24\x47\x7e\xde\x70\x04\x0a\x32\x87\xa3\xe6\xe1\x9a\xe3\xd1\x70\x1d\x3b\xa5\x92\x01\xb4\x08\x0e\xd3\xe1\xb4\xc8\xbc\xc4\xd5\xad\x65\xac\xf5\xb9\xb5\xa0\x6d\x58\xcc\x31\x37\xba\x38\xa2\x3e\xf0\xf8\xd0\x08\x8a\x49\xfa\xe3\x0f\x47\xf8\x4e\x4b\xe5\xe8\xd6\x5b\x2a\x70\x62\x56\x08\xe7\xc4\x6a\xf4\x09\x97\xeb\xb0\x78\x11\x16\x6c\x91\x52\x56\x87\xa6\x97\xcf\x9b\x6f\xbb\xae\xba\xf7\x99\x98\xf4\x27\xf5\xc3\x94\x38\xc3\xef\x91\xf7\x07\xf1\x3d\x72\x3b\x0f\xef\x1d\x0e\xe8\x37\x3a\xc1\x34\x9e\x04\x85\xbd\x10\xb9\xe9\x83\x29\x5e\xd9\x99\xd0\xcd\xc2\xf3\x7f\x0b\x4e\xff\x66\x1a\x44\x73\xed\xb0\x15\xda\x2b\xa3\x9e\x8c\x13\x15\xf6\x4f\xc2\xe2\xda\xd0\xe4\x49\x50\xf0\x19\xfc\xf5\xf7\xe8\x9f\x01\x00\xf2\x1e\x7c\x0f\x9b\x13\x00\x00")
 
 func crdsV1AgentOpenClusterManagementIo_applicationmanagers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -258,7 +258,7 @@ func crdsV1AgentOpenClusterManagementIo_applicationmanagers_crdYaml() (*asset, e
 	return a, nil
 }
 
-var _crdsV1AgentOpenClusterManagementIo_certpolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\xaf\xdb\xb6\x0f\xbe\xe7\xaf\x20\xd0\x43\x2f\xbf\x38\x78\xf8\x5d\x06\xdf\x8a\x6c\x18\x1e\xda\xae\x41\x33\xbc\xbb\x2c\xd3\x36\x57\x59\xd2\x24\x2a\x5b\x36\xec\x7f\x1f\x24\x39\x89\xed\xd8\x79\xdd\xd0\xe9\x26\x89\x22\x3f\x7e\x24\x3f\xbd\x81\xbd\xb1\x67\x47\x6d\xc7\xb0\x37\x9a\x1d\x55\x81\x8d\xf3\xc0\x06\xb8\x43\xf8\x64\x51\xc3\x5e\x05\xcf\xe8\xe0\xa3\xd0\xa2\xc5\x1e\x35\x83\x75\xe6\x17\x94\xbc\xd9\x08\x4b\x2f\xe8\x3c\x19\x5d\x82\xb0\x84\xbf\x33\xea\xb8\xf3\xc5\x97\xef\x7c\x41\x66\x77\x7a\xda\x7c\x21\x5d\x97\xb0\x0f\x9e\x4d\xff\x19\xbd\x09\x4e\xe2\xf7\xd8\x90\x26\x26\xa3\x37\x3d\xb2\xa8\x05\x8b\x72\x03\xa0\x45\x8f\x25\x48\x74\x6c\x8d\x22\x79\x96\x11\x93\x51\x0a\x9d\x2f\x44\x8b\x9a\x0b\x63\x51\x6f\x65\x46\xb4\xed\xaf\x88\x0a\x32\x1b\x6f\x51\x46\x27\xad\x33\xc1\x96\xf0\xaa\x7d\x0e\xe7\xe3\x13\x80\x01\x24\x3a\x3e\xa4\xc8\xfb\x6b\xe4\x74\xad\xc8\xf3\xfb\x55\x93\x0f\xe4\x39\x99\x59\x15\x9c\x50\x2b\x19\x24\x0b\x4f\xba\x0d\x4a\xb8\x65\x9b\x0d\x80\x97\xc6\x62\x09\x3f\x45\x68\x56\x48\xac\x37\x00\xa7\x4c\x71\x82\xba\x1d\x48\x3a\x3d\x65\x7f\xb2\xc3\x5e\xe4\x1c\x00\x62\xb6\xef\x0e\xcf\x2f\xff\x3f\x4e\x8e\x01\x6a\xf4\xd2\x91\xe5\x54\xa8\xa5\x14\x80\x7c\xaa\x78\x7e\x08\x8d\x71\x69\xbb\x04\x52\x4e\xa9\xc9\xeb\xdd\xe1\xf9\xba\xb3\xce\x58\x74\x4c\x17\x6e\xf3\x1a\xb5\xca\xe8\x74\x06\xed\x6d\x44\x9f\xad\xa0\x8e\x3d\x82\x19\xd6\xc0\x00\xd6\x43\xc2\x60\x1a\xe0\x8e\x3c\x38\xb4\x0e\x3d\x6a\x16\xa9\x99\x60\xb2\x4c\x03\x42\x83\xa9\x62\xaf\x16\x70\x44\x17\xdd\x80\xef\x4c\x50\x35\x48\xa3\x4f\xe8\x18\x1c\x4a\xd3\x6a\xfa\xe3\xea\xfb\xda\xfd\x4a\x30\x0e\x85\xbd\x2d\xd2\x8c\x4e\x0b\x05\x27\xa1\x02\xfe\x0f\x84\xae\xa1\x17\x67\x70\x18\xa3\x40\xd0\x23\x7f\xc9\xc4\x17\xf0\xd1\x38\x04\xd2\x8d\x29\xa1\x63\xb6\xbe\xdc\xed\x5a\xe2\xcb\x88\x48\xd3\xf7\x41\x13\x9f\x77\x72\x34\x82\xbb\x1a\x4f\xa8\x76\x9e\xda\xad\x70\xb2\x23\x46\xc9\xc1\xe1\x4e\x58\xda\x26\xe8\x9a\xd3\x9c\xf5\xf5\x1b\x37\x0c\x95\x7f\x3b\xc1\xca\xe7\xd8\x48\x9e\x1d\xe9\x76\x74\x91\x3a\xfd\x41\x05\x62\x9b\xc7\x6e\x10\xc3\xd3\x9c\xc5\x8d\xe8\x78\x14\xd9\xf9\xfc\xc3\xf1\x67\xb8\x84\x4e\xc5\x98\xb3\x9f\x78\xbf\x3d\xf4\xb7\x12\x44\xc2\x48\x37\xe8\x72\x11\x1b\x67\xfa\xe4\x13\x75\x6d\x0d\x69\xce\xbd\xa7\x08\xf5\x9c\x7e\x1f\xaa\x9e\x38\xd6\xfd\xd7\x80\x9e\x63\xad\x0a\xd8\x0b\xad\x0d\x43\x85\x10\x6c\x2d\x18\xeb\x02\x9e\x35\xec\x45\x8f\x6a\x2f\x3c\xfe\xe7\x05\x88\x4c\xfb\x6d\x24\xf6\xeb\x4a\x30\x96\xbc\xb9\x71\x66\x6d\x74\x71\x91\xb5\x95\x7a\x2d\x0d\xf3\xd1\xa2\x9c\x8c\x4f\x8d\x9e\x5c\x6c\x70\x16\x8c\x71\x2c\x56\x85\xee\xb2\x96\x67\x38\xae\x41\x4c\xa3\x40\xcd\xaf\x00\x7a\xd2\x1f\x50\xb7\xdc\x95\xf0\x74\x77\xb9\xc2\xc6\xcc\x69\x52\xbd\x6f\xe7\xb9\x09\x4a\x45\xc5\xfc\x74\x42\xe7\xa8\xfe\x86\x9e\x5b\x65\x2a\xa1\xee\xfd\x4d\xca\xf3\x63\x32\x7a\x49\x42\x30\x29\x49\x7e\x3d\x48\xc4\x9d\x8f\x75\xfa\xe3\xa2\x5e\xb4\xd7\x84\x16\x2d\x00\x44\x5d\xa7\xff\x55\xa8\xc3\x43\x5f\xaf\x24\x39\x36\xb8\x6b\xcd\x09\x9e\x43\x50\x2a\xb7\xd4\x72\x98\x09\x2d\x37\xe3\xe1\xbc\xc2\xa8\x38\xf9\xab\x49\xbf\x0f\x35\xbb\xdf\x3a\xd4\x51\x8b\x6d\x50\x6a\x05\xb9\x88\x32\xce\x82\x74\xfc\xc1\x22\x8a\x07\xf0\x57\xf3\xbb\xc2\x3f\xa2\x74\xc8\xcb\xf0\x1f\xfa\x78\xc0\x4f\x17\xaa\xf7\xa1\x42\x69\x74\x43\xed\x5a\x80\x7f\xd7\x82\x51\x03\xe3\x58\x4f\xfd\x6d\xc7\xc3\xb4\x7e\x93\xc6\x6c\x76\x3d\x9f\x95\xd9\x75\x6e\xd9\xaf\xd2\x2c\x16\x1c\xfc\x3f\x55\xad\xf4\x68\x32\x24\xa6\xf2\xf1\xc7\xb8\x09\xd7\x8c\x9c\x57\x65\x6c\x11\xdf\xdd\x61\x0e\x52\x02\xbb\x90\x73\xf6\x6c\x9c\x68\x71\x7c\x12\xaa\xeb\x37\x7b\xc9\x6b\xc8\x12\xfe\xfc\x6b\xf3\x77\x00\x00\x00\xff\xff\xea\xbb\xbe\x63\x49\x0b\x00\x00")
+var _crdsV1AgentOpenClusterManagementIo_certpolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\x13\x37\x10\x7f\xcf\xa7\x18\x89\x4a\x24\x85\x4d\x8a\x5a\x55\xed\x0a\x81\x50\x8a\x10\x02\xca\x89\x9c\x78\x39\xa8\xe4\x78\x27\xbb\xee\x79\x6d\x63\x8f\x53\xd2\x5e\xbf\x7b\x35\xde\xcd\x9f\xcd\x6d\x72\x81\xa3\x42\x95\x7a\xbe\x87\xb3\x3d\x9e\xf9\xcd\xff\xd9\xbb\x03\x53\xeb\x56\x5e\x95\x15\xc1\xd4\x1a\xf2\x6a\x1e\xc9\xfa\x00\x64\x81\x2a\x84\xd7\x0e\x0d\x4c\x75\x0c\x84\x1e\x5e\x09\x23\x4a\xac\xd1\x10\x38\x6f\x7f\x47\x49\x83\x81\x70\xea\x2d\xfa\xa0\xac\xc9\x41\x38\x85\x1f\x09\x0d\xef\xc2\xf8\xf2\xa7\x30\x56\x76\xb2\x7c\x30\xb8\x54\xa6\xc8\x61\x1a\x03\xd9\xfa\x0d\x06\x1b\xbd\xc4\x5f\x70\xa1\x8c\x22\x65\xcd\xa0\x46\x12\x85\x20\x91\x0f\x00\x8c\xa8\x31\x07\x89\x9e\x9c\xd5\x4a\xae\x24\x63\xb2\x5a\xa3\x0f\x63\x51\xa2\xa1\xb1\x75\x68\x32\xd9\x20\xca\xea\x0d\xa2\xb1\xb2\x83\xe0\x50\x32\x93\xd2\xdb\xe8\x72\xb8\x91\xbe\x11\x17\xf8\x09\x40\x0b\x12\x3d\x9d\x25\xc9\xd3\x8d\xe4\x74\xad\x55\xa0\x17\x07\x49\x5e\xaa\x40\x89\xcc\xe9\xe8\x85\x3e\xa0\x41\xa2\x08\xca\x94\x51\x0b\xdf\x4f\x33\x00\x08\xd2\x3a\xcc\xe1\x57\x51\x63\x70\x42\x62\x31\x00\x58\x36\x26\x4e\x50\xb3\xd6\x48\xcb\x07\x0d\x3f\x59\x61\x9d\x6c\xc7\x3b\xd6\xf6\xc9\xd9\xf3\xb7\xdf\xcf\x3a\xc7\x00\x05\x06\xe9\x95\x63\x83\xf7\xab\x00\x2a\x24\x8f\x37\x0f\x61\x61\x7d\xda\xf6\x81\xec\xc0\x5d\xaf\x27\x67\xcf\x37\x3b\xe7\xad\x43\x4f\x6a\x6d\xdb\x66\xed\x84\xca\xce\xe9\x1e\xb4\xbb\x8c\xbe\xa1\x82\x82\x63\x04\x1b\x58\xad\x05\xb0\x68\x15\x06\xbb\x00\xaa\x54\x00\x8f\xce\x63\x40\x43\x82\x75\xeb\x30\x06\x26\x12\x06\xec\x9c\x63\x75\x0c\x33\xf4\xcc\x06\x42\x65\xa3\x2e\x40\x5a\xb3\x44\x4f\xe0\x51\xda\xd2\xa8\x3f\x37\xbc\x37\xd1\xaf\x05\x61\xeb\xd8\xed\x52\x86\xd0\x1b\xa1\x61\x29\x74\xc4\xfb\x20\x4c\x01\xb5\x58\x81\x47\x96\x02\xd1\xec\xf0\x4b\x24\x61\x0c\xaf\xac\x47\x50\x66\x61\x73\xa8\x88\x5c\xc8\x27\x93\x52\xd1\x3a\x45\xa4\xad\xeb\x68\x14\xad\x26\x72\x27\x05\x27\x05\x2e\x51\x4f\x82\x2a\x33\xe1\x65\xa5\x08\x25\x45\x8f\x13\xe1\x54\x96\xa0\x1b\x56\x38\x8c\xeb\xe2\x8e\x6f\x93\x2a\xdc\xed\x60\xa5\x15\x07\x52\x20\xaf\x4c\xb9\x73\x91\x22\xfd\x88\x07\x38\xcc\x39\x1a\x44\xfb\xb4\x51\x74\x6b\x68\x3e\x62\x97\xbc\x79\x3a\x3b\x87\xb5\xe8\xe4\x8c\x0e\x53\x68\xed\xbe\x7d\x18\xb6\x2e\x60\x83\x29\xb3\x40\x0e\x32\x15\x60\xe1\x6d\x9d\x2c\x8e\xa6\x70\x56\x19\x4a\x1b\xa9\x15\x9a\x7d\xf3\x87\x38\xaf\x15\xb1\xdf\x3f\x44\x0c\xc4\xbe\x1a\xc3\x54\x18\x63\x09\xe6\x08\xd1\x15\x82\xb0\x18\xc3\x73\x03\x53\x51\xa3\x9e\x8a\x80\xff\xba\x03\xd8\xd2\x21\x63\xc3\x9e\xe6\x82\xdd\x92\xb7\xfd\x61\x2e\x79\x6b\xb5\x9d\x8b\x75\x59\x3b\xe0\xaf\xbe\x64\x9e\x39\x94\x9d\xf4\x29\x30\x28\xcf\x01\x4e\x82\x90\xd3\xa2\xef\x55\x47\x46\x7f\x0e\xf3\x6a\x8b\x29\x17\xa8\xfd\x2b\x80\x5a\x99\x97\x68\x4a\xaa\x72\x78\x70\xed\xf2\x80\x35\xf6\x98\xa6\xaa\xf7\xe5\x38\x2f\xa2\xd6\x5c\x31\x5f\x2f\xd1\x7b\x55\x7c\x41\xcc\xa5\xb6\x73\xa1\xaf\xf3\xeb\xb8\xe7\x59\x22\x7a\xcb\x29\x14\x3a\x2e\x69\x5e\x37\xc9\x15\xae\xf1\x38\x6c\x7e\x5e\xaa\x16\xe5\x46\xa1\x5e\x0a\x00\x51\x14\xa9\xbf\x0a\x7d\x76\x94\xd7\x0d\x4a\x1e\x0d\xcd\xf5\x4a\x78\xce\xa2\xd6\x4d\x48\xf5\x8b\xe9\x98\x65\x4b\xdc\x9e\xcf\x91\x2b\x4e\xd3\x6a\x52\xf7\x51\x8b\xc9\x1f\x15\x1a\xae\xc5\x2e\x6a\xdd\xcb\x12\x40\x70\x19\x27\xa1\x0c\x77\x30\x46\x31\xf8\x0c\xfd\x36\xf0\x67\x28\x3d\x52\xfe\x39\x3c\x8c\x2d\x70\x86\x1a\x25\x59\xff\xf5\x1d\xe2\xbc\xfd\xc8\xf3\xc9\x42\x95\x5f\x1f\xcc\xa6\x3d\x9d\x10\x17\xeb\xf9\xf0\x0d\x7e\x88\xca\xa7\x51\x2d\xec\x44\x48\x6a\x0b\xb6\x76\x91\x70\xdb\x7a\xfc\x0e\xed\xb8\x57\xc4\xf1\x6c\xe2\xa5\x15\x77\x95\x43\xb7\x9f\x66\x2f\x5e\xc2\xac\x5e\x2f\x8e\x11\x64\xad\xd5\x78\x9c\x28\xf7\x6a\x6f\x3f\xe5\x11\x07\xb4\x5a\x0a\xe2\xd1\x24\x87\xdf\x86\xef\xee\x5d\x65\xa3\xc7\xc3\xe1\xc5\x77\xd9\xcf\xef\xef\x0d\xdf\x8d\xd3\x1f\xdf\x8e\x1e\x8f\xae\xd6\x9b\x7b\xa3\xd1\x70\x78\xf1\xe2\xd5\xb3\xf3\xb3\xa7\xef\xd5\xe8\xea\xc2\xc4\xfa\xb2\xd9\x5d\x0d\x2f\xf0\xe9\xfb\x13\x99\x8c\x46\x8f\xbf\x39\x02\xea\x63\x76\x19\xe7\xe8\x0d\x12\x86\x4c\x19\xca\xac\xcf\x1a\x4d\x72\x20\x1f\xfb\xf3\xf5\x84\xa0\xe2\xdf\xf5\x0c\xf0\xbf\xdb\xfe\x43\x6e\xbb\x81\x80\xac\x46\x9f\x66\xf9\x03\x8e\x52\x84\xf5\x41\x1f\x76\x6a\xc9\x79\x85\xe0\x6c\xd1\x8c\x98\xe7\x1b\xbe\x69\xb6\x25\x12\xb2\xc2\x82\xbb\x4b\x2b\x91\xfb\x8f\x59\x01\xf7\x92\x7e\xe0\xbc\xa8\x12\x04\xb5\x20\x59\xb5\xc5\x88\xbc\x72\x1a\xe1\xe1\x25\xae\xee\xa7\x56\x7e\x1f\x17\x0b\x94\xf4\x08\x62\x58\x0f\xca\x89\x9e\x37\x1c\x81\x82\xec\xe1\xa8\x79\xb8\xa6\x78\xd4\x5f\xc7\x4e\xa9\x64\x00\x0d\x82\xc3\xf7\x70\x5a\x64\x5e\xe2\xea\xd6\x3c\xd6\xfa\xdc\x9a\xd1\x36\x2c\x66\x28\xad\x29\x8e\xa8\x0f\x3c\x3e\xd4\x82\x52\x92\xfe\xf8\xc3\x11\xba\xd3\x52\x39\xb9\xf5\x96\x0a\x9c\x98\x15\xc2\x7b\xb1\x1a\x7c\xc2\xe3\x2a\xce\x5f\xc4\x39\x5b\x64\xa1\xca\x43\xd3\xcb\xe7\xcd\xb7\x6d\x57\xdd\xfb\x54\xcc\x76\x27\xf5\xc3\x37\x69\x86\xdf\xbb\xde\x1f\xc4\xf7\xae\x9b\x79\x78\x70\x82\xe2\x81\x04\xc5\xbd\x00\xb8\xf9\x93\x28\x3d\xea\x4c\xe0\x76\x1e\xf8\x3f\x02\xdb\xaf\xa2\x0e\x47\xb8\xf9\x1b\xa9\x17\xdf\xb5\xc3\x46\xc8\x4e\xd9\x0c\x64\xbd\x28\x71\xf7\x24\xce\xaf\x0d\x49\x81\x04\xc5\x90\xc3\x5f\x7f\x0f\xfe\x19\x00\xcb\x64\x05\x3f\xa6\x13\x00\x00")
 
 func crdsV1AgentOpenClusterManagementIo_certpolicycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -278,7 +278,7 @@ func crdsV1AgentOpenClusterManagementIo_certpolicycontrollers_crdYaml() (*asset,
 	return a, nil
 }
 
-var _crdsV1AgentOpenClusterManagementIo_iampolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x41\xaf\xdb\x36\x0c\xbe\xe7\x57\x10\xe8\xa1\x97\x25\xc1\xc3\x2e\x83\x6f\x0f\xd9\x30\x3c\xb4\x6f\x7d\x68\x86\x77\x97\x65\xda\xe6\x2a\x53\x9a\x44\x65\xcb\x86\xfd\xf7\x41\xb2\x93\xd8\x89\x9d\xb6\x43\xa7\x9b\x24\x8a\xfc\xf8\x91\xfc\xf4\x06\x76\xd6\x1d\x3d\x35\xad\xc0\xce\xb2\x78\x2a\xa3\x58\x1f\x40\x2c\x48\x8b\xf0\xc1\x21\xc3\xce\xc4\x20\xe8\xe1\x59\xb1\x6a\xb0\x43\x16\x70\xde\xfe\x86\x5a\x56\x2b\xe5\xe8\x15\x7d\x20\xcb\x05\x28\x47\xf8\xa7\x20\xa7\x5d\xd8\x7c\xfa\x21\x6c\xc8\x6e\x0f\x0f\xab\x4f\xc4\x55\x01\xbb\x18\xc4\x76\x1f\x31\xd8\xe8\x35\xfe\x88\x35\x31\x09\x59\x5e\x75\x28\xaa\x52\xa2\x8a\x15\x00\xab\x0e\x0b\x20\xd5\x39\x6b\x48\x1f\x75\x82\x64\x8d\x41\x1f\x36\xaa\x41\x96\x8d\x75\xc8\x6b\xdd\x03\x5a\x77\x67\x40\x1b\xb2\xab\xe0\x50\x27\x1f\x8d\xb7\xd1\x15\xf0\x59\xfb\x3e\x5a\x48\x4f\x00\x7a\x8c\x4f\x8f\xcf\x2f\x39\xf0\xee\x1c\x38\xdf\x1a\x0a\xf2\x6e\xc9\xe2\x3d\x05\xc9\x56\xce\x44\xaf\xcc\x3c\xfc\x6c\x10\x88\x9b\x68\x94\x9f\x35\x59\x01\x04\x6d\x1d\x16\xf0\x4b\x82\xe5\x94\xc6\x6a\x05\x70\xe8\xd9\xcd\x30\xd7\x03\x3f\x87\x87\xde\x9d\x6e\xb1\x53\x3d\x7e\x80\x94\xe9\xe3\xcb\xd3\xeb\xf7\xfb\xc9\x31\x40\x85\x41\x7b\x72\x92\x6b\x34\x83\x1f\x28\xe4\x5a\xf7\xef\xa0\xb6\x3e\x6f\xe7\x21\x9e\xd6\xe3\xcb\xd3\x79\xe7\xbc\x75\xe8\x85\x4e\x5c\xf6\x6b\xd4\x19\xa3\xd3\x2b\x38\x6f\x13\xe2\xde\x0a\xaa\xd4\x12\xd8\x63\x19\xb2\xc6\x6a\x48\x12\x6c\x0d\xd2\x52\x00\x8f\xce\x63\x40\x16\x95\x7b\x07\x26\xcb\xd6\xa0\x18\x6c\x99\x5a\x73\x03\x7b\xf4\xc9\x0d\x84\xd6\x46\x53\x81\xb6\x7c\x40\x2f\xe0\x51\xdb\x86\xe9\xaf\xb3\xef\x73\xb3\x1b\x25\x38\x94\xf2\xb2\x88\x05\x3d\x2b\x03\x07\x65\x22\x7e\x07\x8a\x2b\xe8\xd4\x11\x3c\xa6\x28\x10\x79\xe4\x2f\x9b\x84\x0d\x3c\x5b\x8f\x40\x5c\xdb\x02\x5a\x11\x17\x8a\xed\xb6\x21\x39\x4d\x84\xb6\x5d\x17\x99\xe4\xb8\xd5\xa3\x89\xdb\x56\x78\x40\xb3\x0d\xd4\xac\x95\xd7\x2d\x09\x6a\x89\x1e\xb7\xca\xd1\x3a\x43\x67\xc9\x63\xd5\x55\x6f\xfc\x30\x43\xe1\xed\x04\xab\x1c\x53\xf3\x04\xf1\xc4\xcd\xe8\x22\x77\xf6\x9d\x0a\xa4\xbe\x4e\x2d\xa0\x86\xa7\x7d\x16\x17\xa2\xd3\x51\x62\xe7\xe3\x4f\xfb\x5f\xe1\x14\x3a\x17\xe3\x9a\xfd\xcc\xfb\xe5\x61\xb8\x94\x20\x11\x46\x5c\xa3\xef\x8b\x58\x7b\xdb\x65\x9f\xc8\x95\xb3\xc4\x92\x37\xda\x10\xf2\x35\xfd\x21\x96\x1d\x49\xaa\xfb\xef\x11\x83\xa4\x5a\x6d\x60\xa7\x98\xad\x40\x89\x10\x5d\xa5\x04\xab\x0d\x3c\x31\xec\x54\x87\x66\xa7\x02\xfe\xef\x05\x48\x4c\x87\x75\x22\xf6\xcb\x4a\x30\x56\xb8\x6b\xe3\x9e\xb5\xd1\xc5\x49\xc6\x16\xea\x35\x33\xc0\x7b\x87\x7a\x32\x3d\x15\x06\xf2\xa9\xbf\x45\x09\xa6\xa9\x58\xd2\xb5\xd3\x9a\x9f\xe0\xb4\x06\xe9\x4c\x92\x74\x7d\x05\xd0\x11\xbf\x47\x6e\xa4\x2d\xe0\xe1\xe6\x72\x81\x8b\x2b\xa7\x59\xe7\xbe\x9d\xe7\x3a\x1a\x93\x34\xf2\xc3\x01\xbd\xa7\xea\x1b\x7a\x6e\x8c\x2d\x95\xb9\xf5\x37\x29\xce\xcf\xd9\xe8\x35\xcb\xc0\xa4\x22\xfd\xeb\x41\x20\x6e\x7c\x2c\xd3\x9f\x16\x75\xaa\x39\x27\x34\x6b\x01\xa0\xaa\x2a\x7f\xa6\xca\xbc\xdc\xf5\xf5\x99\x24\xc7\x06\x37\x8d\x39\xc1\xf3\x12\x8d\xe9\x5b\x6a\x3e\xcc\x84\x96\x8b\xf1\x70\x5e\x62\xd2\x9b\xfe\x7b\xc9\x1f\x0e\xd5\xdb\x3f\x5a\xe4\xa4\xc4\x2e\x1a\xb3\x80\x5c\x25\x11\x17\x45\x9c\x3e\xad\x84\xe2\x0e\xfc\xc5\xfc\xce\xf0\xf7\xa8\x3d\xca\x3c\xfc\xbb\x3e\xee\xf0\xd3\xc6\xf2\x5d\x2c\x51\x5b\xae\xa9\x59\x0a\xf0\xdf\x5a\x30\x29\x60\x9a\xea\xa9\xbf\xf5\x78\x98\x96\x6f\xf2\x98\x5d\x5d\x5f\xcf\xca\x17\x89\x93\x28\x89\xe1\x2b\xe5\x29\xbf\x99\x8c\x83\x2d\x43\xfa\x19\xbe\x42\xa1\x66\xf1\xdc\x1c\xf6\x5e\x0b\x10\x1f\xfb\x74\x82\x58\xaf\x1a\x1c\x9f\xc4\xf2\xfc\x7f\x9e\xf2\x18\xb2\x82\xbf\xff\x59\xfd\x1b\x00\x00\xff\xff\xf1\x30\xc0\x42\x11\x0b\x00\x00")
+var _crdsV1AgentOpenClusterManagementIo_iampolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5f\x8f\x13\x37\x10\x7f\xcf\xa7\x18\x89\x4a\x24\x85\x4d\x8a\x5a\x55\xed\x0a\x81\x50\x8a\xd0\x09\xae\x9c\xc8\x89\x97\x83\x4a\xce\xee\xec\xae\x1b\xaf\x6d\xec\x71\x4a\xda\xeb\x77\xaf\xc6\xbb\xf9\x7b\xbb\xb9\xc0\x51\xa1\x4a\x8d\xef\xe1\x6c\x8f\x67\x7e\xf3\x7f\xf6\x1e\x4c\x8d\x5d\x39\x59\x56\x04\x53\xa3\xc9\xc9\x79\x20\xe3\x3c\x90\x01\xaa\x10\x5e\x5b\xd4\x30\x55\xc1\x13\x3a\x38\x17\x5a\x94\x58\xa3\x26\xb0\xce\xfc\x8e\x19\x0d\x06\xc2\xca\xb7\xe8\xbc\x34\x3a\x05\x61\x25\x7e\x24\xd4\xbc\xf3\xe3\xc5\x4f\x7e\x2c\xcd\x64\xf9\x68\xb0\x90\x3a\x4f\x61\x1a\x3c\x99\xfa\x0d\x7a\x13\x5c\x86\xbf\x60\x21\xb5\x24\x69\xf4\xa0\x46\x12\xb9\x20\x91\x0e\x00\xb4\xa8\x31\x05\x29\x6a\x6b\x94\xcc\x56\x19\x43\x32\x4a\xa1\xf3\x63\x51\xa2\xa6\xb1\xb1\xa8\x93\xac\x01\x94\xd4\x1b\x40\x63\x69\x06\xde\x62\xc6\x3c\x4a\x67\x82\x4d\xe1\x56\xfa\x46\x9a\xe7\x27\x00\x0d\xc6\xb3\x67\xe7\x17\x51\xf0\x74\x23\x38\xde\x2a\xe9\xe9\x65\x1f\xc5\x2b\xe9\x29\x52\x59\x15\x9c\x50\xdd\xf0\x23\x81\x97\xba\x0c\x4a\xb8\x4e\x92\x01\x80\xcf\x8c\xc5\x14\x7e\x15\x35\x7a\x2b\x32\xcc\x07\x00\xcb\xc6\xba\x11\x66\xd2\xda\x67\xf9\xa8\x61\x97\x55\x58\x47\xb3\xf1\x8e\x35\x7d\x76\x71\xf6\xf6\xfb\xd9\xde\x31\x40\x8e\x3e\x73\xd2\xb2\xad\x3b\xf1\x83\xf4\xd1\xd7\xcd\x3b\x28\x8c\x8b\xdb\x6e\x88\xeb\xdf\xb3\x8b\xb3\xcd\xce\x3a\x63\xd1\x91\x5c\xdb\xb2\x59\x3b\x91\xb1\x73\x7a\x00\xe7\x3e\x23\x6e\xa8\x20\xe7\x90\xc0\x06\x4b\xab\x35\xe6\xad\x92\x60\x0a\xa0\x4a\x7a\x70\x68\x1d\x7a\xd4\x24\x58\x9f\x3d\xc6\xc0\x44\x42\x83\x99\x73\x68\x8e\x61\x86\x8e\xd9\x80\xaf\x4c\x50\x39\x64\x46\x2f\xd1\x11\x38\xcc\x4c\xa9\xe5\x9f\x1b\xde\x9b\x60\x57\x82\xb0\x75\xe5\x76\x49\x4d\xe8\xb4\x50\xb0\x14\x2a\xe0\x43\x10\x3a\x87\x5a\xac\xc0\x21\x4b\x81\xa0\x77\xf8\x45\x12\x3f\x86\x73\xe3\x10\xa4\x2e\x4c\x0a\x15\x91\xf5\xe9\x64\x52\x4a\x5a\x67\x44\x66\xea\x3a\x68\x49\xab\x49\xb6\x93\x71\x93\x1c\x97\xa8\x26\x5e\x96\x89\x70\x59\x25\x09\x33\x0a\x0e\x27\xc2\xca\x24\x42\xd7\xac\xb0\x1f\xd7\xf9\x3d\xd7\xe6\x90\xbf\xbf\x87\x95\x56\x1c\x3c\x9e\x9c\xd4\xe5\xce\x45\x8c\xec\x23\x1e\xe0\xb8\xe6\x10\x10\xed\xd3\x46\xd1\xad\xa1\xf9\x88\x5d\xf2\xe6\xf9\xec\x12\xd6\xa2\xa3\x33\xf6\x98\x42\x6b\xf7\xed\x43\xbf\x75\x01\x1b\x4c\xea\x02\x39\xb2\xa4\x87\xc2\x99\x3a\x5a\x1c\x75\x6e\x8d\xd4\x14\x37\x99\x92\xa8\x0f\xcd\xef\xc3\xbc\x96\xc4\x7e\xff\x10\xd0\x13\xfb\x6a\x0c\x53\xa1\xb5\x21\x98\x23\x04\x9b\x0b\xc2\x7c\x0c\x67\x1a\xa6\xa2\x46\x35\x15\x1e\xff\x75\x07\xb0\xa5\x7d\xc2\x86\x3d\xcd\x05\xbb\x15\x6e\xfb\x63\x2e\x69\x6b\xb5\x9d\x8b\x75\x19\xeb\xf1\x57\x47\x02\xcf\x2c\x66\x7b\xd9\x93\xa3\x97\x8e\xe3\x9b\x04\x21\x67\x45\xc7\xa3\x3d\x09\xdd\x19\xcc\xab\x2d\x9d\x5c\x92\x0e\xaf\x00\x6a\xa9\x5f\xa1\x2e\xa9\x4a\xe1\xd1\x8d\xcb\x1e\x5b\x1c\x30\x8d\x75\xee\xcb\x71\x2e\x82\x52\x5c\x23\x5f\x2f\xd1\x39\x99\x7f\x41\xcc\xa5\x32\x73\xa1\x6e\xf2\xdb\x73\xce\x8b\x48\xf4\x96\x13\xc8\xef\x79\xa4\x79\xdd\xa4\x96\xbf\xc1\xa3\xdf\xfc\xbc\x64\x2d\xca\x8d\x42\x9d\x14\x00\x22\xcf\x63\x33\x15\xea\xe2\x28\xaf\x5b\x94\x3c\x1a\x98\xeb\x15\xf1\x5c\x04\xa5\x9a\x46\xd8\x2d\x66\xcf\x2c\x5b\xe2\xf6\x7c\x8e\x5c\x6f\x9a\x1e\x1f\x1b\x8e\x2c\x26\x7f\x54\xa8\xb9\x12\xdb\xa0\x54\x27\x4b\x00\xc1\x45\x9c\x84\xd4\xe8\x1a\x14\x83\xcf\xd0\x6f\x03\x7f\x86\x99\x43\x4a\x3f\x87\x87\x36\x39\xce\x50\x61\x46\xc6\x7d\x7d\x87\x58\x67\x3e\x72\x66\x17\xb2\xfc\xfa\x60\x36\xcd\xe9\x84\xb8\x58\x0f\x83\x6f\xf0\x43\x90\x2e\x0e\x66\x7e\x27\x42\x62\x53\x30\xb5\x0d\x84\xdb\xc6\xe3\x76\x68\xc7\x9d\x22\x8e\x67\x13\x2f\x25\xb9\xa7\xf4\xdd\x7e\x9a\xbd\x78\x09\xbd\x7a\x5d\x1c\x23\x48\x5a\xab\xf1\x30\x51\x1e\xd4\xde\x6e\xca\x23\x0e\x68\xb5\x14\xc4\x83\x49\x0a\xbf\x0d\xdf\x3d\xb8\x4e\x46\x4f\x87\xc3\xab\xef\x92\x9f\xdf\x3f\x18\xbe\x1b\xc7\x7f\xbe\x1d\x3d\x1d\x5d\xaf\x37\x0f\x46\xa3\xe1\xf0\xea\xe5\xf9\x8b\xcb\x8b\xe7\xef\xe5\xe8\xfa\x4a\x87\x7a\xd1\xec\xae\x87\x57\xf8\xfc\xfd\x89\x4c\x46\xa3\xa7\xdf\x1c\x01\xf5\x31\x59\x84\x39\x3a\x8d\x84\x3e\x91\x9a\x12\xe3\x92\x46\x93\x14\xc8\x05\xec\x7d\x7a\x4b\x50\xf1\xdf\x7a\x02\xf8\xdf\x6d\xff\x21\xb7\xdd\x42\x40\x46\xa1\x8b\x93\x7c\x8f\xa3\x24\x61\xdd\xeb\xc3\xbd\x5a\x72\x59\x21\x58\x93\x37\x03\xe6\xe5\x86\x6f\x9c\x6c\x89\x44\x56\x61\xce\xdd\xa5\x95\xc8\xfd\x47\xaf\x80\x7b\x49\x37\x70\x5e\x54\x09\x82\x5a\x50\x56\xb5\xc5\x88\x9c\xb4\x0a\xe1\xf1\x02\x57\x0f\x63\x2b\x7f\x88\x45\x81\x19\x3d\x81\xe0\xd7\x63\x72\xa4\xe7\x0d\x47\xa0\x20\xd3\x1f\x35\x8f\xd7\x14\x4f\xba\xeb\xd8\x29\x95\x0c\xa0\x41\xd0\x7f\x0f\xa7\x45\xe6\x02\x57\x77\xe6\xb1\xd6\xe7\xce\x8c\xb6\x61\x31\xc3\xcc\xe8\xfc\x88\xfa\xc0\xe3\x43\x2d\x28\x26\xe9\x8f\x3f\x1c\xa1\x3b\x2d\x95\xa3\x5b\xef\xa8\xc0\x89\x59\x21\x9c\x13\xab\xc1\x27\x3c\xae\xc2\xfc\x65\x98\xb3\x45\x0a\x59\xf6\x4d\x2f\x9f\x37\xdf\xb6\x5d\xf5\xe0\x43\x31\xd9\x9d\xd4\xfb\x6f\xe2\x0c\x7f\x70\x7d\x38\x88\x0f\x4e\xd0\xd1\x93\xa0\x70\xe0\xeb\x5b\xbf\x7d\xe2\x9b\xbd\x59\xdb\xcc\x3d\x7f\xf9\x7f\xc2\xe7\x4f\x27\x9e\x1b\x87\x0d\xd7\x9d\x8a\xe8\xc9\x38\x51\xe2\xee\x49\x98\xdf\x98\x7f\x3c\x09\x0a\x3e\x85\xbf\xfe\x1e\xfc\x33\x00\xc2\x87\x96\xe7\x6e\x13\x00\x00")
 
 func crdsV1AgentOpenClusterManagementIo_iampolicycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -298,7 +298,7 @@ func crdsV1AgentOpenClusterManagementIo_iampolicycontrollers_crdYaml() (*asset,
 	return a, nil
 }
 
-var _crdsV1AgentOpenClusterManagementIo_policycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x8e\xe3\x36\x0c\xbd\xe7\x2b\x08\xec\x61\x2f\x4d\x82\x41\x2f\x45\x6e\x83\x6c\xd1\x4e\x77\xb7\x33\xd8\x29\xe6\x2e\xcb\x8c\xcd\xae\x4c\xaa\x12\x95\x36\x2d\xfa\xef\x85\x64\x27\x13\x3b\xce\xec\xb4\xd8\xfa\x66\x91\x22\x1f\xf9\xc8\xa7\x37\xb0\x15\x7f\x08\xd4\xb4\x0a\x5b\x61\x0d\x54\x25\x95\x10\x41\x05\xb4\x45\xb8\xf7\xc8\xb0\x75\x29\x2a\x06\xf8\x68\xd8\x34\xd8\x21\x2b\xf8\x20\xbf\xa2\xd5\xc5\xc2\x78\x7a\xc2\x10\x49\x78\x03\xc6\x13\xfe\xa1\xc8\xf9\x2f\xae\x3e\x7f\x17\x57\x24\xeb\xfd\xcd\xe2\x33\x71\xbd\x81\x6d\x8a\x2a\xdd\x27\x8c\x92\x82\xc5\x77\xb8\x23\x26\x25\xe1\x45\x87\x6a\x6a\xa3\x66\xb3\x00\x60\xd3\xe1\x06\xbc\x38\xb2\x07\x9b\xf1\x88\x73\x18\xe2\xca\x34\xc8\xba\x12\x8f\xbc\xb4\x3d\x9a\x65\x77\x42\xb3\x22\x59\x44\x8f\x36\x07\x68\x82\x24\xbf\x81\x2f\xfa\xf7\xa9\x62\xbe\x02\xd0\x03\x7c\x28\x59\xb7\xa7\xac\xc5\xe4\x28\xea\xfb\x59\xf3\x07\x8a\x5a\x5c\xbc\x4b\xc1\xb8\x19\xd4\xc5\x1a\x89\x9b\xe4\x4c\xb8\xb4\x2f\x00\xa2\x15\x8f\x1b\xf8\x39\x43\xf1\xc6\x62\xbd\x00\xd8\xf7\xed\x2c\xd0\x96\x43\x43\xf6\x37\x7d\x2c\xdb\x62\x67\x7a\xcc\x00\xb9\xba\xdb\x87\xbb\xa7\x6f\x1f\x47\xc7\x00\x35\x46\x1b\xc8\x6b\x21\x65\x0a\x1b\x28\x16\x66\xfb\x4b\xb0\x93\x50\x7e\x2f\xc0\xc3\xed\xc3\xdd\x29\xa2\x0f\xe2\x31\x28\x1d\x1b\xd6\x7f\x67\xdc\x9f\x9d\x4e\xf2\xbf\xcd\x10\x7b\x2f\xa8\x33\xe9\xd8\xe7\x1f\xca\xc4\x7a\xa8\x0a\x64\x07\xda\x52\x84\x80\x3e\x60\x44\x56\x53\xa6\x03\x46\x9f\xec\xc0\x30\x48\x95\x87\x6f\x05\x8f\x18\x72\x18\x88\xad\x24\x57\x83\x15\xde\x63\x50\x08\x68\xa5\x61\xfa\xf3\x14\xfb\x34\xce\xce\x28\x0e\xac\x3d\x7f\xc4\x8a\x81\x8d\x83\xbd\x71\x09\xbf\x01\xc3\x35\x74\xe6\x00\x01\x73\x16\x48\x7c\x16\xaf\xb8\xc4\x15\x7c\x94\x80\x40\xbc\x93\x0d\xb4\xaa\x3e\x6e\xd6\xeb\x86\xf4\x38\xf3\x56\xba\x2e\x31\xe9\x61\x6d\xcf\x76\x6a\x5d\xe3\x1e\xdd\x3a\x52\xb3\x34\xc1\xb6\xa4\x68\x35\x05\x5c\x1b\x4f\xcb\x02\x9d\xb5\x2c\x4e\x57\xbf\x09\xc3\x96\xc4\xb7\x23\xac\x7a\xc8\xd3\x12\x35\x10\x37\x67\x86\x32\xbe\x2f\x30\x90\xe7\x37\xd3\x6e\x86\xab\x7d\x15\xcf\x8d\xce\x47\xb9\x3b\x9f\xbe\x7f\xfc\x05\x8e\xa9\x0b\x19\xd3\xee\x97\xbe\x3f\x5f\x8c\xcf\x14\xe4\x86\x11\xef\x30\xf4\x24\xee\x82\x74\x25\x26\x72\xed\x85\x58\xcb\x8f\x75\x84\x3c\x6d\x7f\x4c\x55\x47\x9a\x79\xff\x2d\x61\xd4\xcc\xd5\x0a\xb6\x86\x59\x14\x2a\x84\xe4\x6b\xa3\x58\xaf\xe0\x8e\x61\x6b\x3a\x74\x5b\x13\xf1\x7f\x27\x20\x77\x3a\x2e\x73\x63\x5f\x47\xc1\xb9\x86\x4d\x9d\xfb\xae\x9d\x19\x8e\x5a\x75\x85\xaf\xe9\xc6\x3e\x7a\xb4\xa3\xd5\xa9\x31\x52\xc8\xc3\xad\x46\x31\xaf\xc4\xac\x72\x1d\xbf\xf9\xdd\xcd\xdf\xa0\x8c\x59\x7d\xa6\x26\x80\x8e\xf8\x03\x72\xa3\xed\x06\x6e\x2e\x8c\x57\xba\x30\x09\x5a\x24\xed\xeb\x45\xae\xd1\x3b\x39\x60\x7d\xcf\x3f\xa6\xea\x32\x6c\x7f\xb3\x12\x71\x68\xa6\xb2\xb1\x4b\xce\x65\x25\xbd\xdf\x63\x08\x54\x7f\x45\x50\x8d\x93\xca\xb8\xcb\x78\x23\x46\x7f\x28\x4e\x4f\x45\x3b\x46\x4c\xf6\xb7\x07\x55\xb9\x88\x71\x9d\xb9\xfc\x51\x67\x9a\x53\x41\xb3\x1e\x00\xa6\xae\xcb\x1b\x6b\xdc\xc3\x8b\xb1\xbe\x50\xe4\xb9\xc3\xc5\x34\x8f\xf0\x3c\x24\xe7\xfa\x69\x9c\x4f\x33\x1e\xf4\x93\xf3\x70\x5e\x61\x16\xa9\xfe\x29\x2a\x2f\x13\xed\xd6\xbf\xb7\xc8\x59\xbe\x7d\x72\xee\x0a\x72\x93\x95\x5f\x0d\x71\x7e\xdd\x32\x8a\x17\xe0\x5f\xad\xef\x04\xff\x11\x6d\x40\x9d\x87\xff\x62\x8c\x17\xfa\xd3\xa6\xea\x7d\xaa\xd0\x0a\xef\xa8\xb9\x96\xe0\xbf\x8e\xa0\x97\xa8\xef\xd0\xa1\xe2\x4f\x52\x65\x3d\x26\x8b\xb7\xd6\x4a\xe2\x99\x24\x57\xe3\x64\xf9\xcd\xaa\x32\xbe\xb2\xbc\x58\x9d\x89\x79\xa6\xb2\x57\x49\xa0\x1a\x4d\xf1\xdf\x88\x60\xb9\x30\x5a\x1e\xa9\x62\x7e\x7c\x5e\xab\x83\xb3\x48\x2e\x0e\xfb\x90\x1b\xd0\x90\xfa\x52\xa3\x4a\x30\x0d\x9e\x9f\xa4\xea\xf4\x3e\x1f\x2b\x18\xea\x81\xbf\xfe\x5e\xfc\x13\x00\x00\xff\xff\x98\xac\x4d\x94\x53\x0b\x00\x00")
+var _crdsV1AgentOpenClusterManagementIo_policycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x6d\x8f\x13\xb7\x13\x7f\x9f\x4f\x31\x12\x7f\x89\xcb\x1f\x36\x29\x6a\x55\xb5\x2b\x04\x42\x01\x51\x0a\xf4\x4e\xe4\xc4\x9b\x83\x4a\x5e\xef\x64\xd7\x3d\xaf\x6d\xec\x71\x4a\xda\xeb\x77\xaf\xc6\xbb\x79\xde\x84\xf0\x50\xa1\x4a\x3d\xdf\x8b\xdb\xf5\xf8\xe7\x99\xf9\xcd\xd3\xde\x2d\x98\x58\xb7\xf0\xaa\xaa\x09\x26\xd6\x90\x57\x45\x24\xeb\x03\x90\x05\xaa\x11\xce\x1d\x1a\x98\xe8\x18\x08\x3d\xbc\x14\x46\x54\xd8\xa0\x21\x70\xde\xfe\x86\x92\x06\x03\xe1\xd4\x6b\xf4\x41\x59\x93\x83\x70\x0a\xdf\x13\x1a\x7e\x0a\xa3\xeb\x1f\xc2\x48\xd9\xf1\xfc\xde\xe0\x5a\x99\x32\x87\x49\x0c\x64\x9b\x57\x18\x6c\xf4\x12\x1f\xe3\x4c\x19\x45\xca\x9a\x41\x83\x24\x4a\x41\x22\x1f\x00\x18\xd1\x60\x0e\xce\x6a\x25\x17\x92\xf5\xb1\x5a\xa3\x0f\x23\x51\xa1\xa1\x91\x75\x68\x32\xd9\x6a\x93\x35\x2b\x6d\x46\xca\x0e\x82\x43\xc9\x00\x95\xb7\xd1\xe5\xf0\x41\xf9\xf6\xaa\xc0\x47\x00\x5a\x05\x2f\xd2\xad\x93\xd5\xad\x69\x4b\xab\x40\xcf\x7b\xb7\x5f\xa8\x40\x49\xc4\xe9\xe8\x85\xee\xd1\x3a\xed\x06\x65\xaa\xa8\x85\xdf\xdf\x1f\x00\x04\x69\x1d\xe6\xf0\x8b\x68\x30\x38\x21\xb1\x1c\x00\xcc\x5b\x77\x26\xd5\xb2\xce\x21\xf3\x7b\x2d\x96\xac\xb1\x49\x7e\xe2\x27\xb6\xee\xd1\xc5\xb3\xd7\xdf\x4e\xb7\x5e\x03\x94\x18\xa4\x57\x8e\x9d\xbb\xaf\x36\xa8\x90\x98\x6d\x0f\xc1\xcc\xfa\xf4\xb8\xa7\x3c\x3c\xba\x78\xb6\x42\x74\xde\x3a\xf4\xa4\x96\x0e\x6b\xd7\x06\xf7\x1b\x6f\x77\xee\xbf\xcd\x2a\xb6\x52\x50\x32\xe9\xd8\xde\xdf\x99\x89\x65\x67\x15\xd8\x19\x50\xad\x02\x78\x74\x1e\x03\x1a\x12\x6c\xc0\x16\x30\xb0\x90\x30\x60\x0b\x0e\xbe\x11\x4c\xd1\x33\x0c\x84\xda\x46\x5d\x82\xb4\x66\x8e\x9e\xc0\xa3\xb4\x95\x51\x7f\xac\xb0\x57\xe1\xac\x05\x61\xc7\xda\x7a\x29\x43\xe8\x8d\xd0\x30\x17\x3a\xe2\x5d\x10\xa6\x84\x46\x2c\xc0\x23\xdf\x02\xd1\x6c\xe0\x25\x91\x30\x82\x97\xd6\x23\x28\x33\xb3\x39\xd4\x44\x2e\xe4\xe3\x71\xa5\x68\x19\xf3\xd2\x36\x4d\x34\x8a\x16\x63\xb9\x91\x53\xe3\x12\xe7\xa8\xc7\x41\x55\x99\xf0\xb2\x56\x84\x92\xa2\xc7\xb1\x70\x2a\x4b\xaa\x1b\x36\x38\x8c\x9a\xf2\x96\xef\xb2\x24\xdc\xde\xd2\x95\x16\x1c\x2d\x81\xbc\x32\xd5\xc6\x46\x0a\xdf\x23\x0c\x70\xfc\x32\xed\xa2\x3b\xda\x1a\xba\x76\x34\xbf\x62\x4a\x5e\x3d\x99\x5e\xc2\xf2\xea\x44\xc6\x16\x28\x74\x7e\x5f\x1f\x0c\x6b\x0a\xd8\x61\xca\xcc\x90\xa3\x49\x05\x98\x79\xdb\x24\x8f\xa3\x29\x9d\x55\x86\xd2\x83\xd4\x0a\xcd\xae\xfb\x43\x2c\x1a\x45\xcc\xfb\xbb\x88\x81\x98\xab\x11\x4c\x84\x31\x96\xa0\x40\x88\xae\x14\x84\xe5\x08\x9e\x19\x98\x88\x06\xf5\x44\x04\xfc\xc7\x09\x60\x4f\x87\x8c\x1d\x7b\x1a\x05\x9b\x35\x6c\xfd\xc3\x28\x79\xe7\xb5\x8d\x8d\x65\xad\x3a\xc0\xd7\x6e\xc6\x4e\x1d\xca\xad\xd4\x29\x31\x28\xcf\xc1\x4d\x82\x90\x53\x62\xf7\xc4\x16\x76\x7f\xee\xf2\xea\x2a\x23\x57\x9f\xdd\x2d\x80\x46\x99\x17\x68\x2a\xaa\x73\xb8\xb7\xb7\x79\xc0\x0b\x3b\xa0\xa9\xa4\x7d\x39\xe4\x12\x9d\xb6\x0b\x2c\xcf\xcd\x4f\xb1\xd8\x87\x6d\x75\x2a\xac\xd5\x28\x76\xcb\xc6\x2c\x6a\xcd\x95\xf4\x7c\x8e\xde\xab\xf2\x0b\x9a\x5b\x69\x5b\x08\xbd\x8f\xb7\xc5\xe8\xd3\x24\xf4\x9a\xb3\x2e\x6c\x31\xd9\x9e\x6e\xf3\x31\xec\x61\x1c\x66\x8e\x97\x6a\x44\xb5\x32\xa8\x57\x02\x40\x94\x65\xea\xb1\x42\x5f\x1c\xc5\xfa\x80\x91\x47\xa3\x79\xb9\x92\x3e\x17\x51\xeb\x36\x1a\xfb\xaf\xd9\x0e\xf4\x95\x70\xf7\xbe\x40\x2e\x52\x6d\x2b\x4a\x9d\x49\xcd\xc6\xbf\xd7\x68\xb8\x7c\xbb\xa8\x75\x2f\x24\x80\xe0\xca\x4f\x42\x19\xee\x6e\xac\xc5\xe0\x13\xec\x5b\xa9\x3f\x45\xe9\x91\xf2\x4f\xc1\x30\xb6\xc4\x29\x6a\x94\x64\xfd\xd7\x27\xc4\x79\xfb\x9e\xe7\x95\x99\xaa\xbe\xbe\x32\xab\x8e\x76\x42\x5c\x2c\x67\xc4\x57\xf8\x2e\x2a\x9f\x46\xb6\xb0\x11\x21\xa9\x93\xd8\xc6\x45\xc2\x75\xb7\xf2\x1b\xb2\xa3\xde\x2b\x8e\x67\x13\x2f\xad\xb8\x11\x1d\xda\xfd\x38\x7f\xf1\x12\x66\x71\x3e\x3b\x26\x90\x75\x5e\xe3\x09\xa4\xda\x29\xdb\xfd\x92\x47\x08\xe8\xac\x14\xc4\xd3\x4c\x0e\xbf\x9e\xbd\xb9\x73\x93\x0d\x1f\x9e\x9d\x5d\x7d\x93\xfd\xf8\xf6\xce\xd9\x9b\x51\xfa\xe3\xff\xc3\x87\xc3\x9b\xe5\xc3\x9d\xe1\xf0\xec\xec\xea\xf9\xcb\xa7\x97\x17\x4f\xde\xaa\xe1\xcd\x95\x89\xcd\x75\xfb\x74\x73\x76\x85\x4f\xde\x9e\x08\x32\x1c\x3e\xfc\xdf\x11\xa5\xde\x67\xd7\xb1\x40\x6f\x90\x30\x64\xca\x50\x66\x7d\xd6\x5a\x92\x03\xf9\xd8\x9f\xaf\x27\x04\x15\xff\x2e\xc7\x86\xff\x68\xfb\x17\xd1\xf6\x01\x01\xb2\x1a\x7d\x1a\xff\x0f\x10\xa5\x08\x9b\x83\x1c\x6e\xd5\x92\xcb\xf4\x6d\x53\xb6\x53\xe9\xe5\x0a\x37\x8d\xc3\x44\x42\xd6\x58\x72\x77\xe9\x6e\xe4\xfe\x63\x16\xc0\xbd\xa4\x5f\x71\x5e\x54\x0b\x82\x46\x90\xac\xbb\x62\x44\x5e\x39\x8d\x70\xff\x1a\x17\x77\x53\x2b\xbf\x8b\xb3\x19\x4a\x7a\x00\x31\x2c\x67\xeb\x24\xcf\x0f\x1c\x81\x82\xec\xe1\xa8\xb9\xbf\x94\x78\xd0\x5f\xc7\x4e\xa9\x64\x00\xad\x06\x87\xf7\xe1\xb4\xc8\xbc\xc6\xc5\x67\x63\x2c\xed\xf9\x6c\xa0\x75\x58\x4c\x51\x5a\x53\x1e\x31\x1f\x78\x7c\x68\x04\xa5\x24\xfd\xfe\xbb\x23\x72\xa7\xa5\x72\xa2\xf5\x33\x0d\x38\x31\x2b\x84\xf7\x62\x31\xf8\x88\xc3\x75\x2c\x9e\xc7\x82\x3d\x32\x53\xd5\xa1\xe9\xe5\x53\xe7\x5b\x67\x03\x3d\x46\x8d\x84\x3f\xdb\x82\x3f\xf6\x94\xc4\x47\x52\xda\x68\x28\x3f\x1d\xa7\xeb\xce\x3b\x5f\xa9\xd9\xde\x5c\xbe\xb3\xdd\x63\xd9\xe0\x04\xa7\x04\x12\x14\x77\x82\x63\xab\x28\xec\x7e\x2f\x4d\xd3\x81\xad\xc9\xdc\x16\x81\xff\xb9\x70\xea\x47\x56\xaf\x26\x7b\x2f\x5b\xc8\x8d\xe2\x19\xc8\x7a\x51\xe1\xe6\x9b\x58\xec\x8d\x4a\x81\x04\xc5\x90\xc3\x9f\x7f\x0d\xfe\x1e\x00\xc6\x20\x2f\x94\xb0\x13\x00\x00")
 
 func crdsV1AgentOpenClusterManagementIo_policycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -318,7 +318,7 @@ func crdsV1AgentOpenClusterManagementIo_policycontrollers_crdYaml() (*asset, err
 	return a, nil
 }
 
-var _crdsV1AgentOpenClusterManagementIo_searchcollectors_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xcd\xae\xe3\x36\x0f\xdd\xe7\x29\x08\xcc\x62\x36\x5f\x1c\x5c\x7c\x9b\xc2\xbb\x41\x5a\x14\x17\x33\xd3\x09\x26\xc5\xdd\xcb\x32\x6d\xb3\x23\x53\xaa\x44\xa5\x4d\x8b\xbe\x7b\x21\xd9\x49\x6c\xe7\x67\x6e\x8b\xa9\x76\x16\x29\xf2\xf0\x1c\x92\x7e\x03\x5b\xeb\x8e\x9e\xda\x4e\x60\x6b\x59\x3c\x55\x51\xac\x0f\x20\x16\xa4\x43\xf8\xe4\x90\x61\x6b\x62\x10\xf4\xf0\x51\xb1\x6a\xb1\x47\x16\x70\xde\xfe\x82\x5a\x56\x2b\xe5\xe8\x05\x7d\x20\xcb\x25\x28\x47\xf8\xbb\x20\xa7\xaf\x50\x7c\xf9\x2e\x14\x64\x37\x87\xa7\xd5\x17\xe2\xba\x84\x6d\x0c\x62\xfb\xcf\x18\x6c\xf4\x1a\xbf\xc7\x86\x98\x84\x2c\xaf\x7a\x14\x55\x2b\x51\xe5\x0a\x80\x55\x8f\x25\x04\x54\x5e\x77\xda\x1a\x83\x3a\xa1\x29\x54\x8b\x2c\x85\x75\xc8\x6b\x3d\x80\x59\xf7\x67\x30\x05\xd9\x55\x70\xa8\xd3\xfb\xd6\xdb\xe8\x4a\xf8\xaa\xff\x90\x29\xa4\x27\x00\x03\xbe\x7d\x4e\xba\x3d\x25\xcd\x16\x43\x41\xde\xdf\xb2\x7e\xa0\x20\xd9\xc3\x99\xe8\x95\xb9\x86\x9c\x8d\x81\xb8\x8d\x46\xf9\x2b\xf3\x0a\x20\x68\xeb\xb0\x84\x9f\x12\x0c\xa7\x34\xd6\x2b\x80\xc3\xc0\x64\x86\xb5\x1e\xb9\x38\x3c\x0d\xa1\x74\x87\xbd\x1a\xf0\x02\xa4\xca\xde\xed\x9e\x5f\xfe\xbf\x9f\x5d\x03\xd4\x18\xb4\x27\x27\x59\x8f\x05\x66\xa0\x90\x35\x1d\xde\x40\x63\x7d\xfe\x5c\x22\x87\x77\xbb\xe7\x73\x3c\xe7\xad\x43\x2f\x74\xa2\x6a\x38\x13\xd1\x27\xb7\x8b\xec\x6f\x13\xc0\xc1\x0b\xea\xa4\x36\x0e\xe9\xc7\x22\xb1\x1e\x6b\x02\xdb\x80\x74\x14\xc0\xa3\xf3\x18\x90\x45\xe5\xb6\x80\xd9\xb1\x0d\x28\x06\x5b\xa5\xae\x2b\x60\x8f\x3e\x85\x81\xd0\xd9\x68\x6a\xd0\x96\x0f\xe8\x05\x3c\x6a\xdb\x32\xfd\x71\x8e\x7d\xee\x63\xa3\x04\x47\xc5\x2e\x87\x58\xd0\xb3\x32\x70\x50\x26\xe2\xff\x40\x71\x0d\xbd\x3a\x82\xc7\x94\x05\x22\x4f\xe2\x65\x97\x50\xc0\x47\xeb\x11\x88\x1b\x5b\x42\x27\xe2\x42\xb9\xd9\xb4\x24\xa7\x66\xd7\xb6\xef\x23\x93\x1c\x37\x7a\x32\x4c\x9b\x1a\x0f\x68\x36\x81\xda\x75\xa2\x9a\x04\xb5\x44\x8f\x1b\xe5\x68\x9d\xa1\xb3\xe4\x89\xe9\xeb\x37\x7e\x1c\x8f\xf0\x76\x86\x55\x8e\xa9\x57\x82\x78\xe2\x76\x62\xc8\x8d\xfb\x40\x81\xd4\xba\x49\x75\x35\x3e\x1d\xaa\xb8\x10\x9d\xae\x12\x3b\x9f\x7f\xd8\xff\x0c\xa7\xd4\x59\x8c\x25\xfb\x99\xf7\xcb\xc3\x70\x91\x20\x11\x46\xdc\xa0\x1f\x44\x6c\xbc\xed\x73\x4c\xe4\xda\x59\x62\xc9\x1f\xda\x10\xf2\x92\xfe\x10\xab\x9e\x24\xe9\xfe\x6b\xc4\x20\x49\xab\x02\xb6\x8a\xd9\x0a\x54\x08\xd1\xd5\x4a\xb0\x2e\xe0\x99\x61\xab\x7a\x34\x5b\x15\xf0\x3f\x17\x20\x31\x1d\xd6\x89\xd8\xd7\x49\x30\x5d\x5e\x4b\xe7\x81\xb5\x89\xe1\xb4\xa5\xee\xe8\xb5\x98\xd7\xbd\x43\x3d\x9b\x9c\x1a\x03\xf9\xd4\xdb\xa2\x04\xd3\x44\xdc\x5a\x59\xa7\x73\x7b\x72\xd3\x19\x37\x62\xda\x3c\x4b\x13\x40\x4f\xfc\x01\xb9\x95\xae\x84\xa7\x2b\xe3\x1d\x0e\x16\x41\xf3\x3a\xfb\x76\x91\x9b\x68\x4c\x5a\x85\x9f\x0e\xe8\x3d\xd5\xdf\x30\x72\x6b\x6c\xa5\xcc\x75\xbc\x99\x28\x3f\x66\xa7\x97\x3c\xfe\x33\x35\x86\xd7\xe3\x62\xb8\x8a\x71\x9f\xfe\x74\xa8\x57\xed\xb9\xa0\x9b\x1e\x00\xaa\xae\xf3\xff\x51\x99\xdd\xc3\x58\x5f\x29\x72\xea\x70\xd5\x90\x33\x3c\xbb\x68\xcc\xce\x1a\xd2\xc7\xdb\x69\x66\xb4\x5c\x9c\xc7\xfb\x0a\xd3\x9e\x71\xc3\x55\xfa\xb7\x50\xb3\xf9\xad\x43\x4e\x1b\xd8\x45\x63\xee\x20\x57\x69\x79\x8b\x22\x46\x3f\xa0\x78\x00\xff\x6e\x7d\x67\xf8\x7b\xd4\x1e\xe5\x36\xfc\x87\x31\x1e\xf0\xd3\xc5\xea\x7d\xac\x50\x5b\x6e\xa8\xbd\x97\xe0\xdf\xb5\x60\xda\x7c\x69\xa2\xe7\xf1\xd6\xd3\x61\xba\x6f\xc9\x63\xb6\x30\x2f\x67\x65\x61\xbe\x51\xca\xab\xd6\x96\x28\x89\xe1\x1f\x2c\xae\xec\x3f\x1b\x16\x5b\x85\xf4\xbf\x78\xe5\xee\xba\x89\xe3\xea\x72\x88\x58\x82\xf8\x38\x14\x1a\xc4\x7a\xd5\xe2\xf4\x26\x56\xe7\x3f\xea\x09\xff\x58\x0d\xfc\xf9\xd7\xea\xef\x00\x00\x00\xff\xff\x3f\x9d\x0d\x37\xfe\x0a\x00\x00")
+var _crdsV1AgentOpenClusterManagementIo_searchcollectors_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\x13\x37\x10\x7f\xcf\xa7\x18\x89\x4a\x24\x85\x4d\x8a\x5a\x55\xed\x0a\x81\x50\x8a\x10\x02\xca\x89\x9c\x78\x39\xa8\xe4\xec\xce\xee\xba\xf1\xda\xc6\x1e\xa7\xa4\xbd\x7e\xf7\x6a\xbc\x9b\x64\xb3\xf9\x73\x81\xa3\x42\x95\x1a\xf3\x80\x3d\xe3\xf1\xcc\xfc\xe6\xdf\xde\x1d\x98\x1a\xbb\x72\xb2\xac\x08\xa6\x46\x93\x93\xf3\x40\xc6\x79\x20\x03\x54\x21\xbc\xb6\xa8\x61\xaa\x82\x27\x74\xf0\x4a\x68\x51\x62\x8d\x9a\xc0\x3a\xf3\x3b\x66\x34\x18\x08\x2b\xdf\xa2\xf3\xd2\xe8\x14\x84\x95\xf8\x91\x50\xf3\xce\x8f\x17\x3f\xf9\xb1\x34\x93\xe5\x83\xc1\x42\xea\x3c\x85\x69\xf0\x64\xea\x37\xe8\x4d\x70\x19\xfe\x82\x85\xd4\x92\xa4\xd1\x83\x1a\x49\xe4\x82\x44\x3a\x00\xd0\xa2\xc6\x14\x3c\x0a\x97\x55\x99\x51\x0a\x33\xd6\x66\x2c\x4a\xd4\x34\x36\x16\x75\x92\x35\xca\x24\xf5\x46\x99\xb1\x34\x03\x6f\x31\xe3\xfb\xa5\x33\xc1\xa6\x70\x23\x7f\xf3\x92\xe7\x2b\x00\x8d\x7e\xb3\xf8\xe8\x74\xfd\x68\xa4\x28\xe9\xe9\xc5\x21\xea\x4b\xe9\x29\x72\x58\x15\x9c\x50\xfb\x2a\x47\xa2\x97\xba\x0c\x4a\xb8\x3d\xf2\x00\xc0\x67\xc6\x62\x0a\xbf\x8a\x1a\xbd\x15\x19\xe6\x03\x80\x65\xe3\xc9\xa8\x56\xd2\xfa\x62\xf9\xa0\x11\x95\x55\x58\x47\x17\xf1\x8e\x2d\x7b\x72\xf1\xfc\xed\xf7\xb3\x9d\x63\x80\x1c\x7d\xe6\xa4\x65\xbf\xee\xe9\x0c\xd2\x47\x4c\x9b\x3b\x50\x18\x17\xb7\x7d\xcd\xe1\xc9\xc5\xf3\x8d\x3c\xeb\x8c\x45\x47\x72\xed\xaa\x66\x75\x40\xef\x9c\xf6\x5e\xbf\xcb\x0a\x36\x5c\x90\x33\xda\xd8\x3c\xdf\x1a\x89\x79\x6b\x13\x98\x02\xa8\x92\x1e\x1c\x5a\x87\x1e\x35\x09\x56\x7f\x47\x30\x30\x93\xd0\x60\xe6\x1c\x75\x63\x98\xa1\x63\x31\xe0\x2b\x13\x54\x0e\x99\xd1\x4b\x74\x04\x0e\x33\x53\x6a\xf9\xe7\x46\xf6\x26\x8e\x95\x20\x6c\x11\xdb\x2e\xa9\x09\x9d\x16\x0a\x96\x42\x05\xbc\x0f\x42\xe7\x50\x8b\x15\x38\xe4\x57\x20\xe8\x8e\xbc\xc8\xe2\xc7\xf0\xca\x38\x04\xa9\x0b\x93\x42\x45\x64\x7d\x3a\x99\x94\x92\xd6\xc1\x9e\x99\xba\x0e\x5a\xd2\x6a\x92\x75\x92\x69\x92\xe3\x12\xd5\xc4\xcb\x32\x61\x57\x4b\xc2\x8c\x82\xc3\x89\xb0\x32\x89\xaa\x6b\x36\xd8\x8f\xeb\xfc\x8e\x6b\xd3\xc3\xdf\xdd\xd1\x95\x56\x1c\x2b\x9e\x9c\xd4\x65\x87\x10\x03\xf7\x04\x02\x1c\xba\x8c\xba\x68\xaf\x36\x86\x6e\x1d\xcd\x47\x0c\xc9\x9b\xa7\xb3\x4b\x58\x3f\x1d\xc1\xd8\x11\x0a\xad\xdf\xb7\x17\xfd\x16\x02\x76\x98\xd4\x05\x72\x30\x49\x0f\x85\x33\x75\xf4\x38\xea\xdc\x1a\xa9\x29\x6e\x32\x25\x51\xf7\xdd\xef\xc3\xbc\x96\xc4\xb8\x7f\x08\xe8\x89\xb1\x1a\xc3\x54\x68\x6d\x08\xe6\x08\xc1\xe6\x82\x30\x1f\xc3\x73\x0d\x53\x51\xa3\x9a\x0a\x8f\xff\x3a\x00\xec\x69\x9f\xb0\x63\xcf\x83\xa0\x5b\xbc\xb6\x3f\x96\x92\xb6\x5e\xeb\x10\xd6\x55\xea\x08\x5e\xbd\x7c\x9d\x59\xcc\x76\x32\x27\x47\x2f\x1d\xc7\x36\x09\x42\xce\x88\xde\x85\x1d\xc9\x87\x33\x97\x57\x5b\x11\xb9\xf2\xf4\x49\x00\xb5\xd4\x2f\x51\x97\x54\xa5\xf0\x60\x8f\x78\xc4\x07\x3d\xa1\xb1\x9c\x7d\x39\xc9\x45\x50\x8a\x4b\xe1\xeb\x25\x3a\x27\xf3\x2f\xa8\x73\xa9\xcc\x5c\xa8\x7d\x79\x3b\xa0\x3c\x8b\x4c\x6f\x39\x71\xfc\x0e\x1a\xcd\xed\x26\xa5\xfc\x9e\x8c\xe3\xee\xe7\x25\x6b\x51\x6e\x0c\x3a\xc8\x01\x20\xf2\x3c\xf6\x47\xa1\x2e\x4e\xca\xba\xc1\xc8\x93\x01\xb9\x5e\x51\x9f\x8b\xa0\xd4\x85\x51\x32\x5b\x1d\x7e\x66\xc7\x2d\x5b\xe6\xf6\x7c\x8e\x5c\x67\x6c\xbc\x1f\x7b\x8b\x2c\x26\x7f\x54\xa8\xb9\x02\xdb\xa0\xd4\x41\x91\x00\x82\x8b\x37\x09\xa9\xd1\x35\x5a\x0c\x3e\xc3\xbe\x8d\xfa\x33\xcc\x1c\x52\xfa\x39\x32\xb4\xc9\x71\x86\x4d\x77\xff\xfa\x80\x58\x67\x3e\xae\xa6\x46\x17\xb2\xfc\xfa\xca\x6c\x9a\xd2\x19\x71\xb1\x9e\xef\xde\xe0\x87\x20\x5d\x9c\xb7\x7c\x27\x42\x62\x33\x30\xb5\x0d\x84\xdb\x86\xe3\x3a\xbc\xe3\x83\x4f\x9c\xce\x26\x5e\x4a\x72\x2f\x39\x46\xfd\x34\x7f\xf1\x12\x7a\xf5\xba\x38\xc5\x90\xb4\x5e\xe3\x21\xa2\x44\x77\x06\xe7\x09\x00\x5a\x2b\x05\xf1\x40\x92\xc2\x6f\xc3\x77\xf7\xae\x93\xd1\xe3\xe1\xf0\xea\xbb\xe4\xe7\xf7\xf7\x86\xef\xc6\xf1\x3f\xdf\x8e\x1e\x8f\xae\xd7\x9b\x7b\xa3\xd1\x70\x78\xf5\xe2\xd5\xb3\xcb\x8b\xa7\xef\xe5\xe8\xfa\x4a\x87\x7a\xd1\xec\xae\x87\x57\xf8\xf4\xfd\x99\x42\x46\xa3\xc7\xdf\x9c\x50\xea\x63\xb2\x08\x73\x74\x1a\x09\x7d\x22\x35\x25\xc6\x25\x8d\x25\x29\x90\x0b\x78\xf4\xea\x0d\x41\xc5\xff\xd6\x9d\xff\x7f\xd8\xfe\x43\xb0\xdd\xc0\x40\x46\xa1\x8b\x13\xfc\x11\xa0\x24\x61\x7d\x14\xc3\x9d\x5a\x72\x59\x21\x58\x93\x37\x83\xe5\xe5\x46\x6e\x9c\x68\x89\x44\x56\x61\xce\xdd\xa5\x7d\x91\xfb\x8f\x5e\x01\xf7\x92\xc3\x8a\xf3\xa2\x4a\x10\xd4\x82\xb2\xaa\x2d\x46\xe4\xa4\x55\x08\x0f\x17\xb8\xba\x1f\x5b\xf9\x7d\x2c\x0a\xcc\xe8\x11\x04\xbf\x1e\x8f\x23\x3f\x6f\x38\x02\x45\x7f\xd0\xea\xfe\x1e\xae\x39\x1e\x1d\xae\x63\xe7\x54\x32\x80\x46\x83\xe3\x74\x38\x2f\x32\x17\xb8\xba\xb5\x8c\xb5\x3d\xb7\x16\xb4\x0d\x8b\x19\x66\x46\xe7\x27\xcc\x07\x1e\x1f\x6a\x41\x31\x49\x7f\xfc\xe1\x04\xdf\x79\xa9\x1c\x61\xbd\xa5\x01\x67\x66\x85\x70\x4e\xac\x06\x9f\x70\xb9\x0a\xf3\x17\x61\xce\x1e\x29\x64\x79\x6c\x7a\xf9\xbc\xf9\xb6\xed\xaa\xbd\x0f\xc4\xa4\x3b\xa9\x1f\xa7\xc4\x19\xbe\x47\xee\x0f\xe2\x3d\xf2\x01\x53\x06\x67\x78\xc1\x93\xa0\xd0\x8b\x86\x93\x5f\x45\x91\x7f\x67\x12\x37\x73\xcf\x7f\x0f\x38\xf3\xc3\xe8\xa0\x1e\x7b\x87\x8d\xc4\x4e\xad\xf4\x64\x9c\x28\xb1\x7b\x12\xe6\x7b\x93\x91\x27\x41\xc1\xa7\xf0\xd7\xdf\x83\x7f\x06\x00\xe5\x36\x77\xc6\x5b\x13\x00\x00")
 
 func crdsV1AgentOpenClusterManagementIo_searchcollectors_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -338,7 +338,7 @@ func crdsV1AgentOpenClusterManagementIo_searchcollectors_crdYaml() (*asset, erro
 	return a, nil
 }
 
-var _crdsV1AgentOpenClusterManagementIo_workmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x41\xaf\xdb\x36\x0c\xbe\xe7\x57\x10\xe8\xa1\x97\x25\xc1\xc3\x2e\x83\x6f\x45\x36\x0c\x0f\x7d\x5d\x83\x66\x78\x3b\xcb\x32\x6d\x73\x4f\x96\x34\x8a\x4a\x97\x0d\xfb\xef\x83\x64\x27\xb1\x13\xe7\x35\x28\x5a\xdd\x2c\xd2\xe4\x47\x7e\xe4\xa7\x37\xb0\x71\xfe\xc0\xd4\xb4\x02\x1b\x67\x85\xa9\x8c\xe2\x38\x80\x38\x90\x16\xe1\xa3\x47\x0b\x1b\x13\x83\x20\xc3\x07\x65\x55\x83\x1d\x5a\x01\xcf\xee\x4f\xd4\xb2\x58\x28\x4f\xcf\xc8\x81\x9c\x2d\x40\x79\xc2\xbf\x05\x6d\xfa\x0a\xab\x97\x9f\xc2\x8a\xdc\x7a\xff\xb0\x78\x21\x5b\x15\xb0\x89\x41\x5c\xf7\x09\x83\x8b\xac\xf1\x67\xac\xc9\x92\x90\xb3\x8b\x0e\x45\x55\x4a\x54\xb1\x00\xb0\xaa\xc3\x02\x3e\x3b\x7e\xe9\x72\x32\x0e\x2b\xd5\xa0\x95\x95\xf3\x68\x97\xba\x07\xb2\xec\x4e\x40\x56\xe4\x16\xc1\xa3\x4e\xff\x36\xec\xa2\x2f\xe0\x8b\xfe\x7d\x96\x90\x7e\x01\xe8\xb1\xfd\xe1\xf8\xa5\xaf\x8e\xf3\xad\xa1\x20\xef\x2f\x2d\x4f\x14\x24\x5b\xbd\x89\xac\xcc\x14\x66\x36\x04\xb2\x4d\x34\x8a\x27\xa6\x05\x40\xd0\xce\x63\x01\xbf\xa5\xb4\x5e\x69\xac\x16\x00\xfb\xbe\x6b\x19\xc6\x72\xa8\x7b\xff\xd0\x87\xd1\x2d\x76\xaa\xc7\x07\x90\x2a\x79\xb7\x7d\x7c\xfe\x71\x37\xb9\x06\xa8\x30\x68\x26\x2f\xb9\xf7\x23\x9c\x40\x21\x73\xd7\xfb\x43\xed\x38\x7f\x8e\xd1\xc2\xbb\xed\xe3\x29\x8e\x67\xe7\x91\x85\x8e\x2d\xe9\xcf\x88\xd8\xd1\xed\x45\xd6\xb7\x09\x58\xef\x05\x55\x62\x14\xfb\xd4\x43\x71\x58\x0d\xb5\x80\xab\x41\x5a\x0a\xc0\xe8\x19\x03\x5a\x51\x99\x7a\x98\x1c\x57\x83\xb2\xe0\xca\x34\x59\x2b\xd8\x21\xa7\x30\x10\x5a\x17\x4d\x05\xda\xd9\x3d\xb2\x00\xa3\x76\x8d\xa5\x7f\x4e\xb1\x4f\xb3\x6a\x94\xe0\xc0\xd0\xf9\x90\x15\x64\xab\x0c\xec\x95\x89\xf8\x03\x28\x5b\x41\xa7\x0e\xc0\x98\xb2\x40\xb4\xa3\x78\xd9\x25\xac\xe0\x83\x63\x04\xb2\xb5\x2b\xa0\x15\xf1\xa1\x58\xaf\x1b\x92\xe3\x40\x6b\xd7\x75\xd1\x92\x1c\xd6\x7a\xb4\x30\xeb\x0a\xf7\x68\xd6\x81\x9a\xa5\x62\xdd\x92\xa0\x96\xc8\xb8\x56\x9e\x96\x19\xba\x95\xbc\x15\x5d\xf5\x86\x87\x15\x08\x6f\x27\x58\xe5\x90\x66\x24\x08\x93\x6d\x46\x86\x3c\xa0\xaf\x30\x90\xc6\x34\x31\xae\x86\x5f\xfb\x2a\xce\x8d\x4e\x57\xa9\x3b\x9f\x7e\xd9\xfd\x0e\xc7\xd4\x99\x8c\xcb\xee\xe7\xbe\x9f\x7f\x0c\x67\x0a\x52\xc3\xc8\xd6\xc8\x3d\x89\x35\xbb\x2e\xc7\x44\x5b\x79\x47\x56\xf2\x87\x36\x84\xf6\xb2\xfd\x21\x96\x1d\x49\xe2\xfd\xaf\x88\x41\x12\x57\x2b\xd8\x28\x6b\x9d\x40\x89\x10\x7d\xa5\x04\xab\x15\x3c\x5a\xd8\xa8\x0e\xcd\x46\x05\xfc\xee\x04\xa4\x4e\x87\x65\x6a\xec\x7d\x14\x8c\x05\xea\xd2\xb9\xef\xda\xc8\x70\x54\xa3\x1b\x7c\x8d\xf6\x74\xe7\x51\x4f\xb6\xa6\xc2\x40\x9c\xe6\x5a\x94\x60\xda\x86\x4b\x59\x3a\x9e\xf9\x8d\x4d\x67\x50\xbc\x27\x55\xa2\xb9\x32\x02\xa8\xaa\xca\x9a\xab\xcc\xf6\x66\x88\x57\x1a\xf1\x6a\xe1\xa3\xf4\x49\xe8\xae\xc3\x76\x64\x9f\xd0\x36\xd2\x16\xf0\x70\x23\xe8\x6c\xc6\x51\xd0\xac\x9e\xdf\x2e\x72\x1d\x8d\x49\xca\xfb\x71\x8f\xcc\x54\x7d\xc3\xc8\x8d\x71\xa5\x32\xd7\xf1\x26\xb3\xf0\x6b\x76\x7a\xce\xaa\x33\x19\x84\xfe\xef\x41\x8f\xae\x62\xdc\x66\x3f\x1d\xea\x54\x73\x2a\x68\xd6\xe3\xfe\x31\xf8\x42\x91\x63\x87\xd9\x71\x38\xe1\xd9\x46\x63\xb6\xce\x90\x3e\xcc\xa7\x99\xb4\xe5\xec\x3c\xdc\x97\x98\xe4\xcd\xf7\x57\xe9\x39\xa3\x7a\xfd\xb9\x45\x9b\x84\xdf\x47\x63\x6e\x20\x57\xe9\xcd\x10\x45\x36\x3d\x89\x09\xc5\x2b\xf0\x6f\xd6\x77\x82\xbf\x43\xcd\x28\xf3\xf0\xbf\x76\x5d\xda\x58\xbe\x8f\x25\x6a\x67\x6b\x6a\x6e\x25\xf8\xba\x11\x4c\x82\x9b\xc4\x64\x1a\x6f\x39\x15\x88\x79\x5b\x5a\xb4\xdb\x96\xbc\x82\x17\xe6\xcb\x3d\xba\x30\xcf\x94\x79\x97\x92\x8a\x92\x18\xee\xd4\xd2\xec\x3b\x59\x22\x57\x86\xf4\x7c\xdd\x21\xa7\xb3\xf9\xaf\x2e\xfb\x68\x05\x08\xc7\xbe\xc0\x20\x8e\x55\x83\xe3\x9b\x58\x9e\x1e\xf7\x23\xee\xa1\x0a\xf8\xf7\xbf\xc5\xff\x01\x00\x00\xff\xff\x36\x82\x6a\xa9\x6d\x0b\x00\x00")
+var _crdsV1AgentOpenClusterManagementIo_workmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x8f\x13\x37\x10\x7f\xdf\x4f\x31\x12\x95\xb8\x14\x36\x29\x6a\x55\xb5\x2b\x04\x42\x29\x42\x08\x28\x27\x72\xa2\x0f\x07\x95\x9c\xdd\xd9\x5d\x37\x5e\xdb\xd8\xe3\x40\xda\xeb\x77\xaf\xc6\xbb\x49\x76\x73\x49\x2e\xfc\xa9\x50\xa5\x9e\xef\xe1\xec\x19\xcf\xbf\xdf\xcc\x78\xf6\x6e\xc1\xd4\xd8\x95\x93\x55\x4d\x30\x35\x9a\x9c\x9c\x07\x32\xce\x03\x19\xa0\x1a\xe1\xa5\x45\x0d\x53\x15\x3c\xa1\x83\x17\x42\x8b\x0a\x1b\xd4\x04\xd6\x99\x3f\x30\xa7\x24\x11\x56\xbe\x46\xe7\xa5\xd1\x19\x08\x2b\xf1\x03\xa1\xe6\x9d\x1f\x2f\x7e\xf2\x63\x69\x26\xcb\x7b\xc9\x42\xea\x22\x83\x69\xf0\x64\x9a\x57\xe8\x4d\x70\x39\xfe\x82\xa5\xd4\x92\xa4\xd1\x49\x83\x24\x0a\x41\x22\x4b\x00\xb4\x68\x30\x83\xf7\xc6\x2d\x9a\xa8\xcc\xf9\xb1\xa8\x50\xd3\xd8\x58\xd4\x69\xde\x1a\x92\x36\x1b\x43\xc6\xd2\x24\xde\x62\xce\x77\x2b\x67\x82\xcd\xe0\x46\xfe\x56\x8b\xe7\x2b\x00\xad\x6d\xbf\x19\xb7\x68\xbd\x73\xf1\x54\x49\x4f\xcf\x76\x29\xcf\xa5\xa7\x48\xb5\x2a\x38\xa1\x86\x66\x46\x82\x97\xba\x0a\x4a\xb8\x01\x29\x01\xf0\xb9\xb1\x98\xc1\xaf\xa2\x41\x6f\x45\x8e\x45\x02\xb0\x6c\xa3\x16\xcd\x48\x3b\xbf\x97\xf7\x5a\x31\x79\x8d\x4d\x0c\x07\xef\xd8\x93\x47\xe7\x4f\x5f\x7f\x3f\x1b\x1c\x03\x14\xe8\x73\x27\x2d\xc7\x70\x60\x27\x48\x1f\xb1\x6b\xf9\xa1\x34\x2e\x6e\xfb\xd6\xc2\xa3\xf3\xa7\x1b\x39\xd6\x19\x8b\x8e\xe4\x3a\x24\xed\xea\x01\xdb\x3b\xdd\xd1\x7a\x9b\x0d\x6b\xb9\xa0\x60\x44\xb1\x55\xdd\x39\x87\x45\xe7\x0b\x98\x12\xa8\x96\x1e\x1c\x5a\x87\x1e\x35\x09\x36\x7b\x20\x18\x98\x49\x68\x30\x73\xce\xac\x31\xcc\xd0\xb1\x18\xf0\xb5\x09\xaa\x80\xdc\xe8\x25\x3a\x02\x87\xb9\xa9\xb4\xfc\x73\x23\x7b\x93\xab\x4a\x10\x76\x08\x6d\x97\xd4\x84\x4e\x0b\x05\x4b\xa1\x02\xde\x05\xa1\x0b\x68\xc4\x0a\x1c\xb2\x16\x08\xba\x27\x2f\xb2\xf8\x31\xbc\x30\x0e\x41\xea\xd2\x64\x50\x13\x59\x9f\x4d\x26\x95\xa4\x75\x42\xe7\xa6\x69\x82\x96\xb4\x9a\xe4\xbd\x82\x99\x14\xb8\x44\x35\xf1\xb2\x4a\x85\xcb\x6b\x49\x98\x53\x70\x38\x11\x56\xa6\xd1\x74\xcd\x0e\xfb\x71\x53\xdc\x72\x5d\x09\xf8\xdb\x03\x5b\x69\xc5\x39\xe2\xc9\x49\x5d\xf5\x08\x31\x41\x8f\x20\xc0\x69\xca\x88\x8b\xee\x6a\xeb\xe8\x36\xd0\x7c\xc4\x90\xbc\x7a\x3c\xbb\x80\xb5\xea\x08\xc6\x40\x28\x74\x71\xdf\x5e\xf4\x5b\x08\x38\x60\x52\x97\xc8\x89\x24\x3d\x94\xce\x34\x31\xe2\xa8\x0b\x6b\xa4\xa6\xb8\xc9\x95\x44\xbd\x1b\x7e\x1f\xe6\x8d\x24\xc6\xfd\x5d\x40\x4f\x8c\xd5\x18\xa6\x42\x6b\x43\x30\x47\x08\xb6\x10\x84\xc5\x18\x9e\x6a\x98\x8a\x06\xd5\x54\x78\xfc\xd7\x01\xe0\x48\xfb\x94\x03\x7b\x1a\x04\xfd\x06\xb5\xfd\x61\x29\x59\x17\xb5\x1e\x61\xdd\x8d\x0e\xe0\xd5\xab\xd3\x99\xc5\x7c\x50\x35\x05\x7a\xe9\x38\xaf\x49\x10\x72\x35\xf4\x98\x07\x12\xf7\x57\x2c\xaf\xae\xe3\x3d\x17\x73\x54\xd7\x88\x00\xa2\x28\x62\xcf\x15\xea\xfc\xa0\x88\x23\x81\x38\xea\x78\x4f\x3d\x37\xba\xeb\x62\x1b\xa9\x9f\xa3\xae\xa8\xce\xe0\x5e\xf2\x11\x1a\x7b\x42\x63\xf7\xfc\x72\x92\xcb\xa0\x14\x77\xde\x97\x4b\x74\x4e\x16\x5f\xd0\xe6\x4a\x99\xb9\x50\xd7\xe5\x0d\x72\xe1\x49\x64\x7a\xcd\xf5\xea\x07\x89\xd0\xde\x6e\x2b\xd9\x5f\x93\x71\x18\x7d\x5e\xb2\x11\xd5\xc6\xa1\xbd\x1c\xa7\xa7\xc1\x0d\x4e\xde\x98\x0e\x1b\x7b\xce\x83\x52\xe7\x46\xc9\x7c\xb5\x5f\xcd\x20\x2c\x5b\xe6\xee\x7c\x8e\xdc\xde\x6c\xbc\x1f\x9f\x33\x59\x4e\xde\xd7\xa8\xb9\xf1\xdb\xa0\xd4\x5e\x91\x00\x82\xdf\x0c\x12\x52\xa3\x6b\xad\x48\x3e\xc1\xbf\x8d\xf9\x33\xcc\x1d\x52\xf6\x29\x32\xb4\x29\x70\x86\x0a\x73\x32\xee\xeb\x03\x62\x9d\xf9\xb0\x9a\x1a\x5d\xca\xea\xeb\x1b\xb3\x79\x0b\x4f\xc8\x8b\xf5\xe8\xf8\x0a\xdf\x05\xe9\xe2\x38\xe7\x7b\x19\x12\xdf\x20\xd3\xd8\x40\xb8\x7d\xe7\x5c\x8f\x77\xbc\x57\xc5\xf1\x6a\xe2\xa5\x24\x3f\x61\x87\xa8\x1f\x17\x2f\x5e\x42\xaf\x5e\x96\xc7\x18\xd2\x2e\x6a\x3c\xbb\xec\xb6\xfe\xfd\x9c\x47\x00\xe8\xbc\x14\xc4\x73\x50\x06\xbf\x9f\xbd\xb9\x73\x95\x8e\x1e\x9e\x9d\x5d\x7e\x97\xfe\xfc\xf6\xce\xd9\x9b\x71\xfc\xe3\xdb\xd1\xc3\xd1\xd5\x7a\x73\x67\x34\x3a\x3b\xbb\x7c\xf6\xe2\xc9\xc5\xf9\xe3\xb7\x72\x74\x75\xa9\x43\xb3\x68\x77\x57\x67\x97\xf8\xf8\xed\x89\x42\x46\xa3\x87\xdf\x1c\x31\xea\x43\xba\x08\x73\x74\x1a\x09\x7d\x2a\x35\xa5\xc6\xa5\xad\x27\x19\x90\x0b\x78\xf0\xea\x0d\x49\xc5\xbf\xeb\x81\xe3\x7f\xd8\xfe\x43\xb0\xdd\xc0\x40\x46\xa1\x8b\x1f\x0e\x07\x80\x92\x84\xcd\x41\x0c\x07\xbd\xe4\xa2\x46\xb0\xa6\x68\xe7\xd9\x8b\x8d\xdc\x38\x48\x13\x89\xbc\xc6\x82\x5f\x97\x4e\x23\xbf\x3f\x7a\x05\xfc\x96\xec\x37\x9c\x17\xd5\x82\xa0\x11\x94\xd7\x5d\x33\x22\x27\xad\x42\xb8\xbf\xc0\xd5\xdd\xf8\x94\xdf\xc5\xb2\xc4\x9c\x1e\x40\xf0\xeb\xa9\x3c\xf2\xf3\x86\x33\x50\x90\x39\x9c\x35\xf7\xd7\x1c\x0f\xf6\xf7\xb1\x53\x3a\x19\x40\x6b\xc1\x61\x3a\x9c\x96\x99\x0b\x5c\x7d\xb6\x8c\xb5\x3f\x9f\x2d\x68\x9b\x16\x33\xcc\x8d\x2e\x8e\xb8\x0f\x3c\x3e\x34\x82\x62\x91\xfe\xf8\xc3\x11\xbe\xd3\x4a\x39\xc2\xfa\x99\x0e\x9c\x58\x15\xc2\x39\xb1\x4a\x3e\xe2\x72\x1d\xe6\xcf\xc2\x9c\x23\x52\xca\xea\xd0\xf4\xf2\x69\xf3\x6d\xf7\xaa\xee\x7c\x97\xa6\xc3\xaf\x8f\xfd\x34\xfe\x34\x38\x4c\x89\xf3\xfd\x0e\x79\x77\x48\xdf\x21\xef\x71\x33\x39\x21\x42\x9e\x04\x85\x9d\x4c\x39\xf8\xa1\x16\x79\x07\x13\xba\x99\x7b\xfe\xf7\xc4\x09\xdf\x6a\x7b\xf5\x5f\x3b\x6c\xa5\xf5\xfa\xa7\x27\xe3\x44\x85\xfd\x93\x30\xbf\x36\x2d\x79\x12\x14\x7c\x06\x7f\xfd\x9d\xfc\x33\x00\xb3\xae\x54\x7a\xca\x13\x00\x00")
 
 func crdsV1AgentOpenClusterManagementIo_workmanagers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -358,7 +358,7 @@ func crdsV1AgentOpenClusterManagementIo_workmanagers_crdYaml() (*asset, error) {
 	return a, nil
 }
 
-var _crdsKube111AgentOpenClusterManagementIo_applicationmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\x90\x43\x2e\xb5\x8d\x45\x2f\x85\x6e\x0b\xb7\x28\x16\x49\x1a\x23\x2e\xf6\x4e\x91\x63\x69\x1a\x8a\x64\xc9\xa1\x5b\xb7\xe8\x7f\x2f\x86\x94\x3f\xe4\xca\xbb\x0d\x90\xe8\xa6\x99\xe1\xe3\x9b\x37\x1f\xd2\x1b\xd8\xf8\x70\x8c\xd4\xf5\x0c\x1b\xef\x38\x52\x9b\xd9\xc7\x04\xec\x81\x7b\x84\x8f\x01\x1d\x6c\x6c\x4e\x8c\x11\x3e\x28\xa7\x3a\x1c\xd0\x31\x84\xe8\x7f\x43\xcd\x8b\x85\x0a\xf4\x8c\x31\x91\x77\x0d\xa8\x40\xf8\x27\xa3\x93\xb7\xb4\xfa\xfc\x43\x5a\x91\x5f\x1f\x1e\x5a\x64\xf5\xb0\xf8\x4c\xce\x34\xb0\xc9\x89\xfd\xf0\x09\x93\xcf\x51\xe3\x8f\xb8\x27\x47\x4c\xde\x2d\x06\x64\x65\x14\xab\x66\x01\xe0\xd4\x80\x82\x16\x2c\x69\x25\xde\xa1\x5c\x1c\xd3\x4a\x75\xe8\x78\xe5\x03\xba\xa5\xae\xa4\x96\xc3\x99\xd4\x8a\xfc\x22\x05\xd4\x02\xd1\x45\x9f\x43\x03\xaf\xc6\xd7\xcb\x92\x1c\x01\xa8\x14\x1f\x2f\xf7\xd6\x84\x63\x71\x5a\x4a\xfc\xee\x4e\xc0\x7b\x4a\x5c\x82\x82\xcd\x51\xd9\x59\xee\xc5\x9f\xc8\x75\xd9\xaa\x38\x17\xb1\x00\x48\xda\x07\x6c\xe0\x17\xa1\x14\x94\x46\x23\xb6\xdc\xc6\x51\xae\x91\x66\x62\xc5\x39\x35\xf0\xf7\x3f\x0b\x80\x83\xb2\x64\x0a\x4c\x75\x4a\xae\x8f\xdb\xa7\xe7\xef\x77\xba\xc7\x41\x55\x23\x80\xc1\xa4\x23\x85\x12\x37\x93\x00\x50\x2a\xe5\xae\x87\x60\xef\x63\x79\x9d\x49\x03\x1e\xb7\x4f\x23\x66\x88\x3e\x60\x64\x3a\xf1\x92\xe7\xaa\x1d\xce\xb6\x9b\xdb\xdf\x0a\xbd\x1a\x03\x46\x1a\x00\xeb\xdd\x87\x6a\x43\x03\xa9\xb2\xf0\x7b\xe0\x9e\x12\x44\x0c\x11\x13\x3a\x2e\x44\xae\x60\x41\x42\x94\x03\xdf\x4a\x2f\xae\x60\x87\x51\x40\x20\xf5\x3e\x5b\x03\xda\xbb\x03\x46\x86\x88\xda\x77\x8e\xfe\x3a\x23\x9f\xbb\xdb\x2a\xc6\xb1\x72\xa7\x87\x1c\x63\x74\xca\x8a\xb0\x19\xbf\x03\xe5\x0c\x0c\xea\x08\x11\xe5\x0e\xc8\xee\x0a\xad\x84\xa4\x15\x7c\xf0\x11\x81\xdc\xde\x37\xd0\x33\x87\xd4\xac\xd7\x1d\xf1\x69\x00\xb4\x1f\x86\xec\x88\x8f\x6b\x7d\x35\x60\x6b\x83\x07\xb4\xeb\x44\xdd\x52\x45\xdd\x13\xa3\xe6\x1c\x71\xad\x02\x2d\x0b\x71\xc7\x65\x8a\x06\xf3\xe6\x5c\xfe\xb7\x57\x4c\xf9\x28\x9d\x92\x38\x92\xeb\xce\xe6\xd2\xc0\x77\x75\x97\xee\x95\x42\xab\xf1\x58\xe5\x7f\x91\x57\x4c\xa2\xca\xa7\x9f\x76\xbf\xc2\xe9\xd2\x52\x82\xa9\xe6\x45\xed\xcb\xb1\x74\x11\x5e\x84\x22\xb7\xc7\x58\x0b\xb7\x8f\x7e\x28\x88\xe8\x4c\xf0\xe4\xb8\xbc\x68\x4b\xe8\xa6\xa2\xa7\xdc\x0e\xc4\x52\xe9\xdf\x33\x26\x96\xfa\xac\x60\xa3\x9c\xf3\x0c\x2d\x42\x0e\x46\x31\x9a\x15\x3c\x39\xd8\xa8\x01\xed\x46\x25\xfc\xe6\xb2\x8b\xc2\x69\x29\x92\xbe\x2e\xfc\xf5\xf6\x9a\x06\x56\xb5\xce\xe6\xd3\x7e\x9a\xad\xd0\x7f\xe7\x72\x17\x50\x4f\x86\xc4\x60\xa2\x28\x8d\xcc\x8a\x51\xda\xff\xce\xb6\xba\x3f\xa1\xf2\x8c\xbb\x50\xf6\xcc\xd4\x01\x30\x90\x7b\x8f\xae\xe3\xbe\x81\x87\x1b\xd7\x6c\xe6\x37\x70\x65\x6d\x7d\x0d\xcc\x7d\xb6\x56\x56\xf3\xc7\x03\xc6\x48\xe6\xab\x60\x76\xd6\xb7\xca\xde\x22\x4d\x4a\xf0\x73\x09\x79\x2e\x83\x3d\x11\xbe\x9e\x1d\x47\xfe\x06\xe1\x9e\xcc\xf2\xd0\xa0\xba\x73\x12\x33\x7e\x00\x65\x4c\xf9\x04\x2a\xbb\x7d\x01\xe7\xc5\xc4\xae\xdd\x37\x0d\x37\xe1\xb1\xcd\xd6\x6e\xbd\x25\x7d\x9c\xbb\x60\x22\xc4\x25\x74\xb4\xb7\x28\x9b\x23\x54\x93\x7c\x20\x68\xbf\xfe\xa3\x47\x27\xbb\x34\x64\x6b\x41\xcd\x72\x96\xe1\x53\xe4\xe4\x13\x23\x0c\xee\xd2\xbe\x93\xd5\x99\xf6\x0e\x75\x44\x9e\xa3\xfd\xc2\xf9\xbb\x8a\xf4\xb9\x7d\x97\x5b\xd4\xde\xed\xa9\x9b\x87\xfe\xf2\x06\x93\xed\x25\xc3\x79\x8d\xb4\xbc\x1e\x8e\x7b\xf6\x32\x34\x13\xe7\x6d\xf7\x4f\x9c\xb5\x15\x27\xa6\x99\x7c\x5e\xdd\x44\xf5\x2f\xe2\x0b\x76\x51\x39\x30\x19\x0a\xdf\x26\xd9\xfc\xff\x77\x1d\xdd\xf0\x38\x9c\xfe\x1b\x0f\x0f\x97\xb7\xc2\x68\x39\xfe\x02\x1e\xaa\xf2\xf5\x92\x06\x38\x66\x1c\xff\x80\x7c\x54\x1d\x8e\x96\x7f\x03\x00\x00\xff\xff\x28\x1e\xc1\xc2\xc4\x0a\x00\x00")
+var _crdsKube111AgentOpenClusterManagementIo_applicationmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xcd\x8e\xdb\x36\x10\xbe\xeb\x29\x06\xc8\x21\x97\xd8\xc6\xa2\x45\x51\x08\x41\x80\xc0\x2d\x8a\x20\x49\xb3\x88\x83\xdc\x69\x6a\x24\x4d\x97\x22\x19\x72\xe8\xc6\x2d\xfa\xee\xc5\x50\xb2\x2d\x39\xf2\x3a\xdb\x4d\x11\xcb\x17\x71\xfe\xbf\xf9\xa3\x9e\xc0\xda\xf9\x7d\xa0\xa6\x65\x58\x3b\xcb\x81\xb6\x89\x5d\x88\xc0\x0e\xb8\x45\x78\xe7\xd1\xc2\xda\xa4\xc8\x18\xe0\xad\xb2\xaa\xc1\x0e\x2d\x83\x0f\xee\x0f\xd4\x5c\x14\xca\xd3\x47\x0c\x91\x9c\x2d\x41\x79\xc2\xcf\x8c\x56\xde\xe2\xf2\xee\xe7\xb8\x24\xb7\xda\xdd\x6c\x91\xd5\x4d\x71\x47\xb6\x2a\x61\x9d\x22\xbb\xee\x3d\x46\x97\x82\xc6\x5f\xb0\x26\x4b\x4c\xce\x16\x1d\xb2\xaa\x14\xab\xb2\x00\xb0\xaa\x43\xd1\xe6\x0d\x69\x25\xd4\x2e\x1b\x0e\x71\xa9\x1a\xb4\xbc\x74\x1e\xed\x42\xf7\x4e\x2d\xba\xa3\x53\x4b\x72\x45\xf4\xa8\x45\x45\x13\x5c\xf2\x25\x5c\xe5\xef\x8d\x45\x11\x01\xe8\x5d\x7c\x79\xb2\xdb\x07\x1c\x32\xd1\x50\xe4\xd7\x17\x18\xde\x50\xe4\xcc\xe4\x4d\x0a\xca\xcc\xfa\x9e\xe9\x91\x6c\x93\x8c\x0a\x73\x1c\x05\x40\xd4\xce\x63\x09\xbf\xab\x0e\xa3\x57\x1a\x2b\x39\x4b\xdb\x30\xc0\x35\xb8\x19\x59\x71\x8a\x25\xfc\xfd\x4f\x01\xb0\x53\x86\xaa\xac\xa6\x27\x4a\xac\x2f\x6f\x5f\x7d\xfc\x61\xa3\x5b\xec\x32\x9c\x72\x5c\x61\xd4\x81\x7c\xe6\x9b\x09\x00\x28\xe6\x74\xf7\x42\x50\xbb\x90\x5f\x67\xc2\x80\x97\xb7\xaf\x06\x9d\x3e\x38\x8f\x81\xe9\x00\x9f\x3c\xa3\x72\x38\x9e\x9d\x59\x7f\x2a\xee\xf5\x3c\x50\x49\x01\x60\x6f\x7b\xd7\x9f\x61\x05\xb1\xf7\xc2\xd5\xc0\x2d\x45\x08\xe8\x03\x46\xb4\x9c\x1d\x19\xa9\x05\x70\x35\x28\x0b\x6e\x2b\xb5\xb8\x84\x0d\x06\x51\x02\xb1\x75\xc9\x54\xa0\x9d\xdd\x61\x60\x08\xa8\x5d\x63\xe9\xaf\xa3\xe6\x63\x75\x1b\xc5\x18\x79\xa2\x91\x2c\x63\xb0\xca\x08\xb0\x09\x9f\x81\xb2\x15\x74\x6a\x0f\x01\xc5\x06\x24\x3b\xd2\x96\x59\xe2\x12\xde\xba\x80\x40\xb6\x76\x25\xb4\xcc\x3e\x96\xab\x55\x43\x7c\x68\x00\xed\xba\x2e\x59\xe2\xfd\x4a\x8f\x1a\x6c\x55\xe1\x0e\xcd\x2a\x52\xb3\x50\x41\xb7\xc4\xa8\x39\x05\x5c\x29\x4f\x8b\xec\xb8\x95\x60\xe3\xb2\xab\x9e\x1c\xd3\xff\x74\xe4\x29\xef\xa5\x52\x22\x07\xb2\xcd\xf1\x38\x17\xf0\x45\xdc\xa5\x7a\x25\xd1\x6a\x10\xeb\x43\x3c\xc1\x2b\x47\x92\x88\xf7\xbf\x6e\x3e\xc0\xc1\x68\x4e\xc1\x48\x25\x0c\x68\x9f\xc4\xe2\x09\x78\x01\x8a\x6c\x8d\x52\x3d\x14\xa1\x0e\xae\xcb\x38\xa3\xad\xbc\x23\xcb\xf9\x45\x1b\x42\x3b\x05\x3d\xa6\x6d\x47\x2c\x99\xfe\x94\x30\xb2\xe4\x67\x09\x6b\x65\xad\x63\xd8\x22\x24\x5f\x29\xc6\x6a\x09\xaf\x2c\xac\x55\x87\x66\xad\x22\xfe\xef\xb0\x0b\xc2\x71\x21\x90\x5e\x07\x7e\x3c\xbd\x0e\xbf\x9e\xb1\x47\xeb\x78\x7c\x98\x4f\xb3\x19\xfa\xb2\x2f\x37\x1e\xf5\xa4\x49\x2a\x8c\x14\xa4\x90\x59\x31\x4a\xf9\x7f\x29\x33\xd2\x3e\xd7\xa1\xf2\x0c\xb3\x50\xe6\xcc\x94\x00\xd0\x91\x7d\x83\xb6\xe1\xb6\x84\x9b\x33\xd2\x6c\xe4\x67\xea\xf2\xd8\xfa\x16\x3a\xeb\x64\x8c\x8c\xe6\x77\x3b\x0c\x81\xaa\x6f\xe2\x67\x63\xdc\x56\x99\x73\x4d\x93\x14\xfc\x96\x59\x3e\x4a\x63\xc4\x09\xf0\xbd\x6c\xdf\x32\xf1\x4c\xc3\x25\x98\xe5\xa1\x4e\x35\xc7\x20\x66\xe8\x00\xaa\xaa\xf2\x0a\x54\xe6\xf6\x1e\x3d\xf7\x06\x76\x4f\xc1\x1d\x9e\xec\xc7\x6d\x32\xe6\xd6\x19\xd2\xfb\x39\x03\x13\x20\x4e\xac\xc3\xf9\x16\x65\x72\xf8\x2c\x9d\x17\x04\xd5\xab\x3f\x5b\xb4\x32\x4b\x7d\x32\x06\xd4\x8c\x4a\x90\x21\xcc\x8a\x2c\x86\xde\x83\xe2\x81\x51\x1d\xdd\xde\xa0\x0e\xc8\xe5\x43\xe5\xad\xab\x70\x83\x06\x35\xbb\xf0\xfd\xc0\xf7\xc1\x7d\xde\xaf\x9d\xad\xa9\xf9\x7e\x4e\x1c\xd7\xc8\xd5\xdc\x1f\xae\x67\xef\xf1\x53\xa2\x90\xef\x4a\x71\x54\x05\x79\x88\xbb\xce\x27\xc6\xd3\x9a\x08\x23\xde\xe5\x8c\x81\xfb\x7a\x44\x1e\x43\xb2\x01\xe6\x69\x57\x02\x93\xff\x61\x73\xfc\x47\x05\xf7\x92\xd9\x19\x0c\x79\xc2\xce\xaa\x27\xc6\xee\x82\xdd\x09\xaa\x1f\x5a\x04\xef\xaa\x7e\x35\x7e\x38\xea\xcc\x1b\x99\x59\xe9\x16\x2b\xe9\xa5\xc1\x9a\x74\x9b\xdd\x83\xf4\xce\x9c\xc3\xf2\x70\xab\x18\x3a\xc5\xba\x1d\x92\xc2\x81\xbc\x41\x78\x7e\x87\xfb\x67\x79\x50\x3d\xc3\xba\x46\xcd\x2f\x20\xc5\xc3\x72\xcf\xfc\xf2\x22\xe9\x50\xec\x42\xf1\x85\xde\xfc\x7f\x7e\xa0\xbf\x98\xcb\xe6\xf5\x7c\x02\xf4\xb6\x2f\x51\xaf\xd6\xb2\xfc\xef\x70\xff\x28\xf9\x43\x0c\x8f\x52\x72\x4a\xff\x06\xb5\xb3\xd5\xc5\x80\x41\xc6\x62\xa7\xb8\x04\xb2\xfc\xd3\x8f\x17\xb9\x7a\x9b\x72\xd1\x9c\x6e\xeb\xf1\x2f\xa7\xef\x11\x6e\x7f\x55\xbd\xab\x10\xd4\xbe\xf8\x4a\xc1\x36\x6d\x5f\xa7\xad\x20\x50\x53\x33\x3f\x8d\x1f\xbe\x93\x87\xa9\x31\xb9\xb4\x2e\xc6\xf7\x89\x4b\xe7\xf9\x9e\x31\x21\x9e\x5f\x18\x26\xc4\x7e\x7b\x4f\x8e\x66\xe2\x29\xae\xc0\x30\x7c\x78\x15\x17\x1a\x7c\xe6\xfa\x96\x05\x26\xf7\x08\xb7\x8d\xf2\x95\xf2\xb5\x37\xb8\x33\x3f\x86\x6f\xa4\x12\x76\x37\xa7\xb7\x5c\x90\x8b\xe1\xab\x39\x13\x00\x7a\x23\x25\x70\x48\x38\x7c\x34\xba\xa0\x1a\x2c\x81\x43\xc2\xe2\xdf\x01\x00\x32\x98\x50\x94\xf7\x0f\x00\x00")
 
 func crdsKube111AgentOpenClusterManagementIo_applicationmanagers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -378,7 +378,7 @@ func crdsKube111AgentOpenClusterManagementIo_applicationmanagers_crdYaml() (*ass
 	return a, nil
 }
 
-var _crdsKube111AgentOpenClusterManagementIo_certpolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\xaf\xdb\x36\x0c\xbe\xe7\x57\x10\xe8\xa1\x97\x25\xc1\xc3\x2e\x83\x6f\x45\x36\x0c\x0f\x6d\xd7\x87\x66\x78\x77\x59\x66\x6c\xae\xb2\xa4\x49\x54\xb6\x6c\xd8\x7f\x1f\x28\x39\x8e\x1d\xd8\xef\xad\x40\xa7\x53\x42\x51\xd4\xc7\x8f\x1f\x69\xbd\x81\x83\xf3\x97\x40\x6d\xc7\x70\x70\x96\x03\xd5\x89\x5d\x88\xc0\x0e\xb8\x43\xf8\xe4\xd1\xc2\xc1\xa4\xc8\x18\xe0\xa3\xb2\xaa\xc5\x1e\x2d\x83\x0f\xee\x37\xd4\xbc\xd9\x28\x4f\xcf\x18\x22\x39\x5b\x81\xf2\x84\x7f\x32\x5a\xf9\x17\x77\x5f\x7e\x88\x3b\x72\xfb\xf3\x43\x8d\xac\x1e\x36\x5f\xc8\x36\x15\x1c\x52\x64\xd7\x7f\xc6\xe8\x52\xd0\xf8\x23\x9e\xc8\x12\x93\xb3\x9b\x1e\x59\x35\x8a\x55\xb5\x01\xb0\xaa\xc7\x0a\x34\x06\xf6\xce\x90\xbe\x68\x01\xe6\x8c\xc1\x10\x77\xaa\x45\xcb\x3b\xe7\xd1\x6e\x75\x81\xb5\xed\x47\x58\x3b\x72\x9b\xe8\x51\x4b\x90\x36\xb8\xe4\x2b\x78\xd5\xbf\x5c\x17\xe5\x08\xc0\x00\x12\x03\x3f\xe5\x9b\x0f\xe3\xcd\x79\xdb\x50\xe4\xf7\xab\x2e\x1f\x28\x72\x76\xf3\x26\x05\x65\x56\x32\xc8\x1e\x91\x6c\x9b\x8c\x0a\xcb\x3e\x1b\x80\xa8\x9d\xc7\x0a\x7e\x11\x68\x5e\x69\x6c\xc4\x96\xea\x30\x10\x37\xc0\x8d\xac\x38\xc5\x0a\xfe\xfe\x67\x03\x70\x56\x86\x1a\x25\x64\x96\x4d\xc9\xf9\xdd\xd3\xe3\xf3\xf7\x47\xdd\x61\xaf\x8a\x11\xa0\xc1\xa8\x03\xf9\xec\xb7\x98\x06\x50\xcc\xa5\x2f\xc7\xe0\xe4\x42\xfe\xbb\x04\x54\xcf\xe9\x91\xf5\xee\xe9\x71\xf8\xed\x83\xf3\x18\x98\xae\x60\x65\x4d\xd4\x32\xda\xee\x20\xbd\x15\xcc\xc5\x07\x1a\xd1\x07\x16\x38\xe7\x62\xc3\x06\x62\x01\xe6\x4e\xc0\x1d\x45\x08\xe8\x03\x46\xb4\x9c\x73\x9f\x84\x05\x71\x51\x16\x5c\x2d\x52\xdd\xc1\x11\x83\x04\x81\xd8\xb9\x64\x1a\xd0\xce\x9e\x31\x30\x04\xd4\xae\xb5\xf4\xd7\x18\x79\x14\xbf\x51\x8c\x43\x49\xaf\x8b\x2c\x63\xb0\xca\x08\xdb\x09\xbf\x03\x65\x1b\xe8\xd5\x05\x02\xca\x1d\x90\xec\x24\x5a\x76\x89\x3b\xf8\xe8\x02\x02\xd9\x93\xab\xa0\x63\xf6\xb1\xda\xef\x5b\xe2\x6b\x7f\x68\xd7\xf7\xc9\x12\x5f\xf6\x7a\xd2\x7f\xfb\x06\xcf\x68\xf6\x91\xda\xad\x0a\xba\x23\x46\xcd\x29\xe0\x5e\x79\xda\x66\xe0\x96\x73\x93\xf5\xcd\x9b\x51\x13\x6f\x27\x48\xf9\x22\xf2\x89\x1c\xc8\xb6\xa3\x39\xab\x7b\x95\x77\x11\xb6\xd4\x5e\x0d\xc7\x0a\xfe\x1b\xbd\x62\x12\x56\x3e\xff\x74\xfc\x15\xae\x97\xe6\x12\xcc\x39\xcf\x6c\xdf\x8e\xc5\x1b\xf1\x42\x14\xd9\x13\x86\x52\xb8\x53\x70\x7d\x8e\x88\xb6\xf1\x8e\x2c\x17\x9d\x19\x42\x3b\x27\x3d\xa6\xba\x27\x96\x4a\xff\x9e\x30\xb2\xd4\x67\x07\x07\x65\xad\x63\xa8\x11\x92\x6f\x14\x63\xb3\x83\x47\x0b\x07\xd5\xa3\x39\xa8\x88\xff\x3b\xed\xc2\x70\xdc\x0a\xa5\xaf\x13\x3f\x1d\x6e\x73\xc7\xc2\xd6\x68\xbe\x0e\xaf\xc5\x0a\x2d\x35\xeb\xd1\xa3\x9e\xb5\x49\x83\x91\x82\x48\x99\x15\xa3\x34\xc0\xea\x30\x5b\xef\x52\x59\xc3\xb0\x94\x01\x34\xdf\x00\xe8\xc9\x7e\x40\xdb\x72\x57\xc1\xc3\xdd\xd6\x62\xf6\x77\xe1\xf2\x3c\xfb\x16\x31\x4f\xc9\x18\x99\xdd\x9f\xce\x18\x02\x35\xdf\x24\x66\x6b\x5c\xad\xcc\x7d\xa4\x59\x19\x7e\xce\x2e\xcf\xb9\xb9\x67\xd4\x97\xb3\x43\xdb\xdf\x45\x58\xa3\x59\x16\xf5\xaa\x1d\x93\x58\xd8\x07\x50\x4d\x93\xbf\x92\xca\x3c\xbd\x10\xe7\xc5\xc4\xa6\xdb\x77\xa2\x9b\xe1\x78\x4a\xc6\x14\xb9\x2c\x5d\x30\x23\xe2\xe6\x3a\xd8\x6b\x94\xe9\x51\x3e\x12\xf9\xbb\x41\xa7\xfd\x1f\x1d\x5a\x99\xa7\x3e\x19\x03\x6a\x11\xb3\x34\xa0\x22\x2b\x5f\x1e\x41\xb0\x0a\x7b\x25\xab\x11\xf6\x11\x75\x40\x5e\x82\xfd\xc2\xf9\x55\x46\xba\x54\xbf\x4f\x35\x6a\x67\x4f\xd4\x2e\x87\xfe\x7a\x81\xc9\x04\x93\xf6\x9c\x46\xda\x4e\x9b\x63\xcd\x9e\x9b\x66\xb6\x79\xaf\xfe\xd9\x66\x91\xe2\xab\xd3\xa6\x3c\x1f\xbe\x6a\xde\xe4\x23\x33\xd9\xbb\x3a\xca\x7c\xff\xef\x23\xe7\x0e\xcb\xf9\xfa\x7c\x3c\x3f\xdc\xfe\x65\x54\xdb\xe1\x25\x78\x2e\xec\x96\x6b\x2a\xe0\x90\x70\x78\xfe\xb8\xa0\x5a\x1c\x2c\xff\x06\x00\x00\xff\xff\xce\xec\x2c\x91\xcb\x0a\x00\x00")
+var _crdsKube111AgentOpenClusterManagementIo_certpolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x8f\xdb\x36\x13\xbe\xeb\x57\x0c\x90\x43\x2e\xb1\x8d\xc5\xfb\xa2\x28\x84\x20\x40\xe0\x16\x45\x90\xa4\x59\xc4\x41\xee\x34\x35\x92\xd8\xa5\x48\x66\x38\x74\xe3\x16\xfd\xef\xc5\x50\xb2\x2d\xb9\xf2\x7a\xb7\x9b\x22\x4b\x1f\x56\x9c\xe1\x7c\x3c\xf3\x45\x3e\x83\xb5\x0f\x7b\x32\x4d\xcb\xb0\xf6\x8e\xc9\x6c\x13\x7b\x8a\xc0\x1e\xb8\x45\xf8\x10\xd0\xc1\xda\xa6\xc8\x48\xf0\x5e\x39\xd5\x60\x87\x8e\x21\x90\xff\x0d\x35\x17\x85\x0a\xe6\x33\x52\x34\xde\x95\xa0\x82\xc1\xaf\x8c\x4e\xbe\xe2\xf2\xee\xc7\xb8\x34\x7e\xb5\xbb\xd9\x22\xab\x9b\xe2\xce\xb8\xaa\x84\x75\x8a\xec\xbb\x8f\x18\x7d\x22\x8d\x3f\x61\x6d\x9c\x61\xe3\x5d\xd1\x21\xab\x4a\xb1\x2a\x0b\x00\xa7\x3a\x2c\x41\x23\x71\xf0\xd6\xe8\xbd\x16\xc3\xbc\xb5\x48\x71\xa9\x1a\x74\xbc\xf4\x01\xdd\x42\xf7\x66\x2d\xba\xa3\x59\x4b\xe3\x8b\x18\x50\x8b\x90\x86\x7c\x0a\x25\x5c\xe5\xef\xd5\x45\x39\x02\x30\x18\x89\xc4\xb7\x59\xf3\xfa\xa8\x39\x93\xad\x89\xfc\xf6\x22\xcb\x3b\x13\x39\xb3\x05\x9b\x48\xd9\x0b\x1e\x64\x8e\x68\x5c\x93\xac\xa2\x79\x9e\x02\x20\x6a\x1f\xb0\x84\x5f\x55\x87\x31\x28\x8d\x95\xec\xa5\x2d\x0d\xc0\x0d\xe6\x46\x56\x9c\x62\x09\x7f\xfe\x55\x00\xec\x94\x35\x95\x12\x30\x7b\xa2\xf8\xfc\xfa\xf6\xcd\xe7\xff\x6d\x74\x8b\x5d\x06\x56\xb6\x2b\x8c\x9a\x4c\xc8\x7c\xb3\x6e\x80\x89\x39\xf4\xfd\x31\xa8\x3d\xe5\xcf\x39\x43\x27\x26\x8b\x70\x80\xd7\xb7\x6f\x86\xff\x03\xf9\x80\xc4\xe6\x80\xad\xac\x51\xb6\x1c\xf7\xce\x4c\x7a\x2e\x36\xf7\x3c\x50\x49\x7e\x60\x6f\xce\xae\xdf\xc3\x0a\x62\x6f\x98\xaf\x81\x5b\x13\x81\x30\x10\x46\x74\x9c\x7d\x1f\x89\x05\xf0\x35\x28\x07\x7e\x2b\xa9\xba\x84\x0d\x92\x08\x81\xd8\xfa\x64\x2b\xd0\xde\xed\x90\x18\x08\xb5\x6f\x9c\xf9\xe3\x28\xf9\x98\xfc\x56\x31\x46\x9e\x48\x34\x8e\x91\x9c\xb2\x82\x76\xc2\x17\xa0\x5c\x05\x9d\xda\x03\xa1\xe8\x80\xe4\x46\xd2\x32\x4b\x5c\xc2\x7b\x4f\x08\xc6\xd5\xbe\x84\x96\x39\xc4\x72\xb5\x6a\x0c\x1f\xea\x43\xfb\xae\x4b\xce\xf0\x7e\xa5\x47\xf5\xb7\xaa\x70\x87\x76\x15\x4d\xb3\x50\xa4\x5b\xc3\xa8\x39\x11\xae\x54\x30\x8b\x6c\xb8\x13\x67\xe3\xb2\xab\x9e\x1d\x73\xe2\xf9\xc8\x52\xde\x4b\xfa\x44\x26\xe3\x9a\xe3\x76\xce\xee\x8b\xb8\x4b\x62\x4b\xec\xd5\x70\xac\x77\xf1\x04\xaf\x6c\x49\x20\x3e\xfe\xbc\xf9\x04\x07\xa5\x39\x04\x23\x91\x30\xa0\x7d\x3a\x16\x4f\xc0\x0b\x50\xc6\xd5\x28\x09\x65\x22\xd4\xe4\xbb\x8c\x33\xba\x2a\x78\xe3\x38\x7f\x68\x6b\xd0\x4d\x41\x8f\x69\xdb\x19\x96\x48\x7f\x49\x18\x59\xe2\xb3\x84\xb5\x72\xce\x33\x6c\x11\x52\xa8\x14\x63\xb5\x84\x37\x0e\xd6\xaa\x43\xbb\x56\x11\xff\x73\xd8\x05\xe1\xb8\x10\x48\xaf\x03\x3f\x6e\x6e\x87\xbf\x9e\xb1\x47\xeb\xb8\x7d\x68\x5e\xb3\x11\x9a\x2b\xd6\x4d\x40\x3d\x29\x93\x0a\xa3\x21\x49\x65\x56\x8c\x52\x00\x73\xa7\x46\x1a\xe6\xaa\x54\xd6\xd0\x2c\xa5\x01\x4d\x09\x00\x9d\x71\xef\xd0\x35\xdc\x96\x70\x73\x46\x9a\xf5\xfe\x4c\x5c\xee\x67\xdf\x42\x66\x9d\xac\x95\xde\xfd\x61\x87\x44\xa6\xfa\x26\x76\x36\xd6\x6f\x95\x3d\x97\x34\x09\xc3\x2f\x99\xe5\xb3\x14\x47\x9c\x40\xdf\x9f\xed\xcb\x26\x9e\x49\xb8\x04\xb3\x2c\xd3\xa9\xe6\xe8\xc4\x0c\x1d\x40\x55\x55\x9e\x92\xca\xde\xde\x23\xe7\x5e\xc7\xee\x49\xba\xc3\xca\x76\xdc\x26\x6b\xfb\x74\x99\x53\x30\x01\xe2\xc4\x3a\xec\x6f\x51\xba\x47\x3f\x24\xf2\xdc\x30\xf5\xea\xf7\x16\x9d\xf4\xd3\x90\xac\x05\x35\x23\x12\xa4\x11\xb3\x32\x0e\xa9\xb7\xa0\x78\xa4\x57\x47\xb3\x37\xa8\x09\xb9\x7c\xec\x79\xe7\x2b\xdc\xa0\x45\xcd\x9e\xbe\x1f\xf8\x81\xfc\x57\x19\xc2\xb5\x69\xbe\x9f\x11\xc7\x51\x72\x35\xf6\x87\x1b\xdc\x47\xfc\x92\x0c\xe5\xcb\x54\x1c\x65\x41\x6e\xe4\xbe\x0b\x89\xf1\x34\x2a\x68\xc4\xbb\x9c\x51\x70\x5f\x8d\xc8\xb2\x46\xa6\xc0\x3c\xed\x8a\x63\xf2\x3b\x4c\x8f\x7f\x29\xe0\x5e\x32\x7b\x8b\x94\xef\x1f\xb3\xe2\x0d\x63\x77\x41\xef\x04\xd5\x4f\x2d\x42\xf0\x55\x3f\x1e\x3f\x1d\x65\xe6\xa9\xcc\xac\x74\x8b\x95\xd4\xd2\xa0\x4d\xaa\xcd\xed\x41\x6a\x67\xce\x60\x59\xdc\x2a\x86\x4e\xb1\x6e\x87\xa0\x30\x99\x60\x11\x5e\xde\xe1\xfe\x45\x6e\x54\x2f\xb0\xae\x51\xf3\x2b\x48\xf1\x30\xe0\x33\xbf\x7c\x48\x38\x14\x7b\x2a\xfe\x21\x37\xff\x5e\x1e\xe8\xaf\xe6\xa2\x79\x3d\x9e\x00\xbd\xee\x4b\xd4\xab\xb9\x2c\xbf\x3b\xdc\x3f\xe9\xfc\xc1\x87\x27\x09\x39\x85\x7f\x83\xda\xbb\xea\xa2\xc3\x20\x6d\xb1\x53\x5c\x82\x71\xfc\xc3\xff\x2f\x72\xf5\x3a\xe5\xb2\xd9\x4c\xa6\xf5\x78\xe5\xf0\x3d\xc1\xec\x07\xe5\xbb\x22\x52\xfb\xe2\x81\x07\xdb\xb4\x7d\x9b\xb6\x82\x40\x6d\x9a\xf9\x6e\xfc\xf8\x99\x3c\x74\x8d\xc9\xc5\x75\x31\xbe\x4f\x5c\xda\xcf\xf7\x8c\x09\xf1\xfc\xc2\x30\x21\xf6\xd3\xbb\xb8\xe2\xe6\xf0\xe2\x2a\x2e\x14\xf0\xec\x15\x2d\x1f\x99\xdc\x14\xfc\x36\xca\x5b\xe4\xe1\xb7\xb4\x33\x5b\x86\xb7\x50\x09\xbb\x9b\xd3\x57\x4e\xba\xc5\xf0\x78\xce\x04\x80\x5e\x4d\x09\x4c\x09\x87\x17\xa3\x27\xd5\x60\x09\x4c\x09\x8b\xbf\x07\x00\x6d\x40\x62\x84\xfe\x0f\x00\x00")
 
 func crdsKube111AgentOpenClusterManagementIo_certpolicycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -398,7 +398,7 @@ func crdsKube111AgentOpenClusterManagementIo_certpolicycontrollers_crdYaml() (*a
 	return a, nil
 }
 
-var _crdsKube111AgentOpenClusterManagementIo_iampolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xe3\x36\x0c\xbd\xe7\x57\x10\xd8\xc3\x5e\x9a\x04\x83\x5e\x0a\xdf\x06\x69\x51\x0c\x76\xa7\x3b\xd8\x29\xe6\x4e\xcb\x8c\xcd\xae\x2c\xa9\x12\x95\x36\x2d\xfa\xdf\x0b\xca\x8e\x13\x07\xce\x4c\x17\xd8\xfa\x66\x8a\x7a\x7c\x7a\xfc\x90\xde\xc1\xce\x87\x63\xe4\xb6\x13\xd8\x79\x27\x91\xeb\x2c\x3e\x26\x10\x0f\xd2\x11\x7c\x0a\xe4\x60\x67\x73\x12\x8a\xf0\x88\x0e\x5b\xea\xc9\x09\x84\xe8\x7f\x23\x23\xab\x15\x06\x7e\xa1\x98\xd8\xbb\x0a\x30\x30\xfd\x29\xe4\xf4\x2f\x6d\xbe\xfc\x90\x36\xec\xb7\x87\xbb\x9a\x04\xef\x56\x5f\xd8\x35\x15\xec\x72\x12\xdf\x7f\xa6\xe4\x73\x34\xf4\x23\xed\xd9\xb1\xb0\x77\xab\x9e\x04\x1b\x14\xac\x56\x00\x0e\x7b\xaa\x80\xb1\x0f\xde\xb2\x39\x1a\xe5\xe5\xad\xa5\x98\x36\xd8\x92\x93\x8d\x0f\xe4\xd6\x66\x60\xb5\xee\x27\x56\x1b\xf6\xab\x14\xc8\x28\x46\x1b\x7d\x0e\x15\xbc\xe9\x3f\x44\x4b\xba\x05\x60\xe0\xf8\x70\xff\xf8\x54\x02\xef\xa6\xc0\x65\xd5\x72\x92\x0f\xb7\x3c\x3e\x72\x92\xe2\x15\x6c\x8e\x68\x97\xe9\x17\x87\xc4\xae\xcd\x16\xe3\xa2\xcb\x0a\x20\x19\x1f\xa8\x82\x5f\x94\x56\x40\x43\x8d\xda\x72\x1d\x47\xcd\x46\xaa\x49\x50\x72\xaa\xe0\xef\x7f\x56\x00\x07\xb4\xdc\xa0\xea\x38\x2c\xea\x79\xef\x9f\x1e\x5e\xbe\x7f\x36\x1d\xf5\x38\x18\x01\x1a\x4a\x26\x72\x28\x7e\x4b\x67\x00\x4e\x25\xe9\xc3\x2e\xd8\xfb\x58\x7e\x17\x68\xc2\xfd\xd3\xc3\x08\x1a\xa2\x0f\x14\x85\x4f\xc4\xf4\xbb\x28\x8a\xc9\x76\x15\xfe\xbd\xf2\x1b\x7c\xa0\xd1\x32\xa0\x21\xf6\x61\xb0\x51\x03\x69\x60\xe1\xf7\x20\x1d\x27\x88\x14\x22\x25\x72\x52\xce\x79\x01\x0b\xea\x82\x0e\x7c\xad\x15\xb9\x81\x67\x8a\x0a\x02\xa9\xf3\xd9\x36\x60\xbc\x3b\x50\x14\x88\x64\x7c\xeb\xf8\xaf\x09\x79\xaa\x71\x8b\x42\x63\xf2\x4e\x1f\x3b\xa1\xe8\xd0\xaa\xb2\x99\xbe\x03\x74\x0d\xf4\x78\x84\x48\x1a\x03\xb2\xbb\x40\x2b\x2e\x69\x03\x8f\x3e\x12\xb0\xdb\xfb\x0a\x3a\x91\x90\xaa\xed\xb6\x65\x39\xb5\x81\xf1\x7d\x9f\x1d\xcb\x71\x6b\x2e\xda\x6c\xdb\xd0\x81\xec\x36\x71\xbb\xc6\x68\x3a\x16\x32\x92\x23\x6d\x31\xf0\xba\x10\x77\x52\x7a\xa9\x6f\xde\x4d\xf9\x7f\x7f\xc1\x54\x8e\x5a\x2a\x49\x22\xbb\x76\x32\x97\x2a\xbe\xa9\xbb\x56\xb0\x26\x1a\xc7\x6d\x03\xff\xb3\xbc\x6a\x52\x55\x3e\xff\xf4\xfc\x2b\x9c\x82\x96\x14\xcc\x35\x2f\x6a\x9f\xb7\xa5\xb3\xf0\x2a\x14\xbb\x3d\xc5\x21\x71\xfb\xe8\xfb\x82\x48\xae\x09\x9e\x9d\x94\x1f\x63\x99\xdc\x5c\xf4\x94\xeb\x9e\x45\x33\xfd\x7b\xa6\x24\x9a\x9f\x0d\xec\xd0\x39\x2f\x50\x13\xe4\xd0\xa0\x50\xb3\x81\x07\x07\x3b\xec\xc9\xee\x30\xd1\xff\x2e\xbb\x2a\x9c\xd6\x2a\xe9\xdb\xc2\x5f\xce\xb0\xb9\xe3\xa0\xd6\x64\x3e\x0d\xa9\xc5\x0c\x2d\x34\xe6\x73\x20\x33\xeb\x92\x86\x12\x47\xad\x64\x41\x21\xad\xff\x5b\x33\xeb\x76\x8f\xea\x37\x8e\x44\x1d\x35\xf3\x05\x80\x9e\xdd\x47\x72\xad\x74\x15\xdc\x5d\x2d\x2d\x9e\xfd\x0a\xae\x4c\xae\x6f\x81\xb9\xcf\xd6\xea\x84\xfe\x74\xa0\x18\xb9\xf9\x26\x98\xad\xf5\x35\xda\x6b\xa4\x59\x12\x7e\x2e\x2e\x2f\xa5\xb5\x67\xca\x0f\x7b\xc7\xa6\xbf\x42\xb8\x25\xb3\x7e\xdc\x63\x3b\x1d\x62\x61\x1d\x00\x9b\xa6\x5c\x85\x68\x9f\x5e\xc1\x79\xf5\x60\x97\xcb\x57\x25\x37\xe3\xf1\x94\xad\x1d\xca\x65\x29\xc0\x4c\x88\xb3\xeb\x68\xaf\x49\x67\xc7\x70\x21\x94\x2b\x82\xf7\xdb\x3f\x3a\x72\x3a\x4d\x43\xb6\x16\x70\x91\xb3\xb6\x1f\xb2\xd3\x4b\x46\x19\xdc\xa4\x7d\xe3\x54\x13\xed\x67\x32\x91\x64\x89\xf6\x2b\xfb\x6f\x2a\xd2\xe5\xfa\x43\xae\xc9\x78\xb7\xe7\x76\x19\xfa\xeb\x0b\x4c\xe7\x97\x76\xe7\x25\xd2\xfa\xb2\x39\x6e\xd9\x4b\xd3\xcc\x16\xaf\xab\xff\xcd\xc1\x32\xbc\x0a\xbe\x66\xb4\x94\x1d\xb3\x12\xf7\x75\xd2\x49\xfe\x9f\xa7\xcb\x15\x93\xc3\xe9\x39\x78\xb8\x3b\xff\x15\x4e\xeb\xf1\x65\x77\x18\x84\x1c\xa2\x54\x20\x31\xd3\xf8\xa6\xf1\x11\x5b\x1a\x2d\xff\x06\x00\x00\xff\xff\x78\x39\x5c\xe0\x9b\x0a\x00\x00")
+var _crdsKube111AgentOpenClusterManagementIo_iampolicycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xcd\x8e\xdb\x36\x10\xbe\xeb\x29\x06\xc8\x21\x97\xd8\xc6\xa2\x45\x51\x08\x41\x80\xc0\x2d\x8a\x45\x92\x66\x11\x07\xb9\xd3\xd4\x48\x9a\x2e\x45\x32\xe4\xd0\x8d\x5b\xf4\xdd\x8b\xa1\x64\x5b\x32\xe4\x75\xb6\x9b\x22\xa6\x2f\x22\x87\xf3\xf3\xcd\x2f\x9f\xc1\xda\xf9\x7d\xa0\xa6\x65\x58\x3b\xcb\x81\xb6\x89\x5d\x88\xc0\x0e\xb8\x45\x78\xef\xd1\xc2\xda\xa4\xc8\x18\xe0\x9d\xb2\xaa\xc1\x0e\x2d\x83\x0f\xee\x0f\xd4\x5c\x14\xca\xd3\x27\x0c\x91\x9c\x2d\x41\x79\xc2\x2f\x8c\x56\xbe\xe2\xf2\xfe\xe7\xb8\x24\xb7\xda\xdd\x6c\x91\xd5\x4d\x71\x4f\xb6\x2a\x61\x9d\x22\xbb\xee\x03\x46\x97\x82\xc6\x5f\xb0\x26\x4b\x4c\xce\x16\x1d\xb2\xaa\x14\xab\xb2\x00\xb0\xaa\xc3\x12\x48\x75\xde\x19\xd2\x7b\x2d\x7a\x39\x63\x30\xc4\xa5\x6a\xd0\xf2\xd2\x79\xb4\x0b\xdd\x6b\xb5\xe8\x8e\x5a\x2d\xc9\x15\xd1\xa3\x16\x1e\x4d\x70\xc9\x97\x70\x95\xbe\x97\x16\xe5\x0a\x40\xaf\xe3\xed\xeb\x77\x77\x59\xf0\xfa\x28\x38\x9f\x1a\x8a\xfc\xe6\x12\xc5\x5b\x8a\x9c\xa9\xbc\x49\x41\x99\x79\xf5\x33\x41\x24\xdb\x24\xa3\xc2\x2c\x49\x01\x10\xb5\xf3\x58\xc2\xef\xaa\xc3\xe8\x95\xc6\x4a\xf6\xd2\x36\x0c\x98\x0d\xaa\x46\x56\x9c\x62\x09\x7f\xff\x53\x00\xec\x94\xa1\x4a\x09\x8e\xfd\xa1\xd8\xfb\xfa\xee\xf6\xd3\x0f\x1b\xdd\x62\x97\x31\x95\xed\x0a\xa3\x0e\xe4\x33\xdd\x9c\x0d\x40\x31\x3b\xbd\xbf\x05\xb5\x0b\xf9\x73\x46\x4d\x78\x7d\x77\x3b\x30\xf5\xc1\x79\x0c\x4c\x07\x0c\x65\x8d\x82\xe2\xb8\x77\x26\xfe\xb9\xe8\xd7\xd3\x40\x25\x61\x80\xbd\xec\x5d\xbf\x87\x15\xc4\x5e\x0b\x57\x03\xb7\x14\x21\xa0\x0f\x18\xd1\x72\xb6\x73\xc4\x16\xc0\xd5\xa0\x2c\xb8\xad\x44\xe4\x12\x36\x18\x84\x09\xc4\xd6\x25\x53\x81\x76\x76\x87\x81\x21\xa0\x76\x8d\xa5\xbf\x8e\x9c\x8f\x31\x6e\x14\x63\xe4\x09\x47\xb2\x8c\xc1\x2a\x23\xc8\x26\x7c\x01\xca\x56\xd0\xa9\x3d\x04\x14\x19\x90\xec\x88\x5b\x26\x89\x4b\x78\xe7\x02\x02\xd9\xda\x95\xd0\x32\xfb\x58\xae\x56\x0d\xf1\x21\x0d\xb4\xeb\xba\x64\x89\xf7\x2b\x3d\x4a\xb3\x55\x85\x3b\x34\xab\x48\xcd\x42\x05\xdd\x12\xa3\xe6\x14\x70\xa5\x3c\x2d\xb2\xe2\x56\x8c\x8d\xcb\xae\x7a\x76\xf4\xff\xf3\x91\xa6\xbc\x97\x50\x89\x1c\xc8\x36\xc7\xed\x1c\xc5\x17\x71\x97\x08\x16\x47\xab\xe1\x5a\x6f\xe2\x09\x5e\xd9\x12\x47\x7c\xf8\x75\xf3\x11\x0e\x42\xb3\x0b\x46\x2c\x61\x40\xfb\x74\x2d\x9e\x80\x17\xa0\xc8\xd6\x28\xd1\x43\x11\xea\xe0\xba\x8c\x33\xda\xca\x3b\xb2\x9c\x3f\xb4\x21\xb4\x53\xd0\x63\xda\x76\xc4\xe2\xe9\xcf\x09\x23\x8b\x7f\x96\xb0\x56\xd6\x3a\x86\x2d\x42\xf2\x95\x62\xac\x96\x70\x6b\x61\xad\x3a\x34\x6b\x15\xf1\x7f\x87\x5d\x10\x8e\x0b\x81\xf4\x3a\xf0\xe3\x1a\x76\xf8\xf5\x84\x3d\x5a\xc7\xed\x43\x91\x9a\xf5\xd0\x4c\x62\x6e\x3c\xea\x49\x96\x54\x18\x29\x48\x24\xb3\x62\x94\xf8\x9f\xb9\x34\xe2\x3f\x97\xa3\xb2\x86\x92\x28\xa5\x66\x7a\x00\xd0\x91\x7d\x8b\xb6\xe1\xb6\x84\x9b\xb3\xa3\x59\xdb\xcf\xd8\xe5\xca\xf5\x2d\x78\xd6\xc9\x18\xa9\xd0\xef\x77\x18\x02\x55\xdf\x44\xcf\xc6\xb8\xad\x32\xe7\x9c\x26\x4e\xf8\x2d\x93\x7c\x92\xd4\x88\x13\xe4\xfb\xbb\x7d\xd2\xc4\x33\x0e\x97\x60\x96\x45\x9d\x6a\x8e\x46\xcc\x9c\x03\xa8\xaa\xca\xad\x50\x99\xbb\x07\xf8\x3c\x68\xd8\x03\x21\x77\x58\x59\x8f\xbb\x64\x4c\x5f\xfc\xe7\x04\x4c\x80\x38\x91\x0e\xfb\x5b\x94\xda\xd1\x77\xe6\xdc\x22\xa8\x5e\xfd\xd9\xa2\x95\x6a\xea\x93\x31\xa0\x66\x58\x82\x94\x61\x56\x64\xa5\xc9\x88\x06\xc5\x23\xad\x3a\xaa\xbd\x41\x1d\x90\xcb\xc7\xde\xb7\xae\xc2\x0d\x1a\xd4\xec\xc2\xf7\x03\xdf\x07\xf7\x45\x32\xb4\xa6\xe6\xfb\x29\x71\x6c\x24\x57\x7d\x7f\x18\xd3\x3e\xe0\xe7\x44\x21\x8f\x4c\x71\x14\x05\xb9\x8c\xbb\xce\x27\xc6\x53\xa3\x08\x23\xda\xe5\x8c\x80\x87\x72\x44\x96\x21\xe9\x01\xf3\x67\x57\x0c\x93\xff\xa1\x77\xfc\x47\x06\x0f\x1e\xb3\x33\x18\xf2\xf4\x31\xcb\x9e\x18\xbb\x0b\x72\x27\xa8\x7e\x6c\x11\xbc\xab\xfa\xe6\xf8\xf1\xc8\x33\xf7\x64\x66\xa5\x5b\xac\x24\x97\x06\x69\x92\x6d\x76\x0f\x92\x3b\x73\x0a\xcb\xe2\x56\x31\x74\x8a\x75\x3b\x38\x85\x03\x79\x83\xf0\xf2\x1e\xf7\x2f\x72\xa1\x7a\x81\x75\x8d\x9a\x5f\x41\x8a\x87\xf6\x9e\xe9\xe5\x43\xdc\xa1\xd8\x85\x0b\xcc\x5f\x1e\xce\x5f\xcd\x79\xf3\xba\x3f\x01\x7a\xd9\x97\x4e\xaf\xc6\xb2\xfc\xef\x71\xff\xa4\xfb\x07\x1b\x9e\xc4\xe4\xe4\xfe\x0d\x6a\x67\xab\x8b\x06\x83\x94\xc5\x4e\x71\x09\x64\xf9\xa7\x1f\x2f\x52\xf5\x32\x65\xd4\x6c\x26\xdd\x7a\xbc\xb2\xfb\x9e\xa0\xf6\x57\xc5\xbb\x0a\x41\xed\x8b\xaf\xbc\xd8\xa6\xed\x9b\xb4\x15\x04\x6a\x6a\xe6\xab\xf1\xe3\x7b\xf2\x50\x35\x26\x63\xeb\x62\x3c\x4f\x5c\xda\xcf\x73\xc6\xe4\xf0\x7c\x60\x28\xae\xd8\x34\x3c\xa4\x8a\x0b\xd9\x3a\x37\x8d\xe5\x1b\x93\xa9\xc0\x6d\xa3\xbc\x3a\xbe\x7a\x20\x3b\xd3\x64\x78\xf4\x94\xb0\xbb\x39\x7d\xe5\xf8\x5a\x0c\x8f\xe1\x7c\x00\xd0\x4b\x29\x81\x43\xc2\xe1\x19\xe8\x82\x6a\xb0\x04\x0e\x09\x8b\x7f\x07\x00\x61\x21\x15\xf2\xce\x0f\x00\x00")
 
 func crdsKube111AgentOpenClusterManagementIo_iampolicycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -418,7 +418,7 @@ func crdsKube111AgentOpenClusterManagementIo_iampolicycontrollers_crdYaml() (*as
 	return a, nil
 }
 
-var _crdsKube111AgentOpenClusterManagementIo_policycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xc1\x8e\x1b\x37\x0c\xbd\xfb\x2b\x08\xe4\x90\x4b\x6d\x63\xd1\x4b\x31\xb7\x85\x53\xb4\xdb\x24\xdd\x45\xb6\xd8\xbb\x46\xa2\xc7\x6c\x34\x92\x2a\x51\x6e\xdd\xa2\xff\x5e\x50\x1a\xcf\x7a\xec\xf1\x6e\x50\x24\x73\x1b\x92\x22\x9f\x1e\xa9\x27\xbd\x81\x8d\x0f\x87\x48\xdd\x8e\x61\xe3\x1d\x47\x6a\x33\xfb\x98\x80\x3d\xf0\x0e\xe1\x3e\xa0\x83\x8d\xcd\x89\x31\xc2\x47\xe5\x54\x87\x3d\x3a\x86\x10\xfd\xef\xa8\x79\xb1\x50\x81\x9e\x30\x26\xf2\xae\x01\x15\x08\xff\x62\x74\xf2\x97\x56\x9f\x7f\x48\x2b\xf2\xeb\xfd\x4d\x8b\xac\x6e\x16\x9f\xc9\x99\x06\x36\x39\xb1\xef\x3f\x61\xf2\x39\x6a\x7c\x87\x5b\x72\xc4\xe4\xdd\xa2\x47\x56\x46\xb1\x6a\x16\x00\x4e\xf5\xd8\x40\xf0\x96\xf4\x41\x0b\x28\x6f\x2d\xc6\xb4\x52\x1d\x3a\x5e\xf9\x80\x6e\xa9\x2b\xa4\x65\x3f\x42\x5a\x91\x5f\xa4\x80\x5a\x12\x74\xd1\xe7\xd0\xc0\xab\xf1\xb5\x54\x92\x25\x00\x15\xe0\x43\xa9\xba\x19\xab\x16\x97\xa5\xc4\xef\x67\xdd\x1f\x28\x71\x09\x09\x36\x47\x65\x67\x50\x17\x6f\x22\xd7\x65\xab\xe2\xa5\x7f\x01\x90\xb4\x0f\xd8\xc0\xaf\x02\x25\x28\x8d\x46\x6c\xb9\x8d\x03\x49\x03\xbc\xc4\x8a\x73\x6a\xe0\x9f\x7f\x17\x00\x7b\x65\xc9\x28\x21\xae\x3a\x65\x8f\xb7\x0f\x77\x4f\xdf\x3f\xea\x1d\xf6\xaa\x1a\x01\x0c\x26\x1d\x29\x94\xb8\x0b\xe8\x40\xa9\xb4\xb8\x2e\x81\xad\x8f\xe5\xf7\x62\x03\x70\xfb\x70\x37\xe4\x0b\xd1\x07\x8c\x4c\x47\x4c\xf2\x9d\x0c\xc0\x68\x3b\xab\xfc\x56\xa0\xd5\x18\x30\xd2\x72\xac\x95\xf7\xd5\x86\x06\x52\xc5\xe0\xb7\xc0\x3b\x4a\x10\x31\x44\x4c\xe8\xb8\x6c\xf1\x24\x2d\x48\x88\x72\xe0\x5b\x99\xbe\x15\x3c\x62\x94\x24\x90\x76\x3e\x5b\x03\xda\xbb\x3d\x46\x86\x88\xda\x77\x8e\xfe\x1e\x33\x8f\xf3\x6c\x15\xe3\xd0\xb1\xe3\x47\x8e\x31\x3a\x65\x85\xd4\x8c\xdf\x81\x72\x06\x7a\x75\x80\x88\x52\x03\xb2\x3b\xc9\x56\x42\xd2\x0a\x3e\xfa\x88\x40\x6e\xeb\x1b\xd8\x31\x87\xd4\xac\xd7\x1d\xf1\x71\xe4\xb5\xef\xfb\xec\x88\x0f\x6b\x7d\x72\xa4\xd6\x06\xf7\x68\xd7\x89\xba\xa5\x8a\x7a\x47\x8c\x9a\x73\xc4\xb5\x0a\xb4\x2c\xc0\x1d\x97\x73\xd3\x9b\x37\x63\xeb\xdf\x9e\x20\xe5\x83\x4c\x49\xe2\x48\xae\x1b\xcd\x65\x68\xaf\xf2\x2e\x33\x2b\x6d\x56\xc3\xb2\x8a\xff\x99\x5e\x31\x09\x2b\x9f\x7e\x7c\xfc\x0d\x8e\x45\x4b\x0b\xa6\x9c\x17\xb6\x9f\x97\xa5\x67\xe2\x85\x28\x72\x5b\x8c\xb5\x71\xdb\xe8\xfb\x92\x11\x9d\x09\x9e\x1c\x97\x1f\x6d\x09\xdd\x94\xf4\x94\xdb\x9e\x58\x3a\xfd\x47\xc6\xc4\xd2\x9f\x15\x6c\x94\x73\x9e\xa1\x45\xc8\xc1\x28\x46\xb3\x82\x3b\x07\x1b\xd5\xa3\xdd\xa8\x84\xdf\x9c\x76\x61\x38\x2d\x85\xd2\xd7\x89\x3f\xd5\xab\x69\x60\x65\x6b\x34\x1f\x35\x69\xb6\x43\xe7\x67\xf2\x31\xa0\x9e\x1c\x11\x83\x89\xa2\x8c\x31\x2b\x46\x19\xfe\x59\x7d\xba\x7e\x3a\xe5\x1b\xb4\x4f\xf4\x65\xea\x00\xe8\xc9\x7d\x40\xd7\xf1\xae\x81\x9b\x33\xd7\xec\xae\xcf\xd2\x15\xb9\xfa\x1a\x39\x0d\x06\xeb\x0f\x68\xee\xdd\xcf\xb9\x3d\x4f\x58\x57\xb5\xde\x5b\x54\x53\x31\xd8\x66\x6b\x45\xc1\xef\xf7\x18\x23\x99\xaf\x02\xa5\xb3\xbe\x55\xf6\x3c\xd3\xa4\x6b\x3f\x95\x90\xa7\xa2\x05\x93\x6e\xd5\xb5\x83\x4a\x9c\x65\xb8\xd6\x1d\xf9\xa8\x57\xdd\xb8\x89\x19\x3f\x80\x32\xa6\xdc\x93\xca\x3e\xbc\x90\xe7\xc5\x8d\x9d\xba\xcf\x66\x74\x82\xe3\x21\x5b\x5b\xa7\x6c\xae\xc0\x74\x7c\xc7\xd0\xc1\xde\xa2\x88\x4d\xbd\x42\xca\x8d\x42\xdb\xf5\x9f\x3b\x74\x22\xbf\x21\x5b\x0b\x6a\x16\xb3\x9c\x57\x45\x4e\xee\x24\x41\x70\x15\xf6\x95\x5d\x8d\xb0\x1f\x51\x47\xe4\x39\xd8\x2f\xac\xbf\xca\xc8\x2e\xb7\xef\x73\x8b\xda\xbb\x2d\x75\xf3\xa9\xff\xcf\x80\x05\x9f\xf8\x1d\x5a\x64\xfc\xc5\xb7\xa2\xa3\xa4\xf1\x56\x6b\x9f\xdd\x45\xfa\x2b\x39\x44\x34\x45\x15\x4e\xc3\x97\x17\x87\x61\xe2\x9c\xd9\xcb\xab\xc2\x55\x1f\x1c\x5f\x2c\x5d\x25\x7c\x72\x1c\x7c\x9b\xe4\x9a\xf8\x32\xf5\x3a\xc3\xb0\x3f\x3e\x2a\xf7\x37\xcf\x7f\x05\xcd\x72\x78\x1f\xee\x2b\xe3\xb5\x44\x03\x1c\x33\x0e\x0f\x25\x1f\x55\x87\x83\xe5\xbf\x00\x00\x00\xff\xff\x6c\xf7\x9f\x25\xe1\x0a\x00\x00")
+var _crdsKube111AgentOpenClusterManagementIo_policycontrollers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x5f\x8f\xdb\x36\x0c\x7f\xf7\xa7\x20\xd0\x87\xbe\x34\x09\x0e\x1b\x86\xc1\x28\x0a\x14\xe9\xb0\x75\x6d\xd7\x43\x53\xdc\xbb\x2c\xd1\xb6\x76\xb2\xa4\x4a\x54\xd6\x6c\xd8\x77\x1f\x28\x3b\x4e\x9c\x73\x2e\xd7\x5e\x87\x46\x79\xb1\x48\xfd\xf8\x57\x24\xf5\x04\xd6\xce\xef\x82\x6e\x5a\x82\xb5\xb3\x14\x74\x95\xc8\x85\x08\xe4\x80\x5a\x84\xf7\x1e\x2d\xac\x4d\x8a\x84\x01\xde\x09\x2b\x1a\xec\xd0\x12\xf8\xe0\xfe\x44\x49\x45\x21\xbc\xbe\xc1\x10\xb5\xb3\x25\x08\xaf\xf1\x33\xa1\xe5\xaf\xb8\xbc\xfd\x39\x2e\xb5\x5b\x6d\xaf\x2a\x24\x71\x55\xdc\x6a\xab\x4a\x58\xa7\x48\xae\xfb\x80\xd1\xa5\x20\xf1\x15\xd6\xda\x6a\xd2\xce\x16\x1d\x92\x50\x82\x44\x59\x00\x58\xd1\x61\x09\xde\x19\x2d\x77\x92\x95\x72\xc6\x60\x88\x4b\xd1\xa0\xa5\xa5\xf3\x68\x17\xb2\x57\x69\xd1\x8d\x2a\x2d\xb5\x2b\xa2\x47\xc9\x00\x4d\x70\xc9\x97\x70\x91\xbf\x17\x15\xf9\x08\x40\xaf\xe0\x75\x96\xba\x1e\xa5\x66\x92\xd1\x91\xde\xcc\x92\xdf\xea\x48\x99\xc5\x9b\x14\x84\x99\xd1\x3a\x53\xa3\xb6\x4d\x32\x22\xdc\xa5\x17\x00\x51\x3a\x8f\x25\xfc\x21\x3a\x8c\x5e\x48\x54\xbc\x97\xaa\x30\x38\x69\x50\x2f\x92\xa0\x14\x4b\xf8\xe7\xdf\x02\x60\x2b\x8c\x56\x82\x1d\xd7\x13\xd9\xc6\x97\xd7\xaf\x6f\x7e\xd8\xc8\x16\xbb\xec\x44\xde\x56\x18\x65\xd0\x3e\xf3\xdd\x51\x1d\x74\xcc\x21\xee\x8f\x40\xed\x42\xfe\xbc\x63\x00\xbc\xbc\x7e\x3d\xe0\xf9\xe0\x3c\x06\xd2\x7b\x97\xf1\x3a\x4a\x80\x71\xef\x44\xf2\x53\x56\xad\xe7\x01\xc5\x21\xc7\x5e\xf2\xb6\xdf\x43\x05\xb1\xd7\xc1\xd5\x40\xad\x8e\x10\xd0\x07\x8c\x68\x29\x9b\x78\x04\x0b\xe0\x6a\x10\x16\x5c\xc5\xd9\xb7\x84\x0d\x06\x06\x81\xd8\xba\x64\x14\x48\x67\xb7\x18\x08\x02\x4a\xd7\x58\xfd\xf7\x88\x3c\xe6\xb3\x11\x84\x91\x26\x88\xda\x12\x06\x2b\x0c\x3b\x35\xe1\x33\x10\x56\x41\x27\x76\x10\x90\x65\x40\xb2\x47\x68\x99\x25\x2e\xe1\x9d\x0b\x08\xda\xd6\xae\x84\x96\xc8\xc7\x72\xb5\x6a\x34\xed\x53\x5e\xba\xae\x4b\x56\xd3\x6e\x25\x8f\xae\xd4\x4a\xe1\x16\xcd\x2a\xea\x66\x21\x82\x6c\x35\xa1\xa4\x14\x70\x25\xbc\x5e\x64\xc5\x2d\x1b\x1b\x97\x9d\x7a\x32\x86\xfe\xe9\x91\xa6\xb4\xe3\x2c\x89\x14\xb4\x6d\xc6\xed\x9c\xb4\x67\xfd\xce\x39\xcb\x61\x16\xc3\xb1\xde\xc4\x83\x7b\x79\x8b\x03\xf1\xe1\x97\xcd\x47\xd8\x0b\xcd\x21\x38\x82\x84\xc1\xdb\x87\x63\xf1\xe0\x78\x76\x94\xb6\x35\x72\xee\xe8\x08\x75\x70\x5d\xf6\x33\x5a\xe5\x9d\xb6\x94\x3f\xa4\xd1\x68\xa7\x4e\x8f\xa9\xea\x34\x71\xa4\x3f\x25\x8c\xc4\xf1\x59\xc2\x5a\x58\xeb\x08\x2a\x84\xe4\x95\x20\x54\x4b\x78\x6d\x61\x2d\x3a\x34\x6b\x11\xf1\x7f\x77\x3b\x7b\x38\x2e\xd8\xa5\x97\x1d\x7f\x5c\xaf\xf6\xbf\x9e\xb1\xf7\xd6\xb8\xbd\xaf\x49\xb3\x11\x3a\xbd\x93\x1b\x8f\x72\x72\x45\x14\x46\x1d\x38\x8d\x49\x10\x72\xf2\x9f\x9e\x38\x42\x9e\xbb\x9d\xbc\x86\xda\xc7\xf5\x65\x4a\x00\xe8\xb4\x7d\x8b\xb6\xa1\xb6\x84\xab\x13\xd2\xac\xd5\x27\x70\xb9\x5c\x7d\x0b\x4c\x85\xde\xb8\x1d\xaa\xf7\xf6\xb7\x54\x9d\x02\xf6\x9a\x54\xce\x19\x14\x76\x42\xab\x93\x31\x5c\xc1\xdf\x6f\x31\x04\xad\xbe\x89\x79\x8d\x71\x95\x30\xa7\x48\x93\xa8\xfd\x9a\x59\x6e\xf8\x2e\xc5\x49\xb4\xfa\xb3\xfd\x2d\x8b\x27\x08\xe7\xa2\xc3\x4b\x77\xa2\x19\x8d\x98\xa1\x03\x08\xa5\x72\x9f\x14\xe6\xfa\x1e\x9c\x7b\x0d\xbb\x27\x47\xf7\x2b\xeb\x71\x9d\x8c\xe9\xb3\x6c\x4e\xc0\x34\x7d\x47\xd6\x61\xbf\x42\x2e\x36\x7d\x0b\xc9\x1d\x45\xd7\xab\xbf\x5a\xb4\x5c\x7e\x7d\x32\x06\xc4\x0c\x24\x70\xdd\x26\xa1\x2d\x86\x5e\x83\xe2\x0b\xad\x1a\xd5\xde\xa0\x0c\x48\xe5\x97\x9e\xb7\x4e\xe1\x06\x0d\x4a\x72\xe1\xfb\x39\xdf\x07\xf7\x99\xdb\x73\xad\x9b\xef\xa7\xc4\xd8\x79\x2e\xc6\x7e\x3f\xc3\x7d\xc0\x4f\x49\x87\x3c\x52\xc5\xa3\x2c\xc8\x75\xdf\x75\x3e\x11\x1e\x3a\x4b\x38\xe2\x5d\xce\x08\xb8\xef\x8e\xf0\x32\x9a\x9b\xc6\x3c\xed\x82\x61\xfc\xdf\x37\x9b\xaf\x04\xb8\x97\x4c\xce\x60\xc8\xe3\xca\x2c\xbc\x26\xec\xce\xc8\x9d\x78\xf5\x63\x9e\xc0\x54\xdf\x4d\x3f\x8e\x98\xb9\x89\x13\x09\xd9\xa2\xe2\xbb\x34\x48\xe3\xdb\x66\x77\xc0\x77\x67\x4e\x61\x5e\xd4\x0a\x82\x4e\x90\x6c\x87\xa0\x50\xd0\xde\x20\x3c\xbf\xc5\xdd\xb3\x5c\xa8\x9e\x61\x5d\xa3\xa4\x17\x90\xe2\x7e\x1e\xc8\xfc\xfc\xc1\xe1\x10\xe4\x42\x71\x07\x37\xff\x9f\xef\xe9\x2f\xe6\xa2\x79\x39\x9e\x00\xbd\xec\x73\xd4\x8b\xb9\xcc\xff\x5b\xdc\x3d\xea\xfc\xde\x86\x47\x81\x1c\xc2\xbf\x41\xe9\xac\x3a\x6b\x30\x70\x59\xec\x04\x95\xa0\x2d\xfd\xf4\xe3\x59\xae\x5e\x26\xcf\xa6\xcd\xa4\xc9\x1f\xaf\x1c\xbe\x47\xa8\xfd\xa0\x7c\x17\x21\x88\x5d\xf1\xc0\x83\x6d\xaa\xde\xa4\x8a\x3d\x50\xeb\x66\xbe\x1a\x7f\x4d\x4f\xf6\x2e\xd2\x2b\x34\x48\xf8\xbb\xab\x78\xf4\xd4\x12\x5f\x4a\xe9\x92\xa5\xf2\x61\x18\x43\xe5\x99\xcc\xca\x8b\x3b\xf3\xc3\x84\x38\x63\x4b\x71\xc1\x05\xc3\x1b\xad\x38\x73\xb9\x4f\x67\xb7\x4d\x66\x9f\x4c\x10\xae\x8a\xfc\xa4\x79\xd8\xc0\x77\xa2\xc3\xf0\x9c\x2a\x61\x7b\x75\xf8\xca\x89\xb8\x18\x9e\xd4\x99\x00\xd0\x8b\x28\x81\x42\xc2\xe1\x6d\xe9\x82\x68\xb0\x04\x0a\x09\x8b\xff\x06\x00\x36\x09\x8a\x74\x14\x10\x00\x00")
 
 func crdsKube111AgentOpenClusterManagementIo_policycontrollers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -438,7 +438,7 @@ func crdsKube111AgentOpenClusterManagementIo_policycontrollers_crdYaml() (*asset
 	return a, nil
 }
 
-var _crdsKube111AgentOpenClusterManagementIo_searchcollectors_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xcd\x8e\xdb\x36\x10\xbe\xfb\x29\x06\xc8\x21\x97\xda\xc6\xa2\x97\x42\xb7\xc0\x2d\x8a\x45\x92\xc6\x88\x8b\xbd\x53\xd4\x58\x9a\x86\x22\x59\x72\xe8\xd6\x2d\xfa\xee\xc5\x90\xb2\x2d\x29\xf2\x6e\x0a\x6c\x75\xe3\xfc\x7e\xfc\xe6\x47\x7c\x03\x3b\xe7\xcf\x81\xda\x8e\x61\xe7\x2c\x07\xaa\x13\xbb\x10\x81\x1d\x70\x87\xf0\xc9\xa3\x85\x9d\x49\x91\x31\xc0\x47\x65\x55\x8b\x3d\x5a\x06\x1f\xdc\x6f\xa8\x79\xb5\x52\x9e\x9e\x30\x44\x72\xb6\x02\xe5\x09\xff\x64\xb4\x72\x8a\x9b\x2f\x3f\xc4\x0d\xb9\xed\xe9\xa1\x46\x56\x0f\xab\x2f\x64\x9b\x0a\x76\x29\xb2\xeb\x3f\x63\x74\x29\x68\xfc\x11\x8f\x64\x89\xc9\xd9\x55\x8f\xac\x1a\xc5\xaa\x5a\x01\x58\xd5\x63\x05\x11\x55\xd0\x9d\x76\xc6\xa0\x16\x48\x1b\xd5\xa2\xe5\x8d\xf3\x68\xd7\xba\x20\x5a\xf7\x57\x44\x1b\x72\xab\xe8\x51\x8b\x7f\x1b\x5c\xf2\x15\xbc\x68\x5f\x32\x45\x71\x01\x28\xf8\x0e\x39\xe9\xee\x92\x34\x6b\x0c\x45\x7e\xbf\xa4\xfd\x40\x91\xb3\x85\x37\x29\x28\xf3\x35\xe4\xac\x8c\x64\xdb\x64\x54\xf8\x4a\xbd\x02\x88\xda\x79\xac\xe0\x17\x81\xe1\x95\xc6\x46\x64\xa9\x0e\x03\x3f\x03\xb4\xc8\x8a\x53\xac\xe0\xef\x7f\x56\x00\x27\x65\xa8\x51\xc2\x59\x51\xca\xfd\xde\xed\x1f\x9f\xbe\x3f\xe8\x0e\x7b\x55\x84\x00\x0d\x46\x1d\xc8\x67\xbb\x39\x6e\xa0\x98\x8b\x5b\x3c\xe0\xe8\x42\x3e\xce\xd1\xc3\xbb\xfd\xe3\x10\xcd\x07\xe7\x31\x30\x5d\x10\xc9\x37\xaa\xfc\x55\x36\xcb\xfb\x56\x80\x15\x1b\x68\xa4\xd6\x58\x12\x9f\x8a\x0c\x1b\x88\x05\x82\x3b\x02\x77\x14\x21\xa0\x0f\x18\xd1\x72\xbe\xe0\x28\x2c\x88\x89\xb2\xe0\x6a\x69\xbb\x0d\x1c\x30\x48\x10\x88\x9d\x4b\xa6\x01\xed\xec\x09\x03\x43\x40\xed\x5a\x4b\x7f\x5d\x23\x5f\x1b\xd9\x28\xc6\xa1\x5a\x97\x8f\x2c\x63\xb0\xca\x08\xa5\x09\xbf\x03\x65\x1b\xe8\xd5\x19\x02\x4a\x0e\x48\x76\x14\x2d\x9b\xc4\x0d\x7c\x74\x01\x81\xec\xd1\x55\xd0\x31\xfb\x58\x6d\xb7\x2d\xf1\xa5\xd7\xb5\xeb\xfb\x64\x89\xcf\x5b\x3d\x9a\xa5\x6d\x83\x27\x34\xdb\x48\xed\x5a\x08\x26\x46\xcd\x29\xe0\x56\x79\x5a\x67\xe0\x96\xf3\xc0\xf4\xcd\x9b\x6b\xe1\xdf\x8e\x90\xf2\x59\x7a\x24\x72\x20\xdb\x5e\xc5\xb9\x5d\xef\xf2\x2e\xed\x2a\x55\x56\x83\x5b\xc1\x7f\xa3\x57\x44\xc2\xca\xe7\x9f\x0e\xbf\xc2\x25\x69\x2e\xc1\x94\xf3\xcc\xf6\xcd\x2d\xde\x88\x17\xa2\xc8\x1e\x31\x94\xc2\x1d\x83\xeb\x73\x44\xb4\x8d\x77\x64\x39\x1f\xb4\x21\xb4\x53\xd2\x63\xaa\x7b\x62\xa9\xf4\xef\x09\x23\x4b\x7d\x36\xb0\x53\xd6\x3a\x86\x1a\x21\xf9\x46\x31\x36\x1b\x78\xb4\xb0\x53\x3d\x9a\x9d\x8a\xf8\xbf\xd3\x2e\x0c\xc7\xb5\x50\xfa\x32\xf1\xe3\x45\x35\x35\x2c\x6c\x5d\xc5\x97\x6d\xb4\x58\xa1\xd9\x44\x1e\x3c\xea\xc9\x84\x34\x18\x29\x48\x17\xb3\x62\x94\xde\x5f\x5a\x4c\xf7\x67\x53\xbe\x61\xe7\xc9\x6e\x99\x2a\x00\x7a\xb2\x1f\xd0\xb6\xdc\x55\xf0\x30\x53\x2d\xde\x79\x16\x2e\xaf\xaa\xd7\x88\x79\x4c\xc6\xc8\x0a\xfe\x74\xc2\x10\xa8\x79\x95\x98\xad\x71\xb5\x32\xf3\x48\x13\xf2\x7f\xce\x26\x4f\x79\xa4\x27\xac\x17\xdf\x61\xd8\x67\x11\xee\xd1\x2c\x1f\xf5\xaa\xbd\x5e\x62\x41\x0f\xa0\x9a\x26\xff\xe7\x94\xd9\x3f\x13\xe7\xd9\x8b\x8d\xd5\xb3\x56\x9b\xe0\xd8\x27\x63\xf6\xce\x90\x3e\x2f\x25\x98\x10\x71\x33\x1d\xe4\x35\xca\xce\xf0\x45\x24\xff\x05\x3a\x6e\xff\xe8\xd0\xca\x16\xf5\xc9\x18\x50\x8b\x98\x65\xec\x14\x59\x0c\x05\xc1\x5d\xd8\x77\x6e\x75\x85\x7d\x40\x1d\x90\x97\x60\x3f\xe3\x7f\x97\x91\x2e\xd5\xef\x53\x8d\xda\xd9\x23\xb5\xcb\xa1\xff\x7b\x83\xc9\xde\x92\xc9\x1c\x47\x5a\x8f\x87\xe3\x9e\x3c\x0f\xcd\x44\x39\xef\xfe\x89\x72\x01\xfc\x8b\x0b\xa7\x3c\x13\xbe\x75\xe5\x64\xeb\x49\xfb\xbb\x3a\xca\x76\xff\xa6\xad\x33\x43\x70\xba\xbc\x01\x4f\x0f\xb7\x53\xc6\xb2\x1e\x9e\x73\xa7\x42\x70\xc9\x50\x01\x87\x84\xc3\xe3\xc6\x05\xd5\xe2\x20\xf9\x37\x00\x00\xff\xff\x7f\xc7\xae\xf3\x90\x0a\x00\x00")
+var _crdsKube111AgentOpenClusterManagementIo_searchcollectors_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4b\x8f\xdb\x36\x10\xbe\xeb\x57\x0c\x90\x43\x2e\xb1\x8d\x45\x8b\xa2\x10\x82\x00\x81\x5b\x14\x41\x92\x66\x11\x07\xb9\xd3\xd4\x48\x9a\x2e\x45\x32\xe4\xd0\x8d\x5b\xf4\xbf\x17\x43\xc9\x0f\x29\xf2\x3a\xdb\x4d\x11\xcb\x17\x71\x86\xf3\xf8\xe6\xa9\x27\xb0\x76\x7e\x1f\xa8\x69\x19\xd6\xce\x72\xa0\x6d\x62\x17\x22\xb0\x03\x6e\x11\xde\x79\xb4\xb0\x36\x29\x32\x06\x78\xab\xac\x6a\xb0\x43\xcb\xe0\x83\xfb\x03\x35\x17\x85\xf2\xf4\x11\x43\x24\x67\x4b\x50\x9e\xf0\x33\xa3\x95\xb7\xb8\xbc\xfb\x39\x2e\xc9\xad\x76\x37\x5b\x64\x75\x53\xdc\x91\xad\x4a\x58\xa7\xc8\xae\x7b\x8f\xd1\xa5\xa0\xf1\x17\xac\xc9\x12\x93\xb3\x45\x87\xac\x2a\xc5\xaa\x2c\x00\xac\xea\xb0\x84\x88\x2a\xe8\x56\x3b\x63\x50\x8b\x49\x4b\xd5\xa0\xe5\xa5\xf3\x68\x17\xba\xb7\x68\xd1\x1d\x2d\x5a\x92\x2b\xa2\x47\x2d\xf7\x9b\xe0\x92\x2f\xe1\x2a\x7f\xaf\x29\xca\x15\x80\xde\xbe\x4d\x56\xba\x3e\x28\xcd\x14\x43\x91\x5f\xcf\x51\xdf\x50\xe4\xcc\xe1\x4d\x0a\xca\x7c\x69\x72\x26\x46\xb2\x4d\x32\x2a\x7c\x41\x2e\x00\xa2\x76\x1e\x4b\xf8\x5d\x75\x18\xbd\xd2\x58\xc9\x59\xda\x86\x01\x9f\xc1\xb4\xc8\x8a\x53\x2c\xe1\xef\x7f\x0a\x80\x9d\x32\x54\x29\xc1\xac\x27\x8a\x7f\x2f\x6f\x5f\x7d\xfc\x61\xa3\x5b\xec\x32\x7e\x72\x5c\x61\xd4\x81\x7c\xe6\x9b\xda\x0d\x14\x73\x70\xfb\x1b\x50\xbb\x90\x5f\xa7\xd6\xc3\xcb\xdb\x57\x83\x34\x1f\x9c\xc7\xc0\x74\x00\x4b\x9e\xb3\xc8\x1f\xcf\x26\x7a\x9f\x8a\x61\x3d\x0f\x54\x12\x6b\xec\x15\xef\xfa\x33\xac\x20\xf6\x26\xb8\x1a\xb8\xa5\x08\x01\x7d\xc0\x88\x96\xb3\x83\x67\x62\x01\x5c\x0d\xca\x82\xdb\x4a\xda\x2d\x61\x83\x41\x84\x40\x6c\x5d\x32\x15\x68\x67\x77\x18\x18\x02\x6a\xd7\x58\xfa\xeb\x28\xf9\x98\xc8\x46\x31\x46\x1e\x49\x24\xcb\x18\xac\x32\x02\x69\xc2\x67\xa0\x6c\x05\x9d\xda\x43\x40\xd1\x01\xc9\x9e\x49\xcb\x2c\x71\x09\x6f\x5d\x40\x20\x5b\xbb\x12\x5a\x66\x1f\xcb\xd5\xaa\x21\x3e\xe4\xba\x76\x5d\x97\x2c\xf1\x7e\xa5\xcf\x6a\x69\x55\xe1\x0e\xcd\x2a\x52\xb3\x10\x80\x89\x51\x73\x0a\xb8\x52\x9e\x16\xd9\x70\x2b\xce\xc6\x65\x57\x3d\x39\x06\xfe\xe9\x99\xa5\xbc\x97\x1c\x89\x1c\xc8\x36\xc7\xe3\x9c\xae\x17\x71\x97\x74\x95\x28\xab\xe1\x5a\xef\xe2\x09\x5e\x39\x92\x40\xbc\xff\x75\xf3\x01\x0e\x4a\x73\x08\xce\x44\xc2\x80\xf6\xe9\x5a\x3c\x01\x2f\x40\x91\xad\x51\x52\x87\x22\xd4\xc1\x75\x19\x67\xb4\x95\x77\x64\x39\xbf\x68\x43\x68\xc7\xa0\xc7\xb4\xed\x88\x25\xd2\x9f\x12\x46\x96\xf8\x2c\x61\xad\xac\x75\x0c\x5b\x84\xe4\x2b\xc5\x58\x2d\xe1\x95\x85\xb5\xea\xd0\xac\x55\xc4\xff\x1d\x76\x41\x38\x2e\x04\xd2\xeb\xc0\x9f\x37\xaa\xc3\xaf\x67\xec\xd1\x3a\x1e\x1f\xba\xd1\x6c\x84\x26\x15\xb9\xf1\xa8\x47\x15\x52\x61\xa4\x20\x59\xcc\x8a\x51\x72\x7f\x72\xe1\x4c\xee\x5c\x6d\xca\x33\xf4\x3c\xe9\x2d\x63\x02\x40\x47\xf6\x0d\xda\x86\xdb\x12\x6e\x26\xa4\x59\x9f\x27\xe2\x72\xab\xfa\x16\x32\xeb\x64\x8c\xb4\xe0\x77\x3b\x0c\x81\xaa\x6f\x62\x67\x63\xdc\x56\x99\xa9\xa4\x11\xf8\xbf\x65\x96\x8f\x52\x12\x71\x84\x7a\x7f\xb7\x2f\x96\x38\x91\x70\x09\x66\x79\xa8\x53\xcd\xd1\x89\x19\x3a\x80\xaa\xaa\x3c\xe7\x94\xb9\xbd\x47\xce\xbd\x8e\xdd\x93\x6a\x87\x27\xdb\x71\x9b\x8c\xb9\x75\x86\xf4\x7e\x4e\xc1\x08\x88\x13\xeb\x70\xbe\x45\xe9\x19\x3e\xdf\xce\x73\x81\xea\xd5\x9f\x2d\x5a\xe9\xa2\x3e\x19\x03\x6a\x46\x24\x48\xfb\x65\x45\x16\x43\x6f\x41\xf1\x40\xaf\x8e\x66\x6f\x50\x07\xe4\xf2\xa1\xf7\xad\xab\x70\x83\xfd\x6c\xfb\x7e\xe0\xfb\xe0\x3e\xef\xd7\xce\xd6\xd4\x7c\x3f\x23\x8e\x03\xe4\x6a\xec\x0f\x3b\xd8\x7b\xfc\x94\x28\xe4\x9d\x28\x9e\x65\x41\x6e\xdf\xae\xf3\x89\xf1\x34\x20\xc2\x19\xef\x72\x46\xc1\x7d\x35\x22\x8f\x21\xe9\xfd\xf3\xb4\x2b\x8e\xc9\xff\x30\x33\xfe\xa3\x80\x7b\xc9\xec\x0c\x86\xbc\x75\xcc\x8a\x27\xc6\xee\x82\xde\x11\xaa\x1f\x5a\x04\xef\xaa\x7e\x28\x7e\x38\xca\xcc\xb3\x98\x59\xe9\x16\x2b\xa9\xa5\x41\x9b\x54\x9b\xdd\x83\xd4\xce\x9c\xc1\xf2\x70\xab\x18\x3a\xc5\xba\x1d\x82\xc2\x81\xbc\x41\x78\x7e\x87\xfb\x67\xb9\x51\x3d\xc3\xba\x46\xcd\x2f\x20\xc5\xc3\x58\xcf\xfc\xf2\x22\xe1\x50\xe3\x71\x71\xfe\x7b\x7e\xa0\xbf\x98\x8b\xe6\xf5\x78\x02\xf4\xba\x2f\x51\xaf\xe6\xb2\xfc\xef\x70\xff\xa8\xfb\x07\x1f\x1e\x25\xe4\x14\xfe\x0d\x6a\x67\xab\x8b\x0e\x83\xb4\xc5\x4e\x71\x09\x64\xf9\xa7\x1f\x2f\x72\xf5\x3a\x65\xc5\x6c\xf0\x12\xfc\x39\x7c\x8f\x30\xfb\xab\xf2\x5d\x85\xa0\xf6\xc5\x57\x5e\x6c\xd3\xf6\x75\xda\x0a\x02\x35\x35\xf3\xdd\xf8\xe1\x33\x79\xe8\x1a\xa3\x75\x75\x71\xbe\x4f\x5c\x3a\xcf\x7b\xc6\x88\x38\x5d\x18\x46\xc4\x19\xe3\x8b\x2b\x3e\x0f\x5f\x56\xc5\x85\x6a\x9e\x6e\x69\x99\x7b\xb4\x31\xb8\x6d\x94\x2f\x91\xaf\x5a\xd4\x26\x16\x0c\x1f\x41\x25\xec\x6e\x4e\x6f\x39\xef\x16\xc3\x17\x70\x26\x00\xf4\x1a\x4a\xe0\x90\x70\xf8\x1e\x74\x41\x35\x58\x02\x87\x84\xc5\xbf\x03\x00\x84\xbe\x6a\xb9\xc3\x0f\x00\x00")
 
 func crdsKube111AgentOpenClusterManagementIo_searchcollectors_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -458,7 +458,7 @@ func crdsKube111AgentOpenClusterManagementIo_searchcollectors_crdYaml() (*asset,
 	return a, nil
 }
 
-var _crdsKube111AgentOpenClusterManagementIo_workmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x8f\xdb\x46\x0f\xbd\xfb\x57\x10\xc8\x21\x97\xcf\x36\x16\xdf\xa5\xd0\x2d\x70\x8b\x62\x91\x4d\x63\xc4\xc5\xf6\x3c\x92\x68\x89\xdd\xd1\xcc\x94\xc3\x71\xea\x16\xfd\xef\x05\x47\xb2\x2c\x19\x72\x36\x2d\x52\xdd\x44\x72\xc8\x37\x8f\xe4\x93\xde\xc0\xce\x87\x33\x53\xd3\x0a\xec\xbc\x13\xa6\x32\x89\xe7\x08\xe2\x41\x5a\x84\x8f\x01\x1d\xec\x6c\x8a\x82\x0c\x1f\x8c\x33\x0d\x76\xe8\x04\x02\xfb\x5f\xb1\x92\xd5\xca\x04\x7a\x46\x8e\xe4\x5d\x01\x26\x10\xfe\x2e\xe8\xf4\x2d\x6e\x5e\xbe\x8b\x1b\xf2\xdb\xd3\x43\x89\x62\x1e\x56\x2f\xe4\xea\x02\x76\x29\x8a\xef\x3e\x61\xf4\x89\x2b\xfc\x1e\x8f\xe4\x48\xc8\xbb\x55\x87\x62\x6a\x23\xa6\x58\x01\x38\xd3\x61\x01\x9f\x3d\xbf\x74\xb9\x22\xc7\x8d\x69\xd0\xc9\xc6\x07\x74\xeb\xaa\x47\xb3\xee\x46\x34\x1b\xf2\xab\x18\xb0\xd2\xb3\x0d\xfb\x14\x0a\x78\x35\xbe\xaf\x12\xf5\x08\x40\x8f\xed\x17\xcf\x2f\xfd\x15\x39\x5b\x2d\x45\x79\x7f\xeb\x79\xa2\x28\xd9\x1b\x6c\x62\x63\xe7\x30\xb3\x23\x92\x6b\x92\x35\x3c\x73\xad\x00\x62\xe5\x03\x16\xf0\x93\x96\x0d\xa6\xc2\x5a\x6d\xa9\xe4\x81\x8b\x01\x4a\x14\x23\x29\x16\xf0\xe7\x5f\x2b\x80\x93\xb1\x54\x1b\xe5\xa7\x77\xea\x7d\xde\xed\x1f\x9f\xff\x7f\xa8\x5a\xec\x4c\x6f\x04\xa8\x31\x56\x4c\x21\xc7\x4d\xb1\x02\xc5\xdc\xc4\x3e\x1a\x8e\x9e\xf3\xeb\x14\x31\xbc\xdb\x3f\x0e\x59\x02\xfb\x80\x2c\x74\x41\xa2\xcf\xa4\xbb\xa3\xed\xa6\xde\x5b\x05\xd4\xc7\x40\xad\xfd\xc4\xbe\xe8\xa9\xb7\x61\x0d\xb1\x2f\xef\x8f\x20\x2d\x45\x60\x0c\x8c\x11\x9d\xe4\x8b\x4d\xd2\x82\x86\x18\x07\xbe\xd4\xd1\xda\xc0\x01\x59\x93\x40\x6c\x7d\xb2\x35\x54\xde\x9d\x90\x05\x18\x2b\xdf\x38\xfa\x63\xcc\x3c\x0e\xab\x35\x82\x43\x77\x2e\x0f\x39\x41\x76\xc6\x2a\x95\x09\xff\x07\xc6\xd5\xd0\x99\x33\x30\x6a\x0d\x48\x6e\x92\x2d\x87\xc4\x0d\x7c\xf0\x8c\x40\xee\xe8\x0b\x68\x45\x42\x2c\xb6\xdb\x86\xe4\x32\xcf\x95\xef\xba\xe4\x48\xce\xdb\x6a\xb2\x2f\xdb\x1a\x4f\x68\xb7\x91\x9a\xb5\xe1\xaa\x25\xc1\x4a\x12\xe3\xd6\x04\x5a\x67\xe0\x4e\xf2\x52\x74\xf5\x9b\xb1\xe1\x6f\x27\x48\xe5\xac\xb3\x11\x85\xc9\x35\xa3\x39\x8f\xe5\x5d\xde\x75\x34\xb5\xc3\x66\x38\xd6\xe3\xbf\xd2\xab\x26\x65\xe5\xd3\x0f\x87\x9f\xe1\x52\x34\xb7\x60\xce\x79\x66\xfb\x7a\x2c\x5e\x89\x57\xa2\xc8\x1d\x91\xfb\xc6\x1d\xd9\x77\x39\x23\xba\x3a\x78\x72\x92\x5f\x2a\x4b\xe8\xe6\xa4\xc7\x54\x76\x24\xda\xe9\xdf\x12\x46\xd1\xfe\x6c\x60\x67\x9c\xf3\x02\x25\x42\x0a\xb5\x11\xac\x37\xf0\xe8\x60\x67\x3a\xb4\x3b\x13\xf1\x3f\xa7\x5d\x19\x8e\x6b\xa5\xf4\x75\xe2\xa7\x62\x34\x0f\xec\xd9\x1a\xcd\x17\xd5\x59\xec\xd0\x64\x13\x0f\x01\xab\xd9\x76\xd4\x18\x89\x75\x82\xc5\x08\xea\xdc\xdf\x8a\xcf\xfd\x9d\xd4\x67\xd0\xb4\x27\x53\xa2\xbd\x71\x01\x98\xba\xce\x9a\x6a\xec\xfe\xce\xf1\xbb\x17\xff\xc2\x55\x27\x65\x55\xc2\x6e\x13\x76\xe4\x9e\xd0\x35\xd2\x16\xf0\xb0\x98\x6e\xa1\xd2\x24\x5d\x56\xc4\x6f\x91\xf3\x98\xac\x55\x65\xff\x78\x42\x66\xaa\xbf\x49\xce\xc6\xfa\xd2\xd8\xdb\x4c\xb3\x5e\xff\x98\x43\x9e\xb3\x82\xcc\x1a\xdd\x9f\x1d\xb4\xe5\x26\xc3\xbd\xee\xea\x43\x9d\x69\xc6\x4b\x2c\xf8\xbf\xb6\xcd\x5f\xbc\xd8\xd4\xbd\xd0\xee\x11\xc7\x3e\x59\xbb\xf7\x96\xaa\xf3\x52\x81\x19\x11\xd7\xd0\xc1\x5e\xa2\x4a\x54\xe8\x4d\xfa\x09\xa2\xe3\xf6\x73\x8b\x4e\x45\x3b\x24\x6b\xc1\x2c\x62\xd6\x2d\x37\xe4\xf4\x23\xa6\x08\xee\xc2\xbe\x73\xab\x11\xf6\x01\x2b\x46\x59\x82\xfd\x6f\x16\xa0\x4d\xe5\xfb\x54\x62\xe5\xdd\x91\x9a\xe5\xd4\xff\x7c\xc0\x54\x26\x55\x0c\xa6\x99\xd6\xf3\x15\x5f\xf2\xe8\xda\xdc\xb3\xe7\x75\x9a\x39\x6f\xf7\x62\xe6\x5c\xb8\xd6\xab\xca\xd7\xff\xa7\x7c\x8d\xf6\xe5\xc8\xd9\x52\xf8\x32\xea\x27\xe6\x55\xf9\xbb\xa9\x7c\xba\xfc\x68\x9e\x1e\xae\x6f\x19\xc3\x7a\xf8\x67\x3c\xf5\x94\xf7\xd9\x0b\x10\x4e\x38\xfc\x55\x79\x36\x0d\x0e\x96\xbf\x03\x00\x00\xff\xff\x57\xda\xe9\xbc\xf5\x0a\x00\x00")
+var _crdsKube111AgentOpenClusterManagementIo_workmanagers_crdYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x5f\x8f\xdb\x36\x0c\x7f\xf7\xa7\x20\xd0\x87\xbe\x34\x09\x0e\x1b\x86\xc1\x28\x0a\x14\xd9\x30\x14\x6d\xd7\x43\x53\x74\xcf\xb2\x4d\xdb\xda\xc9\x92\x4a\x51\x69\xb3\x61\xdf\x7d\xa0\xec\x38\x76\xe0\x5c\xae\xbb\x0e\x8d\xf3\x62\x91\xe2\x9f\xdf\x8f\xa2\xe8\x27\xb0\x75\xfe\x40\xba\x69\x19\xb6\xce\x32\xe9\x22\xb2\xa3\x00\xec\x80\x5b\x84\x77\x1e\x2d\x6c\x4d\x0c\x8c\x04\x6f\x95\x55\x0d\x76\x68\x19\x3c\xb9\x3f\xb1\xe4\x2c\x53\x5e\x7f\x44\x0a\xda\xd9\x1c\x94\xd7\xf8\x85\xd1\xca\x5b\x58\xdf\xfd\x1c\xd6\xda\x6d\xf6\x37\x05\xb2\xba\xc9\xee\xb4\xad\x72\xd8\xc6\xc0\xae\x7b\x8f\xc1\x45\x2a\xf1\x17\xac\xb5\xd5\xac\x9d\xcd\x3a\x64\x55\x29\x56\x79\x06\x60\x55\x87\x39\x7c\x76\x74\xd7\x25\x8f\x14\xd6\xaa\x41\xcb\x6b\xe7\xd1\xae\xca\x3e\x9a\x55\x37\x46\xb3\xd6\x2e\x0b\x1e\x4b\xd9\xdb\x90\x8b\x3e\x87\xab\xfa\xbd\x97\x20\x5b\x00\xfa\xd8\xfe\x70\x74\xd7\xa7\x48\x69\xd5\xe8\xc0\xaf\xcf\x25\x6f\x74\xe0\x24\xf5\x26\x92\x32\xf3\x30\x93\x20\x68\xdb\x44\xa3\x68\x26\xca\x00\x42\xe9\x3c\xe6\xf0\xbb\xea\x30\x78\x55\x62\x25\x6b\xb1\xa0\x01\x8b\x21\x94\xc0\x8a\x63\xc8\xe1\xef\x7f\x32\x80\xbd\x32\xba\x52\x82\x4f\x2f\x94\x7c\x5e\xde\xbe\xfa\xf8\xc3\xae\x6c\xb1\x4b\x58\xc9\x72\x85\xa1\x24\xed\x93\xde\x34\x56\xd0\x21\x91\xd8\x6b\x43\xed\x28\xbd\x4e\x23\x86\x97\xb7\xaf\x06\x2b\x9e\x9c\x47\x62\x7d\x04\x45\x9e\x09\xbb\xe3\xda\x99\xbf\xa7\x12\x50\xaf\x03\x95\xf0\x89\xbd\xd3\x7d\xbf\x86\x15\x84\xde\xbd\xab\x81\x5b\x1d\x80\xd0\x13\x06\xb4\x9c\x12\x9b\x98\x05\x70\x35\x28\x0b\xae\x90\xd2\x5a\xc3\x0e\x49\x8c\x40\x68\x5d\x34\x15\x94\xce\xee\x91\x18\x08\x4b\xd7\x58\xfd\xd7\x68\x79\x2c\x56\xa3\x18\x03\xcf\x2c\x6a\xcb\x48\x56\x19\x81\x32\xe2\x33\x50\xb6\x82\x4e\x1d\x80\x50\x7c\x40\xb4\x13\x6b\x49\x25\xac\xe1\xad\x23\x04\x6d\x6b\x97\x43\xcb\xec\x43\xbe\xd9\x34\x9a\x8f\xf5\x5c\xba\xae\x8b\x56\xf3\x61\x53\x4e\xce\xcb\xa6\xc2\x3d\x9a\x4d\xd0\xcd\x4a\x51\xd9\x6a\xc6\x92\x23\xe1\x46\x79\xbd\x4a\x81\x5b\x49\x36\xac\xbb\xea\xc9\x48\xf8\xd3\x49\xa4\x7c\x90\xda\x08\x4c\xda\x36\xe3\x72\x2a\xcb\x8b\xb8\x4b\x69\x0a\xc3\x6a\xd8\xd6\xa7\x78\x82\x57\x96\x84\x88\xf7\xbf\xee\x3e\xc0\xd1\x69\xa2\x60\x62\x12\x06\xb4\x4f\xdb\xc2\x09\x78\x01\x4a\xdb\x1a\xa5\x6c\x74\x80\x9a\x5c\x97\x70\x46\x5b\x79\xa7\x2d\xa7\x97\xd2\x68\xb4\x73\xd0\x43\x2c\x3a\xcd\xc2\xf4\xa7\x88\x81\x85\x9f\x35\x6c\x95\xb5\x8e\xa1\x40\x88\xbe\x52\x8c\xd5\x1a\x5e\x59\xd8\xaa\x0e\xcd\x56\x05\xfc\xdf\x61\x17\x84\xc3\x4a\x20\xbd\x0e\xfc\xb4\x19\x1d\x7f\xbd\x62\x8f\xd6\xb8\x7c\xec\x3a\x8b\x0c\x4d\x4e\xe2\xce\x63\x39\x3b\x1d\x15\x06\x4d\x52\xc1\xac\x18\xa5\xee\x27\xca\x13\x7b\x4b\x67\x52\x9e\xa1\xa7\xbd\x51\x05\x9a\x33\x11\x80\xaa\xaa\xd4\x53\x95\xb9\xbd\xb0\xfd\x62\xe2\xf7\xa4\x3a\x71\x2b\x2d\xec\xdc\x60\xa7\xed\x1b\xb4\x0d\xb7\x39\xdc\x64\x0f\xf4\x34\x31\x97\x3a\xe2\xb7\xb0\x59\x47\x63\xa4\xb3\xbf\xdb\x23\x91\xae\xbe\x49\x9c\x8d\x71\x85\x32\xe7\x96\x66\x5c\xff\x96\x54\x3e\xca\x09\x0c\x33\xa2\xfb\xbd\xfd\xd9\x0c\x67\x16\x2e\xb1\x2b\x8f\xee\x54\x33\x26\xb1\x20\x7f\x28\xcd\xf7\x26\x76\x85\xee\x31\x8e\xdb\x68\xcc\xad\x33\xba\x3c\x2c\x39\x98\x01\x71\x52\x1d\xd6\x0b\x94\x16\xe5\xd3\xee\x74\x05\xe9\x7a\xf3\xb9\x45\x2b\x4d\xdb\x47\x63\x40\x2d\x98\x04\xe9\xf6\xac\xb4\x95\x4b\x4c\x90\xc8\xbe\x32\xab\x31\xec\x1d\x96\x84\x9c\x7f\xed\x7e\xeb\x2a\xdc\xa1\xc1\x92\x1d\x7d\x3f\xf0\x3d\xb9\x2f\x87\xad\xb3\xb5\x6e\xbe\x5f\x10\xe3\x7d\x75\x95\xfb\xe3\x58\xf7\x1e\x3f\x45\x4d\x69\xd4\x0a\x93\x2a\x48\xb7\x85\xeb\x7c\x64\x3c\xdd\x47\x34\xd1\x5d\x2f\x38\xb8\xef\x8c\xc8\x63\xb4\x5c\x35\xcb\xb2\x2b\x89\xc9\xff\x78\x45\xfd\x47\x03\xf7\x8a\xd9\x19\xa4\x34\xe4\x2c\x9a\xd7\x8c\xdd\x05\xbf\x33\x54\x3f\xb4\x08\xde\x55\xfd\x1d\xfc\x61\xb4\x99\xae\x7e\x66\x55\xb6\x58\xc9\x59\x1a\xbc\xc9\x69\xb3\x07\x90\xb3\xb3\x14\xb0\x3c\xdc\x2a\x86\x4e\x71\xd9\x0e\xa4\x30\x69\x6f\x10\x9e\xdf\xe1\xe1\x59\x6a\x54\xcf\xb0\xae\xb1\xe4\x17\x10\xc3\x71\x8a\x48\xfa\xf2\x22\x74\x28\x76\x74\xc1\xf8\xf3\xa3\xfc\xc5\x12\x9b\xd7\xf9\x04\xe8\x7d\x5f\x92\x5e\xad\x65\xf9\xdf\xe1\xe1\x51\xfb\x8f\x39\x3c\xca\xc8\x89\xfe\x1d\x96\xce\x56\x17\x13\x06\x69\x8b\x9d\xe2\x1c\xb4\xe5\x9f\x7e\xbc\xa8\xd5\xfb\x94\x89\x76\x3e\x24\x4c\x7f\x89\xbe\x47\x84\xfd\xa0\x7a\x57\x44\xea\x90\x3d\x70\x63\x1b\x8b\xd7\xb1\x10\x04\x6a\xdd\x2c\x77\xe3\xaf\xbf\x93\x87\xae\x31\x9b\x8e\x57\xc7\x79\xa2\x9f\x8a\x96\x24\x32\xb8\x5c\x5a\x4f\x13\xc8\x4c\x78\x3e\x4a\xcc\x84\x0b\x69\x65\x57\xd0\x18\x3e\xed\xb2\x0b\xe7\x7c\x3a\x2e\x26\xcd\xd9\x1c\xe1\x8a\x20\x9f\x43\x57\x27\xc6\x33\xcf\xc3\x57\x58\x0e\xfb\x9b\xd3\x5b\xaa\xc4\xd5\xf0\x99\x9d\x04\x00\xbd\xf5\x1c\x98\x22\x0e\x1f\xa2\x8e\x54\x83\x39\x30\x45\xcc\xfe\x1d\x00\xb5\x52\xda\xcd\x28\x10\x00\x00")
 
 func crdsKube111AgentOpenClusterManagementIo_workmanagers_crdYamlBytes() ([]byte, error) {
 	return bindataRead(
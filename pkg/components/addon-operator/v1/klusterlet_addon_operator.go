@@ -60,12 +60,107 @@ func NewClusterRoleBinding(instance *agentv1.KlusterletAddonConfig) *rbacv1.Clus
 	}
 }
 
-// NewClusterRole - template for cluster role
-func NewClusterRole(instance *agentv1.KlusterletAddonConfig) *rbacv1.ClusterRole {
+// operatorClusterRoleRules are the rules klusterlet addon operator needs to reconcile the addon CRs &
+// install the addon charts, whatever addons are enabled
+var operatorClusterRoleRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{agentv1.SchemeGroupVersion.Group},
+		Resources: []string{
+			"applicationmanagers", "applicationmanagers/finalizers", "applicationmanagers/status",
+			"certpolicycontrollers", "certpolicycontrollers/finalizers", "certpolicycontrollers/status",
+			"iampolicycontrollers", "iampolicycontrollers/finalizers", "iampolicycontrollers/status",
+			"policycontrollers", "policycontrollers/finalizers", "policycontrollers/status",
+			"searchcollectors", "searchcollectors/finalizers", "searchcollectors/status",
+			"workmanagers", "workmanagers/finalizers", "workmanagers/status",
+		},
+		Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"configmaps", "secrets", "serviceaccounts", "services", "pods"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"namespaces"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"create", "patch"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "daemonsets", "replicasets"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		// create cannot be restricted by name, the clusterroles & clusterrolebindings of the agents are updated &
+		// deleted by name, see NewAgentClusterRoleRules
+		APIGroups: []string{"rbac.authorization.k8s.io"},
+		Resources: []string{"clusterroles", "clusterrolebindings"},
+		Verbs:     []string{"create", "get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"rbac.authorization.k8s.io"},
+		Resources: []string{"roles", "rolebindings"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{"apiextensions.k8s.io"},
+		Resources: []string{"customresourcedefinitions"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{"coordination.k8s.io"},
+		Resources: []string{"leases"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+}
+
+// AgentClusterRoleName returns the name of the clusterrole & clusterrolebinding the operator creates for the agent of
+// an addon
+func AgentClusterRoleName(addonName string) string {
+	return ClusterRolePrefix + addonName
+}
+
+// NewAgentClusterRoleRules returns the rules the operator needs to update & delete the clusterrole & clusterrolebinding
+// of the agent of an addon
+func NewAgentClusterRoleRules(addonName string) []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups:     []string{"rbac.authorization.k8s.io"},
+			Resources:     []string{"clusterroles", "clusterrolebindings"},
+			ResourceNames: []string{AgentClusterRoleName(addonName)},
+			Verbs:         []string{"delete", "patch", "update"},
+		},
+	}
+}
+
+// NewAgentClusterRoleEscalationRule returns the rule allowing the operator to bind & escalate the clusterrole of the
+// agent of an addon, for the agents acting on resources of any kind whose permissions the operator does not hold
+func NewAgentClusterRoleEscalationRule(addonName string) rbacv1.PolicyRule {
+	return rbacv1.PolicyRule{
+		APIGroups:     []string{"rbac.authorization.k8s.io"},
+		Resources:     []string{"clusterroles"},
+		ResourceNames: []string{AgentClusterRoleName(addonName)},
+		Verbs:         []string{"bind", "escalate"},
+	}
+}
+
+// NewClusterRole - template for cluster role, addonRules are the rules needed by the enabled addons.
+// the operator creates the clusterroles of the addons, so it has to hold the permissions it grants, or to be allowed to
+// bind & escalate clusterroles for the addons whose agents act on resources of any kind
+func NewClusterRole(instance *agentv1.KlusterletAddonConfig, addonRules []rbacv1.PolicyRule) *rbacv1.ClusterRole {
 	labels := map[string]string{
 		"app": instance.Name,
 	}
 
+	rules := make([]rbacv1.PolicyRule, 0, len(operatorClusterRoleRules)+len(addonRules))
+	rules = append(rules, operatorClusterRoleRules...)
+	rules = append(rules, addonRules...)
+
 	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: rbacv1.SchemeGroupVersion.String(),
@@ -75,19 +170,7 @@ func NewClusterRole(instance *agentv1.KlusterletAddonConfig) *rbacv1.ClusterRole
 			Name:   ClusterRolePrefix + KlusterletAddonOperator,
 			Labels: labels,
 		},
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{"*"},
-				Resources: []string{"*"},
-				Verbs:     []string{"*"},
-			},
-			{
-				APIGroups:       nil,
-				NonResourceURLs: []string{"*"},
-				Resources:       []string{},
-				Verbs:           []string{"*"},
-			},
-		},
+		Rules: rules,
 	}
 }
 
@@ -131,15 +214,10 @@ func NewDeployment(instance *agentv1.KlusterletAddonConfig, namespace string) (*
 		"app": instance.Name,
 	}
 
-	var deploymentImage string
-	if instance.Spec.ComponentOperatorImage != "" {
-		deploymentImage = instance.Spec.ComponentOperatorImage
-	} else {
-		imageRepository, err := instance.GetImage("klusterlet_addon_operator")
-		if err != nil {
-			return nil, err
-		}
-		deploymentImage = imageRepository
+	// spec.componentOperatorImage & spec.imageOverrides are handled by GetImage
+	deploymentImage, err := instance.GetImage("klusterlet_addon_operator")
+	if err != nil {
+		return nil, err
 	}
 
 	deployment := &appsv1.Deployment{
@@ -204,6 +282,19 @@ func NewDeployment(instance *agentv1.KlusterletAddonConfig, namespace string) (*
 		}
 	}
 
+	deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+		instance.Spec.ProxyConfig.EnvVars()...)
+
+	if agent := instance.Spec.AddonOperatorConfig; agent != nil {
+		if agent.NodePlacement != nil {
+			deployment.Spec.Template.Spec.NodeSelector = agent.NodePlacement.NodeSelector
+			deployment.Spec.Template.Spec.Tolerations = agent.NodePlacement.Tolerations
+		}
+		if agent.Resources != nil {
+			deployment.Spec.Template.Spec.Containers[0].Resources = *agent.Resources
+		}
+	}
+
 	return deployment, nil
 }
 
@@ -220,7 +311,7 @@ func NewImagePullSecret(instance *agentv1.KlusterletAddonConfig, client client.C
 		Namespace: instance.Namespace,
 	}
 	defaultSecretNsN := types.NamespacedName{
-		Name:      os.Getenv("DEFAULT_IMAGE_PULL_SECRET"),
+		Name:      os.Getenv(agentv1.DefaultImagePullSecretEnv),
 		Namespace: os.Getenv("POD_NAMESPACE"),
 	}
 	//fetch secret from cluster namespace
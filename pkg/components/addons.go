@@ -13,6 +13,7 @@ import (
 	certpolicyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/certpolicycontroller/v1"
 	iampolicyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/iampolicycontroller/v1"
 	policyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/policyctrl/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 	search "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/searchcollector/v1"
 	workmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/workmgr/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -27,18 +28,8 @@ const (
 
 var log = logf.Log.WithName("addons")
 
-type KlusterletAddon interface {
-	// GetAddonName retuns the addon name
-	GetAddonName() string
-	// RequiresHubKubeconfig returns true if this addon need to genrate a kubeconfig on hubside
-	CheckHubKubeconfigRequired() bool
-	// IsEnabled checks whether the addon is enabled in the klusterletaddonconfig
-	IsEnabled(instance *agentv1.KlusterletAddonConfig) bool
-	// NewAddonCR returns a CR of the addon by using the given klusterletaddonconfig & managedcluster's namespace
-	NewAddonCR(instance *agentv1.KlusterletAddonConfig, namespace string) (runtime.Object, error)
-	// GetManagedClusterAddOnName returns the ManagedClusterAddOn name that matches this addon
-	GetManagedClusterAddOnName() string
-}
+// KlusterletAddon is an addon deployed on the managed clusters by the klusterlet addon operator
+type KlusterletAddon = registry.KlusterletAddon
 
 var AppMgr = appmgr.AddonAppMgr{}
 var CertCtrl = certpolicyctrl.AddonCertPolicyCtrl{}
@@ -47,34 +38,45 @@ var PolicyCtrl = policyctrl.AddonPolicyCtrl{}
 var Search = search.AddonSearch{}
 var WorkMgr = workmgr.AddonWorkMgr{}
 
-// AddonsArray are all addons we support in this repo
-// each one's GetAddonName() should be unique
-// each one's GetManagedClusterAddOnName() should also be unique
-var AddonsArray = []KlusterletAddon{
-	AppMgr,
-	CertCtrl,
-	IAMCtrl,
-	PolicyCtrl,
-	Search,
-	WorkMgr,
+// GetAddons returns all registered addons ordered by addon name
+func GetAddons() []KlusterletAddon {
+	return registry.Addons()
 }
-var addonMap map[string]KlusterletAddon
-
-var managedClusterAddOnNameMap map[string]KlusterletAddon
 
-func init() {
-	addonMap = make(map[string]KlusterletAddon)
-	managedClusterAddOnNameMap = make(map[string]KlusterletAddon)
+// GetAddonsInDependencyOrder returns all registered addons, each addon comes after the addons it depends on
+func GetAddonsInDependencyOrder() []KlusterletAddon {
+	return registry.AddonsInDependencyOrder()
+}
 
-	for _, addon := range AddonsArray {
-		addonMap[addon.GetAddonName()] = addon
-		managedClusterAddOnNameMap[addon.GetManagedClusterAddOnName()] = addon
+// NewAddonManifests returns the manifests deploying the addon on the managed cluster, they are the manifests of
+// a ManifestsAddon or the CR of the addon otherwise. The proxy is only set on the addons connecting to the hub
+func NewAddonManifests(
+	addon KlusterletAddon,
+	instance *agentv1.KlusterletAddonConfig,
+	namespace string,
+) ([]runtime.Object, error) {
+	if !addon.CheckHubKubeconfigRequired() && instance.Spec.ProxyConfig != nil {
+		instance = instance.DeepCopy()
+		instance.Spec.ProxyConfig = nil
+	}
+	if manifestsAddon, ok := addon.(registry.ManifestsAddon); ok {
+		return manifestsAddon.NewAddonManifests(instance, namespace)
 	}
+	cr, err := addon.NewAddonCR(instance, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return []runtime.Object{cr}, nil
 }
 
 // ConstructManifestWorkName create a manifestwork name
 func ConstructManifestWorkName(instance *agentv1.KlusterletAddonConfig, addon KlusterletAddon) string {
-	return instance.Name + manifestworkMidName + addon.GetAddonName()
+	return ManifestWorkNamePrefix(instance) + addon.GetAddonName()
+}
+
+// ManifestWorkNamePrefix returns the prefix of the manifestwork names of the addons of a klusterletaddonconfig
+func ManifestWorkNamePrefix(instance *agentv1.KlusterletAddonConfig) string {
+	return instance.Name + manifestworkMidName
 }
 
 // GetAddonFromManifestWorkName returns KlusterletAddon given a manifestwork's name
@@ -91,8 +93,8 @@ func GetAddonFromManifestWorkName(manifestworkName string) (KlusterletAddon, err
 	idx += len(manifestworkMidName)
 
 	addonName := manifestworkName[idx:]
-	if addon, ok := addonMap[addonName]; ok {
-		return addon, nil
+	if registration, ok := registry.Get(addonName); ok {
+		return registration.Addon, nil
 	}
 
 	return nil, err
@@ -103,8 +105,8 @@ func GetAddonFromManifestWorkName(manifestworkName string) (KlusterletAddon, err
 // will return error if failed to find a match
 func GetAddonFromManagedClusterAddonName(name string) (KlusterletAddon, error) {
 	err := fmt.Errorf("failed to find addon from ManagedClusterAddOn %s", name)
-	if addon, ok := managedClusterAddOnNameMap[name]; ok {
-		return addon, nil
+	if registration, ok := registry.GetByManagedClusterAddOnName(name); ok {
+		return registration.Addon, nil
 	}
 	return nil, err
 }
@@ -5,7 +5,11 @@ package components
 
 import (
 	"os"
+	"reflect"
 	"testing"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	templateaddon "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/templateaddon/v1"
 )
 
 func TestGetAddonFromManagedClusterAddonName(t *testing.T) {
@@ -245,3 +249,103 @@ func TestGetManagedClusterAddOnName(t *testing.T) {
 		})
 	}
 }
+
+// noHubKubeconfigAddon is an addon which does not connect to the hub
+type noHubKubeconfigAddon struct {
+	KlusterletAddon
+}
+
+func (addon noHubKubeconfigAddon) CheckHubKubeconfigRequired() bool {
+	return false
+}
+
+func TestNewAddonManifestsProxy(t *testing.T) {
+	instance := &agentv1.KlusterletAddonConfig{
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			ImageOverrides: map[string]string{
+				"multicloud_manager":                "quay.io/example/multicloud-manager:1.0",
+				"klusterlet_addon_lease_controller": "quay.io/example/lease-controller:1.0",
+			},
+			ProxyConfig: &agentv1.ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		addon     KlusterletAddon
+		wantProxy map[string]string
+	}{
+		{
+			name:      "addon connecting to the hub",
+			addon:     WorkMgr,
+			wantProxy: map[string]string{"HTTPS_PROXY": "http://proxy.example.com:3128"},
+		},
+		{
+			name:      "addon not connecting to the hub",
+			addon:     noHubKubeconfigAddon{WorkMgr},
+			wantProxy: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifests, err := NewAddonManifests(tt.addon, instance, "open-cluster-management-agent-addon")
+			if err != nil {
+				t.Fatalf("NewAddonManifests() error = %v", err)
+			}
+			cr, ok := manifests[0].(*agentv1.WorkManager)
+			if !ok {
+				t.Fatalf("NewAddonManifests() returns %T, want a WorkManager", manifests[0])
+			}
+			if !reflect.DeepEqual(cr.Spec.GlobalValues.ProxyConfig, tt.wantProxy) {
+				t.Errorf("proxy = %v, want %v", cr.Spec.GlobalValues.ProxyConfig, tt.wantProxy)
+			}
+			if instance.Spec.ProxyConfig == nil {
+				t.Errorf("NewAddonManifests() should not modify the klusterletaddonconfig")
+			}
+		})
+	}
+}
+
+func TestNewAddonManifests(t *testing.T) {
+	templateAddon, err := templateaddon.NewTemplateAddon("my-agent", "", false, nil, nil,
+		"kind: ConfigMap\n---\nkind: Secret\n")
+	if err != nil {
+		t.Fatalf("NewTemplateAddon() error = %v", err)
+	}
+	instance := &agentv1.KlusterletAddonConfig{
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			ImageOverrides: map[string]string{
+				"multicloud_manager":                "quay.io/example/multicloud-manager:1.0",
+				"klusterlet_addon_lease_controller": "quay.io/example/lease-controller:1.0",
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		addon   KlusterletAddon
+		wantLen int
+	}{
+		{
+			name:    "CR of workmgr",
+			addon:   WorkMgr,
+			wantLen: 1,
+		},
+		{
+			name:    "manifests of template addon",
+			addon:   templateAddon,
+			wantLen: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifests, err := NewAddonManifests(tt.addon, instance, "open-cluster-management-agent-addon")
+			if err != nil {
+				t.Fatalf("NewAddonManifests() error = %v", err)
+			}
+			if len(manifests) != tt.wantLen {
+				t.Errorf("NewAddonManifests() returns %d manifests, want %d", len(manifests), tt.wantLen)
+			}
+		})
+	}
+}
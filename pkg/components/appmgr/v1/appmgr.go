@@ -11,10 +11,14 @@ package v1
 import (
 	"os"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	workmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/workmgr/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,8 +33,39 @@ const (
 
 var log = logf.Log.WithName("appmgr")
 
+// application-manager deploys the resources of subscriptions, which can be of any kind. The operator does not hold
+// these permissions, it is allowed to bind & escalate the clusterrole of the agent instead
+var clusterRoleRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"apps.open-cluster-management.io"},
+		Resources: []string{
+			"channels", "deployables", "deployables/status", "helmreleases", "helmreleases/status", "placementrules",
+			"placementrules/status", "subscriptions", "subscriptions/status", "subscriptionstatuses",
+		},
+		Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	addonoperator.NewAgentClusterRoleEscalationRule(ApplicationManager),
+}, addonoperator.NewAgentClusterRoleRules(ApplicationManager)...)
+
 type AddonAppMgr struct{}
 
+func init() {
+	registry.MustRegister(AddonAppMgr{}, registry.Metadata{
+		DisplayName: "Application Manager",
+		Description: "Processes events and other requests to managed resources.",
+		CRDName:     registry.KlusterletAddonConfigCRDName,
+		ImageKeys: []string{
+			"multicluster_operators_subscription",
+			"klusterlet_addon_lease_controller",
+		},
+	})
+}
+
+// GetClusterRoleRules returns the rules the klusterlet addon operator needs to install & run application-manager
+func (addon AddonAppMgr) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return clusterRoleRules
+}
+
 // IsEnabled - check whether appmgr is enabled
 func (addon AddonAppMgr) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
 	return instance.Spec.ApplicationManagerConfig.Enabled
@@ -44,6 +79,11 @@ func (addon AddonAppMgr) GetAddonName() string {
 	return AppMgr
 }
 
+// GetDependencies returns the addons which must be available before application-manager is installed
+func (addon AddonAppMgr) GetDependencies() []string {
+	return []string{workmgr.WorkMgr}
+}
+
 func (addon AddonAppMgr) NewAddonCR(instance *agentv1.KlusterletAddonConfig, namespace string) (runtime.Object, error) {
 	return newApplicationManagerCR(instance, namespace)
 }
@@ -70,6 +110,8 @@ func newApplicationManagerCR(
 		ImagePullSecret: instance.Spec.ImagePullSecret,
 		ImageOverrides:  make(map[string]string, 2),
 	}
+	gv.SetAgentValues(&instance.Spec.ApplicationManagerConfig.KlusterletAddonAgentSpec)
+	gv.SetProxyValues(instance.Spec.ProxyConfig)
 
 	imageRepository, err := instance.GetImage("multicluster_operators_subscription")
 	if err != nil {
@@ -11,11 +11,15 @@ package v1
 import (
 	"os"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	policyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/policyctrl/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 )
 
 // constants for cert policy controller
@@ -29,8 +33,43 @@ const (
 
 var log = logf.Log.WithName("certpolicyctrl")
 
+var clusterRoleRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"policy.open-cluster-management.io"},
+		Resources: []string{"certificatepolicies", "certificatepolicies/status", "certificatepolicies/finalizers"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"secrets", "namespaces"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"create", "patch"},
+	},
+}, addonoperator.NewAgentClusterRoleRules(CertPolicyController)...)
+
 type AddonCertPolicyCtrl struct{}
 
+func init() {
+	registry.MustRegister(AddonCertPolicyCtrl{}, registry.Metadata{
+		DisplayName: "Cert Policy Controller",
+		Description: "Monitors certificate expiration based on distributed policies.",
+		CRDName:     registry.KlusterletAddonConfigCRDName,
+		ImageKeys: []string{
+			"cert_policy_controller",
+			"klusterlet_addon_lease_controller",
+		},
+	})
+}
+
+// GetClusterRoleRules returns the rules the klusterlet addon operator needs to install & run cert-policy-controller
+func (addon AddonCertPolicyCtrl) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return clusterRoleRules
+}
+
 func (addon AddonCertPolicyCtrl) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
 	return instance.Spec.CertPolicyControllerConfig.Enabled
 }
@@ -43,6 +82,11 @@ func (addon AddonCertPolicyCtrl) GetAddonName() string {
 	return CertPolicyCtrl
 }
 
+// GetDependencies returns the addons which must be available before cert-policy-controller is installed
+func (addon AddonCertPolicyCtrl) GetDependencies() []string {
+	return []string{policyctrl.PolicyCtrl}
+}
+
 func (addon AddonCertPolicyCtrl) NewAddonCR(
 	instance *agentv1.KlusterletAddonConfig,
 	namespace string,
@@ -72,6 +116,8 @@ func newCertPolicyControllerCR(
 		ImagePullSecret: instance.Spec.ImagePullSecret,
 		ImageOverrides:  make(map[string]string, 1),
 	}
+	gv.SetAgentValues(&instance.Spec.CertPolicyControllerConfig.KlusterletAddonAgentSpec)
+	gv.SetProxyValues(instance.Spec.ProxyConfig)
 
 	imageRepository, err := instance.GetImage("cert_policy_controller")
 	if err != nil {
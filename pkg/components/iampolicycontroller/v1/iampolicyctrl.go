@@ -11,11 +11,15 @@ package v1
 import (
 	"os"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	policyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/policyctrl/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 )
 
 // constants for component CRs
@@ -29,8 +33,48 @@ const (
 
 var log = logf.Log.WithName("iampolicyctrl")
 
+var clusterRoleRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"policy.open-cluster-management.io"},
+		Resources: []string{"iampolicies", "iampolicies/status", "iampolicies/finalizers"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{"rbac.authorization.k8s.io"},
+		Resources: []string{"clusterroles", "clusterrolebindings", "roles", "rolebindings"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"namespaces"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"create", "patch"},
+	},
+}, addonoperator.NewAgentClusterRoleRules(IAMPolicyController)...)
+
 type AddonIAMPolicyCtrl struct{}
 
+func init() {
+	registry.MustRegister(AddonIAMPolicyCtrl{}, registry.Metadata{
+		DisplayName: "IAM Policy Controller",
+		Description: "Monitors identity controls based on distributed policies.",
+		CRDName:     registry.KlusterletAddonConfigCRDName,
+		ImageKeys: []string{
+			"iam_policy_controller",
+			"klusterlet_addon_lease_controller",
+		},
+	})
+}
+
+// GetClusterRoleRules returns the rules the klusterlet addon operator needs to install & run iam-policy-controller
+func (addon AddonIAMPolicyCtrl) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return clusterRoleRules
+}
+
 func (addon AddonIAMPolicyCtrl) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
 	return instance.Spec.IAMPolicyControllerConfig.Enabled
 }
@@ -42,6 +86,11 @@ func (addon AddonIAMPolicyCtrl) GetAddonName() string {
 	return IAMPolicyCtrl
 }
 
+// GetDependencies returns the addons which must be available before iam-policy-controller is installed
+func (addon AddonIAMPolicyCtrl) GetDependencies() []string {
+	return []string{policyctrl.PolicyCtrl}
+}
+
 func (addon AddonIAMPolicyCtrl) NewAddonCR(
 	instance *agentv1.KlusterletAddonConfig,
 	namespace string,
@@ -71,6 +120,8 @@ func newIAMPolicyControllerCR(
 		ImagePullSecret: instance.Spec.ImagePullSecret,
 		ImageOverrides:  make(map[string]string, 1),
 	}
+	gv.SetAgentValues(&instance.Spec.IAMPolicyControllerConfig.KlusterletAddonAgentSpec)
+	gv.SetProxyValues(instance.Spec.ProxyConfig)
 
 	imageRepository, err := instance.GetImage("iam_policy_controller")
 	if err != nil {
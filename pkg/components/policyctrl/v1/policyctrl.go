@@ -13,6 +13,9 @@ import (
 
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
 	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	workmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/workmgr/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -29,8 +32,42 @@ const (
 
 var log = logf.Log.WithName("policyctrl")
 
+// config-policy-controller enforces configuration policies, which can be of any kind. The operator does not hold
+// these permissions, it is allowed to bind & escalate the clusterrole of the agents instead
+var clusterRoleRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"policy.open-cluster-management.io"},
+		Resources: []string{
+			"configurationpolicies", "configurationpolicies/finalizers", "configurationpolicies/status", "policies",
+			"policies/finalizers", "policies/status",
+		},
+		Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	addonoperator.NewAgentClusterRoleEscalationRule(PolicyController),
+}, addonoperator.NewAgentClusterRoleRules(PolicyController)...)
+
 type AddonPolicyCtrl struct{}
 
+func init() {
+	registry.MustRegister(AddonPolicyCtrl{}, registry.Metadata{
+		DisplayName: "Policy Controller",
+		Description: "Distributes configured policies and monitors Kubernetes-based policies.",
+		CRDName:     registry.KlusterletAddonConfigCRDName,
+		ImageKeys: []string{
+			"config_policy_controller",
+			"governance_policy_spec_sync",
+			"governance_policy_status_sync",
+			"governance_policy_template_sync",
+			"klusterlet_addon_lease_controller",
+		},
+	})
+}
+
+// GetClusterRoleRules returns the rules the klusterlet addon operator needs to install & run policy-controller
+func (addon AddonPolicyCtrl) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return clusterRoleRules
+}
+
 func (addon AddonPolicyCtrl) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
 	return instance.Spec.PolicyController.Enabled
 }
@@ -43,6 +80,11 @@ func (addon AddonPolicyCtrl) GetAddonName() string {
 	return PolicyCtrl
 }
 
+// GetDependencies returns the addons which must be available before policy-controller is installed
+func (addon AddonPolicyCtrl) GetDependencies() []string {
+	return []string{workmgr.WorkMgr}
+}
+
 func (addon AddonPolicyCtrl) NewAddonCR(
 	instance *agentv1.KlusterletAddonConfig,
 	namespace string,
@@ -72,6 +114,8 @@ func newPolicyControllerCR(
 		ImagePullSecret: instance.Spec.ImagePullSecret,
 		ImageOverrides:  make(map[string]string, 1),
 	}
+	gv.SetAgentValues(&instance.Spec.PolicyController.KlusterletAddonAgentSpec)
+	gv.SetProxyValues(instance.Spec.ProxyConfig)
 
 	imageRepository, err := instance.GetImage("config_policy_controller")
 	if err != nil {
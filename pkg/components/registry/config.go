@@ -0,0 +1,188 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/bindata"
+	templateaddon "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/templateaddon/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ConfigMapName is the name of the configmap in the namespace of the controller which configures the addons
+	ConfigMapName = "klusterlet-addon-registry"
+	// configKey is the key of the configuration in the configmap
+	configKey = "addons.yaml"
+)
+
+var log = logf.Log.WithName("registry")
+
+// Config is the configuration of the addons in the registry configmap
+type Config struct {
+	Addons []AddonConfig `json:"addons,omitempty"`
+}
+
+// AddonConfig overrides the metadata of a registered addon, empty fields keep the registered values.
+// An addon which is not registered is added as a template addon if it has a template
+type AddonConfig struct {
+	// Name is the addon name, e.g. search
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	CRDName     string `json:"crdName,omitempty"`
+	// RequiresHubKubeconfig overrides whether a hub kubeconfig is generated for the addon
+	RequiresHubKubeconfig *bool `json:"requiresHubKubeconfig,omitempty"`
+	// ImageKeys are the image manifest keys a template addon resolves for its template, the images of the
+	// built-in addons are fixed so it is ignored for them
+	ImageKeys []string `json:"imageKeys,omitempty"`
+
+	// ManagedClusterAddOnName is the ManagedClusterAddOn name of a template addon, it defaults to Name
+	ManagedClusterAddOnName string `json:"managedClusterAddOnName,omitempty"`
+	// Template is the go template of the manifests of a template addon
+	Template string `json:"template,omitempty"`
+	// TemplateAsset is the bindata asset of the template of a template addon, it is used if Template is empty
+	TemplateAsset string `json:"templateAsset,omitempty"`
+	// Dependencies are the names of the addons a template addon depends on
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// hubKubeconfigAddon is an addon whose need of a hub kubeconfig is set by the configuration
+type hubKubeconfigAddon struct {
+	KlusterletAddon
+	requiresHubKubeconfig bool
+}
+
+func (addon hubKubeconfigAddon) CheckHubKubeconfigRequired() bool {
+	return addon.requiresHubKubeconfig
+}
+
+// LoadConfig applies the registry configmap of the given namespace to the default registry,
+// nothing is changed if there is no configmap
+func LoadConfig(c client.Client, namespace string) error {
+	config, err := getConfig(c, namespace)
+	if err != nil || config == nil {
+		return err
+	}
+	defaultRegistry.ApplyConfig(config)
+	return nil
+}
+
+// getConfig returns the configuration in the given namespace, or nil if there is no configmap
+func getConfig(c client.Client, namespace string) (*Config, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: ConfigMapName, Namespace: namespace}, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	config := &Config{}
+	if err := yaml.Unmarshal([]byte(cm.Data[configKey]), config); err != nil {
+		return nil, fmt.Errorf("invalid %s in configmap %s/%s: %w", configKey, namespace, ConfigMapName, err)
+	}
+	return config, nil
+}
+
+// ApplyConfig overrides the metadata of the registered addons with the configuration, and registers the
+// configured addons which have a template. The configuration of other addons is ignored
+func (r *Registry) ApplyConfig(config *Config) {
+	configured := make(map[string]bool, len(config.Addons))
+	for _, addonConfig := range config.Addons {
+		configured[addonConfig.Name] = true
+	}
+	r.Lock()
+	r.configured = configured
+	r.Unlock()
+
+	for _, addonConfig := range config.Addons {
+		if _, ok := r.Get(addonConfig.Name); ok {
+			r.overrideMetadata(addonConfig)
+			log.Info("Applied the configuration of addon", "addon", addonConfig.Name)
+			continue
+		}
+		if addonConfig.Template == "" && addonConfig.TemplateAsset == "" {
+			log.Info("Ignoring the configuration of an addon which is not registered", "addon", addonConfig.Name)
+			continue
+		}
+		if err := r.registerTemplateAddon(addonConfig); err != nil {
+			log.Error(err, "Failed to register template addon", "addon", addonConfig.Name)
+			continue
+		}
+		log.Info("Registered template addon", "addon", addonConfig.Name)
+	}
+}
+
+// overrideMetadata overrides the metadata of a registered addon with the non empty fields of its configuration
+func (r *Registry) overrideMetadata(addonConfig AddonConfig) {
+	r.Lock()
+	defer r.Unlock()
+	registration := r.registrations[addonConfig.Name]
+	if addonConfig.DisplayName != "" {
+		registration.DisplayName = addonConfig.DisplayName
+	}
+	if addonConfig.Description != "" {
+		registration.Description = addonConfig.Description
+	}
+	if addonConfig.CRDName != "" {
+		registration.CRDName = addonConfig.CRDName
+	}
+	if len(addonConfig.ImageKeys) > 0 {
+		log.Info("Ignoring the image keys of a built-in addon, only template addons resolve configured image keys",
+			"addon", addonConfig.Name)
+	}
+	if addonConfig.RequiresHubKubeconfig != nil {
+		addon := registration.Addon
+		if overridden, ok := addon.(hubKubeconfigAddon); ok {
+			addon = overridden.KlusterletAddon
+		}
+		registration.Addon = hubKubeconfigAddon{
+			KlusterletAddon:       addon,
+			requiresHubKubeconfig: *addonConfig.RequiresHubKubeconfig,
+		}
+	}
+}
+
+// registerTemplateAddon registers an addon rendering the template of its configuration
+func (r *Registry) registerTemplateAddon(addonConfig AddonConfig) error {
+	template := addonConfig.Template
+	if template == "" {
+		data, err := bindata.Asset(addonConfig.TemplateAsset)
+		if err != nil {
+			return err
+		}
+		template = string(data)
+	}
+	addon, err := templateaddon.NewTemplateAddon(
+		addonConfig.Name,
+		addonConfig.ManagedClusterAddOnName,
+		addonConfig.RequiresHubKubeconfig != nil && *addonConfig.RequiresHubKubeconfig,
+		addonConfig.ImageKeys,
+		addonConfig.Dependencies,
+		template,
+	)
+	if err != nil {
+		return err
+	}
+	metadata := Metadata{
+		DisplayName: addonConfig.DisplayName,
+		Description: addonConfig.Description,
+		CRDName:     addonConfig.CRDName,
+		ImageKeys:   addonConfig.ImageKeys,
+	}
+	if metadata.DisplayName == "" {
+		metadata.DisplayName = addonConfig.Name
+	}
+	if metadata.CRDName == "" {
+		metadata.CRDName = KlusterletAddonConfigCRDName
+	}
+	return r.Register(addon, metadata)
+}
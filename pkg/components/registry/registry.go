@@ -0,0 +1,273 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Package registry keeps the klusterlet addons supported by the controller. Each addon package registers its addon
+// with its metadata in an init function, and the metadata can be overridden from a configmap at startup
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// KlusterletAddonConfigCRDName is the name of the CRD configuring the addons
+const KlusterletAddonConfigCRDName = "klusterletaddonconfigs.agent.open-cluster-management.io"
+
+// TemplateAddonLabel is the label set to the name of a template addon on its ManifestWorks & ManagedClusterAddOns,
+// only the labeled objects are deleted when the template addon is removed from the configuration
+const TemplateAddonLabel = "agent.open-cluster-management.io/template-addon"
+
+type KlusterletAddon interface {
+	// GetAddonName retuns the addon name
+	GetAddonName() string
+	// RequiresHubKubeconfig returns true if this addon need to genrate a kubeconfig on hubside
+	CheckHubKubeconfigRequired() bool
+	// IsEnabled checks whether the addon is enabled in the klusterletaddonconfig
+	IsEnabled(instance *agentv1.KlusterletAddonConfig) bool
+	// NewAddonCR returns a CR of the addon by using the given klusterletaddonconfig & managedcluster's namespace
+	NewAddonCR(instance *agentv1.KlusterletAddonConfig, namespace string) (runtime.Object, error)
+	// GetManagedClusterAddOnName returns the ManagedClusterAddOn name that matches this addon
+	GetManagedClusterAddOnName() string
+	// GetClusterRoleRules returns the rules the klusterlet addon operator needs on the managed cluster
+	// to install & run this addon
+	GetClusterRoleRules() []rbacv1.PolicyRule
+	// GetDependencies returns the names of the addons which must be available before this addon is installed
+	GetDependencies() []string
+}
+
+// ManifestsAddon is implemented by the addons deployed by several manifests instead of a single CR
+type ManifestsAddon interface {
+	// NewAddonManifests returns the manifests of the addon by using the given klusterletaddonconfig
+	// & managedcluster's namespace
+	NewAddonManifests(instance *agentv1.KlusterletAddonConfig, namespace string) ([]runtime.Object, error)
+}
+
+// Metadata describes an addon on the hub, in its ClusterManagementAddOn and ManagedClusterAddOns
+type Metadata struct {
+	DisplayName string
+	Description string
+	// CRDName is the name of the CRD configuring the addon
+	CRDName string
+	// ImageKeys are the keys of the image manifest used by the addon, they can only be configured for template addons
+	ImageKeys []string
+}
+
+// Registration is an addon and its metadata
+type Registration struct {
+	Addon KlusterletAddon
+	// ManagedClusterAddOnName is the ManagedClusterAddOn name of the addon when it was registered
+	ManagedClusterAddOnName string
+	Metadata
+}
+
+// Registry is a set of addons, the addon names and ManagedClusterAddOn names are unique in a registry
+type Registry struct {
+	sync.RWMutex
+	// registrations are keyed by addon name
+	registrations map[string]*Registration
+	// managedClusterAddOnNames maps the ManagedClusterAddOn names to the addon names
+	managedClusterAddOnNames map[string]string
+	// configured are the addon names of the applied configuration, nil until a configuration is applied
+	configured map[string]bool
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		registrations:            map[string]*Registration{},
+		managedClusterAddOnNames: map[string]string{},
+	}
+}
+
+// defaultRegistry is the registry the addon packages register into
+var defaultRegistry = NewRegistry()
+
+// Register adds an addon to the registry, it returns an error if the name or the ManagedClusterAddOn name
+// of the addon is already registered
+func (r *Registry) Register(addon KlusterletAddon, metadata Metadata) error {
+	r.Lock()
+	defer r.Unlock()
+	name := addon.GetAddonName()
+	if _, ok := r.registrations[name]; ok {
+		return fmt.Errorf("addon %s is already registered", name)
+	}
+	managedClusterAddOnName := addon.GetManagedClusterAddOnName()
+	if other, ok := r.managedClusterAddOnNames[managedClusterAddOnName]; ok {
+		return fmt.Errorf("ManagedClusterAddOn name %s of addon %s is already used by addon %s",
+			managedClusterAddOnName, name, other)
+	}
+	r.registrations[name] = &Registration{
+		Addon:                   addon,
+		ManagedClusterAddOnName: managedClusterAddOnName,
+		Metadata:                metadata,
+	}
+	r.managedClusterAddOnNames[managedClusterAddOnName] = name
+	return nil
+}
+
+// Registrations returns all registered addons ordered by addon name
+func (r *Registry) Registrations() []Registration {
+	r.RLock()
+	defer r.RUnlock()
+	registrations := make([]Registration, 0, len(r.registrations))
+	for _, registration := range r.registrations {
+		registrations = append(registrations, *registration)
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].Addon.GetAddonName() < registrations[j].Addon.GetAddonName()
+	})
+	return registrations
+}
+
+// Get returns the registration of the addon with the given name
+func (r *Registry) Get(name string) (Registration, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	if registration, ok := r.registrations[name]; ok {
+		return *registration, true
+	}
+	return Registration{}, false
+}
+
+// GetByManagedClusterAddOnName returns the registration of the addon with the given ManagedClusterAddOn name
+func (r *Registry) GetByManagedClusterAddOnName(name string) (Registration, bool) {
+	r.RLock()
+	addonName, ok := r.managedClusterAddOnNames[name]
+	r.RUnlock()
+	if !ok {
+		return Registration{}, false
+	}
+	return r.Get(addonName)
+}
+
+// Removed returns true if the addon is not registered and is not in the applied configuration, e.g. a template
+// addon removed from the registry configmap. It returns false if no configuration was applied, so that the objects
+// of the template addons are kept when the configmap is missing, and for an addon which is configured but could not
+// be registered
+func (r *Registry) Removed(name string) bool {
+	r.RLock()
+	defer r.RUnlock()
+	if r.configured == nil {
+		return false
+	}
+	if _, ok := r.registrations[name]; ok {
+		return false
+	}
+	return !r.configured[name]
+}
+
+// AddonsInDependencyOrder returns all registered addons ordered so that each addon comes after its
+// dependencies, addons with no dependency between them are ordered by addon name. The addons which
+// depend on an unknown addon or are part of a dependency cycle are returned last
+func (r *Registry) AddonsInDependencyOrder() []KlusterletAddon {
+	registrations := r.Registrations()
+	ordered := make([]KlusterletAddon, 0, len(registrations))
+	added := make(map[string]bool, len(registrations))
+	for len(ordered) < len(registrations) {
+		progress := false
+		for _, registration := range registrations {
+			name := registration.Addon.GetAddonName()
+			if added[name] || !dependenciesAdded(registration.Addon, added) {
+				continue
+			}
+			ordered = append(ordered, registration.Addon)
+			added[name] = true
+			progress = true
+			// restart from the first addon so that addons are ordered by name when possible
+			break
+		}
+		if !progress {
+			break
+		}
+	}
+	for _, registration := range registrations {
+		if !added[registration.Addon.GetAddonName()] {
+			ordered = append(ordered, registration.Addon)
+		}
+	}
+	return ordered
+}
+
+func dependenciesAdded(addon KlusterletAddon, added map[string]bool) bool {
+	for _, dependency := range addon.GetDependencies() {
+		if !added[dependency] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateDependencies returns an error if an addon depends on an addon which is not registered
+// or if the dependencies of the addons have a cycle
+func (r *Registry) ValidateDependencies() error {
+	ordered := r.AddonsInDependencyOrder()
+	added := make(map[string]bool, len(ordered))
+	for _, addon := range ordered {
+		for _, dependency := range addon.GetDependencies() {
+			if _, ok := r.Get(dependency); !ok {
+				return fmt.Errorf("addon %s depends on addon %s which is not registered", addon.GetAddonName(), dependency)
+			}
+		}
+		if !dependenciesAdded(addon, added) {
+			return fmt.Errorf("the dependencies of addon %s have a cycle", addon.GetAddonName())
+		}
+		added[addon.GetAddonName()] = true
+	}
+	return nil
+}
+
+// MustRegister adds an addon to the default registry, it panics if the addon cannot be registered.
+// It is meant to be called in the init function of the addon package
+func MustRegister(addon KlusterletAddon, metadata Metadata) {
+	if err := defaultRegistry.Register(addon, metadata); err != nil {
+		panic(err)
+	}
+}
+
+// Registrations returns all addons of the default registry ordered by addon name
+func Registrations() []Registration {
+	return defaultRegistry.Registrations()
+}
+
+// Addons returns all addons of the default registry ordered by addon name
+func Addons() []KlusterletAddon {
+	registrations := defaultRegistry.Registrations()
+	addons := make([]KlusterletAddon, 0, len(registrations))
+	for _, registration := range registrations {
+		addons = append(addons, registration.Addon)
+	}
+	return addons
+}
+
+// AddonsInDependencyOrder returns all addons of the default registry ordered so that each addon comes after
+// its dependencies
+func AddonsInDependencyOrder() []KlusterletAddon {
+	return defaultRegistry.AddonsInDependencyOrder()
+}
+
+// ValidateDependencies checks the dependencies of the addons of the default registry
+func ValidateDependencies() error {
+	return defaultRegistry.ValidateDependencies()
+}
+
+// Get returns the registration of the addon with the given name in the default registry
+func Get(name string) (Registration, bool) {
+	return defaultRegistry.Get(name)
+}
+
+// Removed returns true if the addon is not registered and is not in the configuration applied to the
+// default registry
+func Removed(name string) bool {
+	return defaultRegistry.Removed(name)
+}
+
+// GetByManagedClusterAddOnName returns the registration of the addon with the given ManagedClusterAddOn name
+// in the default registry
+func GetByManagedClusterAddOnName(name string) (Registration, bool) {
+	return defaultRegistry.GetByManagedClusterAddOnName(name)
+}
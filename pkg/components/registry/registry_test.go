@@ -0,0 +1,324 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package registry
+
+import (
+	"reflect"
+	"testing"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testAddon struct {
+	name                    string
+	managedClusterAddOnName string
+	requiresHubKubeconfig   bool
+	dependencies            []string
+}
+
+func (addon testAddon) GetAddonName() string               { return addon.name }
+func (addon testAddon) CheckHubKubeconfigRequired() bool   { return addon.requiresHubKubeconfig }
+func (addon testAddon) GetManagedClusterAddOnName() string { return addon.managedClusterAddOnName }
+func (addon testAddon) GetDependencies() []string          { return addon.dependencies }
+func (addon testAddon) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return nil
+}
+func (addon testAddon) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
+	return true
+}
+func (addon testAddon) NewAddonCR(instance *agentv1.KlusterletAddonConfig, namespace string) (runtime.Object, error) {
+	return nil, nil
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(testAddon{name: "workmgr", managedClusterAddOnName: "work-manager"},
+		Metadata{DisplayName: "Work Manager"}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := r.Register(testAddon{name: "appmgr", managedClusterAddOnName: "application-manager"},
+		Metadata{DisplayName: "Application Manager"}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		addon   testAddon
+		wantErr bool
+	}{
+		{
+			name:    "duplicated addon name",
+			addon:   testAddon{name: "workmgr", managedClusterAddOnName: "other-work-manager"},
+			wantErr: true,
+		},
+		{
+			name:    "duplicated managedclusteraddon name",
+			addon:   testAddon{name: "otherworkmgr", managedClusterAddOnName: "work-manager"},
+			wantErr: true,
+		},
+		{
+			name:    "new addon",
+			addon:   testAddon{name: "search", managedClusterAddOnName: "search-collector"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.Register(tt.addon, Metadata{}); (err != nil) != tt.wantErr {
+				t.Errorf("Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var names []string
+	for _, registration := range r.Registrations() {
+		names = append(names, registration.Addon.GetAddonName())
+	}
+	if want := []string{"appmgr", "search", "workmgr"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Registrations() = %v, want %v", names, want)
+	}
+
+	registration, ok := r.GetByManagedClusterAddOnName("work-manager")
+	if !ok || registration.DisplayName != "Work Manager" {
+		t.Errorf("GetByManagedClusterAddOnName() = %v, %v, want Work Manager", registration, ok)
+	}
+	if _, ok := r.Get("unknown"); ok {
+		t.Errorf("Get() found an addon which is not registered")
+	}
+}
+
+func TestRegistry_AddonsInDependencyOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		addons    []testAddon
+		wantOrder []string
+		wantErr   string
+	}{
+		{
+			name: "dependencies first",
+			addons: []testAddon{
+				{name: "workmgr"},
+				{name: "appmgr", dependencies: []string{"workmgr"}},
+				{name: "policyctrl", dependencies: []string{"workmgr"}},
+				{name: "certpolicyctrl", dependencies: []string{"policyctrl"}},
+				{name: "iampolicyctrl", dependencies: []string{"policyctrl", "certpolicyctrl"}},
+			},
+			wantOrder: []string{"workmgr", "appmgr", "policyctrl", "certpolicyctrl", "iampolicyctrl"},
+		},
+		{
+			name: "unknown dependency",
+			addons: []testAddon{
+				{name: "appmgr", dependencies: []string{"workmgr"}},
+				{name: "search"},
+			},
+			wantOrder: []string{"search", "appmgr"},
+			wantErr:   "addon appmgr depends on addon workmgr which is not registered",
+		},
+		{
+			name: "dependency cycle",
+			addons: []testAddon{
+				{name: "a", dependencies: []string{"b"}},
+				{name: "b", dependencies: []string{"a"}},
+				{name: "c"},
+			},
+			wantOrder: []string{"c", "a", "b"},
+			wantErr:   "the dependencies of addon a have a cycle",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+			for _, addon := range tt.addons {
+				addon.managedClusterAddOnName = addon.name
+				if err := r.Register(addon, Metadata{}); err != nil {
+					t.Fatalf("Register() error = %v", err)
+				}
+			}
+			var order []string
+			for _, addon := range r.AddonsInDependencyOrder() {
+				order = append(order, addon.GetAddonName())
+			}
+			if !reflect.DeepEqual(order, tt.wantOrder) {
+				t.Errorf("AddonsInDependencyOrder() = %v, want %v", order, tt.wantOrder)
+			}
+			err := r.ValidateDependencies()
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("ValidateDependencies() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ValidateDependencies() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_ApplyConfig(t *testing.T) {
+	requiresHubKubeconfig := true
+	r := NewRegistry()
+	if err := r.Register(testAddon{name: "workmgr", managedClusterAddOnName: "work-manager"}, Metadata{
+		DisplayName: "Work Manager",
+		Description: "Handles endpoint work requests and managed cluster status.",
+		CRDName:     KlusterletAddonConfigCRDName,
+		ImageKeys:   []string{"multicloud_manager"},
+	}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	r.ApplyConfig(&Config{
+		Addons: []AddonConfig{
+			{
+				Name:                  "workmgr",
+				DisplayName:           "Work Agent",
+				RequiresHubKubeconfig: &requiresHubKubeconfig,
+				ImageKeys:             []string{"work_agent"},
+			},
+			{
+				Name:        "unknown",
+				DisplayName: "Unknown",
+			},
+			{
+				Name:                    "my-agent",
+				ManagedClusterAddOnName: "my-agent-addon",
+				RequiresHubKubeconfig:   &requiresHubKubeconfig,
+				Template:                "kind: ConfigMap",
+			},
+			{
+				Name:         "my-other-agent",
+				Template:     "kind: ConfigMap",
+				Dependencies: []string{"my-agent"},
+			},
+			{
+				Name:          "missing-asset",
+				TemplateAsset: "resources/addons/missing-asset.yaml",
+			},
+		},
+	})
+
+	// the image keys of a built-in addon are not overridden
+	registration, _ := r.Get("workmgr")
+	wantMetadata := Metadata{
+		DisplayName: "Work Agent",
+		Description: "Handles endpoint work requests and managed cluster status.",
+		CRDName:     KlusterletAddonConfigCRDName,
+		ImageKeys:   []string{"multicloud_manager"},
+	}
+	if !reflect.DeepEqual(registration.Metadata, wantMetadata) {
+		t.Errorf("ApplyConfig() metadata = %v, want %v", registration.Metadata, wantMetadata)
+	}
+	if !registration.Addon.CheckHubKubeconfigRequired() {
+		t.Errorf("ApplyConfig() addon does not require a hub kubeconfig")
+	}
+	if registration.Addon.GetManagedClusterAddOnName() != "work-manager" {
+		t.Errorf("ApplyConfig() changed the ManagedClusterAddOn name to %s", registration.Addon.GetManagedClusterAddOnName())
+	}
+	if _, ok := r.Get("unknown"); ok {
+		t.Errorf("ApplyConfig() registered an unknown addon")
+	}
+	if _, ok := r.Get("missing-asset"); ok {
+		t.Errorf("ApplyConfig() registered a template addon without template")
+	}
+
+	registration, ok := r.GetByManagedClusterAddOnName("my-agent-addon")
+	if !ok {
+		t.Fatalf("ApplyConfig() did not register the template addon")
+	}
+	if _, ok := registration.Addon.(ManifestsAddon); !ok {
+		t.Errorf("ApplyConfig() registered %T, want a ManifestsAddon", registration.Addon)
+	}
+	if !registration.Addon.CheckHubKubeconfigRequired() {
+		t.Errorf("ApplyConfig() template addon does not require a hub kubeconfig")
+	}
+	wantMetadata = Metadata{DisplayName: "my-agent", CRDName: KlusterletAddonConfigCRDName}
+	if registration, _ := r.Get("my-other-agent"); !reflect.DeepEqual(registration.Addon.GetDependencies(), []string{"my-agent"}) {
+		t.Errorf("ApplyConfig() template addon dependencies = %v, want [my-agent]", registration.Addon.GetDependencies())
+	}
+	if !reflect.DeepEqual(registration.Metadata, wantMetadata) {
+		t.Errorf("ApplyConfig() template addon metadata = %v, want %v", registration.Metadata, wantMetadata)
+	}
+}
+
+func TestRegistry_Removed(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(testAddon{name: "workmgr", managedClusterAddOnName: "work-manager"}, Metadata{}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if r.Removed("my-agent") {
+		t.Errorf("Removed() = true before a configuration is applied")
+	}
+
+	r.ApplyConfig(&Config{
+		Addons: []AddonConfig{
+			{
+				Name:          "missing-asset",
+				TemplateAsset: "resources/addons/missing-asset.yaml",
+			},
+		},
+	})
+	tests := map[string]bool{
+		"workmgr":       false,
+		"missing-asset": false,
+		"my-agent":      true,
+	}
+	for name, want := range tests {
+		if got := r.Removed(name); got != want {
+			t.Errorf("Removed(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func Test_getConfig(t *testing.T) {
+	newConfigMap := func(data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: "open-cluster-management"},
+			Data:       map[string]string{configKey: data},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		objs    []runtime.Object
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "no configmap",
+		},
+		{
+			name: "valid configuration",
+			objs: []runtime.Object{newConfigMap(`
+addons:
+- name: search
+  displayName: Search
+  imageKeys:
+  - search_collector
+`)},
+			want: &Config{Addons: []AddonConfig{
+				{Name: "search", DisplayName: "Search", ImageKeys: []string{"search_collector"}},
+			}},
+		},
+		{
+			name:    "invalid configuration",
+			objs:    []runtime.Object{newConfigMap("addons: {")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, tt.objs...)
+			got, err := getConfig(c, "open-cluster-management")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
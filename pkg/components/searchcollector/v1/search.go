@@ -11,11 +11,15 @@ package v1
 import (
 	"os"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	workmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/workmgr/v1"
 )
 
 // constants for search collector
@@ -29,8 +33,34 @@ const (
 
 var log = logf.Log.WithName("search")
 
+// search-collector indexes all resources of the managed cluster
+var clusterRoleRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+}, addonoperator.NewAgentClusterRoleRules(SearchCollector)...)
+
 type AddonSearch struct{}
 
+func init() {
+	registry.MustRegister(AddonSearch{}, registry.Metadata{
+		DisplayName: "Search Collector",
+		Description: "Collects cluster data to be indexed by search components on the hub cluster.",
+		CRDName:     registry.KlusterletAddonConfigCRDName,
+		ImageKeys: []string{
+			"search_collector",
+			"klusterlet_addon_lease_controller",
+		},
+	})
+}
+
+// GetClusterRoleRules returns the rules the klusterlet addon operator needs to install & run search-collector
+func (addon AddonSearch) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return clusterRoleRules
+}
+
 func (addon AddonSearch) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
 	return instance.Spec.SearchCollectorConfig.Enabled
 }
@@ -43,6 +73,11 @@ func (addon AddonSearch) GetAddonName() string {
 	return Search
 }
 
+// GetDependencies returns the addons which must be available before search-collector is installed
+func (addon AddonSearch) GetDependencies() []string {
+	return []string{workmgr.WorkMgr}
+}
+
 func (addon AddonSearch) NewAddonCR(instance *agentv1.KlusterletAddonConfig, namespace string) (runtime.Object, error) {
 	return newSearchCollectorCR(instance, namespace)
 }
@@ -66,6 +101,8 @@ func newSearchCollectorCR(instance *agentv1.KlusterletAddonConfig, namespace str
 		ImagePullSecret: instance.Spec.ImagePullSecret,
 		ImageOverrides:  make(map[string]string, 1),
 	}
+	gv.SetAgentValues(&instance.Spec.SearchCollectorConfig.KlusterletAddonAgentSpec)
+	gv.SetProxyValues(instance.Spec.ProxyConfig)
 
 	imageRepository, err := instance.GetImage("search_collector")
 	if err != nil {
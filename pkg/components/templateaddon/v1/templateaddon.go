@@ -0,0 +1,154 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-cluster-management/library-go/pkg/templateprocessor"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+)
+
+// TemplateAddon is an addon whose manifests are rendered from a go template, so agents can be delivered by
+// the controller without a dedicated addon package
+type TemplateAddon struct {
+	name                    string
+	managedClusterAddOnName string
+	requiresHubKubeconfig   bool
+	imageKeys               []string
+	dependencies            []string
+	template                string
+}
+
+// TemplateValues are the values the template of an addon is rendered with
+type TemplateValues struct {
+	KlusterletAddonConfig *agentv1.KlusterletAddonConfig
+	ClusterName           string
+	AddonName             string
+	// ManagedClusterAddOnName is also the name of the lease the agent should renew
+	ManagedClusterAddOnName string
+	// InstallNamespace is the namespace of the addons on the managed cluster
+	InstallNamespace string
+	// HubKubeconfigSecret is the secret of the hub kubeconfig if the addon requires one
+	HubKubeconfigSecret string
+	// Images are the images of the image keys of the addon
+	Images map[string]string
+	// Values are the values of the addon in the KlusterletAddonConfig
+	Values map[string]string
+}
+
+// NewTemplateAddon returns an addon rendering the template, the images of the imageKeys are resolved from
+// the KlusterletAddonConfig & the image manifest
+func NewTemplateAddon(
+	name string,
+	managedClusterAddOnName string,
+	requiresHubKubeconfig bool,
+	imageKeys []string,
+	dependencies []string,
+	template string,
+) (TemplateAddon, error) {
+	if name == "" {
+		return TemplateAddon{}, fmt.Errorf("the name of a template addon is required")
+	}
+	if strings.TrimSpace(template) == "" {
+		return TemplateAddon{}, fmt.Errorf("the template of addon %s is empty", name)
+	}
+	if managedClusterAddOnName == "" {
+		managedClusterAddOnName = name
+	}
+	return TemplateAddon{
+		name:                    name,
+		managedClusterAddOnName: managedClusterAddOnName,
+		requiresHubKubeconfig:   requiresHubKubeconfig,
+		imageKeys:               imageKeys,
+		dependencies:            dependencies,
+		template:                template,
+	}, nil
+}
+
+// GetClusterRoleRules returns no rule, the manifests of a template addon are applied by the work agent
+// and not by the klusterlet addon operator
+func (addon TemplateAddon) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return nil
+}
+
+// IsEnabled checks whether the addon is enabled in the customAddons of the klusterletaddonconfig
+func (addon TemplateAddon) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
+	return instance.Spec.CustomAddons[addon.name].Enabled
+}
+
+func (addon TemplateAddon) CheckHubKubeconfigRequired() bool {
+	return addon.requiresHubKubeconfig
+}
+
+func (addon TemplateAddon) GetAddonName() string {
+	return addon.name
+}
+
+func (addon TemplateAddon) GetManagedClusterAddOnName() string {
+	return addon.managedClusterAddOnName
+}
+
+func (addon TemplateAddon) GetDependencies() []string {
+	return addon.dependencies
+}
+
+// NewAddonCR returns an error, a template addon is not deployed by a CR but by the manifests of NewAddonManifests
+func (addon TemplateAddon) NewAddonCR(instance *agentv1.KlusterletAddonConfig, namespace string) (runtime.Object, error) {
+	return nil, fmt.Errorf("addon %s is rendered from a template and has no CR", addon.name)
+}
+
+// NewAddonManifests renders the template of the addon with the given klusterletaddonconfig & namespace
+func (addon TemplateAddon) NewAddonManifests(
+	instance *agentv1.KlusterletAddonConfig,
+	namespace string,
+) ([]runtime.Object, error) {
+	values := TemplateValues{
+		KlusterletAddonConfig:   instance,
+		ClusterName:             instance.Spec.ClusterName,
+		AddonName:               addon.name,
+		ManagedClusterAddOnName: addon.managedClusterAddOnName,
+		InstallNamespace:        namespace,
+		Images:                  make(map[string]string, len(addon.imageKeys)),
+		Values:                  instance.Spec.CustomAddons[addon.name].Values,
+	}
+	if addon.requiresHubKubeconfig {
+		values.HubKubeconfigSecret = addon.managedClusterAddOnName + "-hub-kubeconfig"
+	}
+	for _, key := range addon.imageKeys {
+		image, err := instance.GetImage(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image %s of addon %s: %w", key, addon.name, err)
+		}
+		values.Images[key] = image
+	}
+
+	reader := templateprocessor.NewYamlStringReader(addon.template, templateprocessor.KubernetesYamlsDelimiter)
+	tp, err := templateprocessor.NewTemplateProcessor(reader, &templateprocessor.Options{})
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := tp.TemplateBytes([]byte(addon.template), values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the template of addon %s: %w", addon.name, err)
+	}
+
+	var manifests []runtime.Object
+	for _, yaml := range templateprocessor.NewYamlStringReader(
+		string(rendered), templateprocessor.KubernetesYamlsDelimiter).Yamls {
+		manifest, err := tp.BytesToUnstructured([]byte(yaml))
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest in the template of addon %s: %w", addon.name, err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("the template of addon %s renders no manifest", addon.name)
+	}
+	return manifests, nil
+}
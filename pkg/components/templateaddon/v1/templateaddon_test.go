@@ -0,0 +1,176 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package v1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+)
+
+const testTemplate = `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: {{ .AddonName }}
+  namespace: {{ .InstallNamespace }}
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .AddonName }}
+  namespace: {{ .InstallNamespace }}
+spec:
+  template:
+    spec:
+      serviceAccountName: {{ .AddonName }}
+      containers:
+      - name: agent
+        image: {{ index .Images "my_agent" }}
+        args:
+        - --cluster-name={{ .ClusterName }}
+        - --hub-kubeconfig-secret={{ .HubKubeconfigSecret }}
+        - --log-level={{ index .Values "logLevel" | default "info" }}
+`
+
+func newTestKlusterletAddonConfig(values map[string]string) *agentv1.KlusterletAddonConfig {
+	return &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: "cluster1",
+		},
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			ClusterName:      "cluster1",
+			ClusterNamespace: "cluster1",
+			ImageOverrides:   map[string]string{"my_agent": "quay.io/example/my-agent:1.0"},
+			CustomAddons: map[string]agentv1.KlusterletAddonConfigCustomAddonSpec{
+				"my-agent": {Enabled: true, Values: values},
+			},
+		},
+	}
+}
+
+func TestNewTemplateAddon(t *testing.T) {
+	addon, err := NewTemplateAddon("my-agent", "", true, nil, nil, testTemplate)
+	if err != nil {
+		t.Fatalf("NewTemplateAddon() error = %v", err)
+	}
+	if addon.GetManagedClusterAddOnName() != "my-agent" {
+		t.Errorf("GetManagedClusterAddOnName() = %s, want my-agent", addon.GetManagedClusterAddOnName())
+	}
+	if _, err := NewTemplateAddon("", "", false, nil, nil, testTemplate); err == nil {
+		t.Errorf("NewTemplateAddon() without name should fail")
+	}
+	if _, err := NewTemplateAddon("my-agent", "", false, nil, nil, " \n"); err == nil {
+		t.Errorf("NewTemplateAddon() without template should fail")
+	}
+}
+
+func TestTemplateAddon_IsEnabled(t *testing.T) {
+	addon, _ := NewTemplateAddon("my-agent", "", false, nil, nil, testTemplate)
+	if !addon.IsEnabled(newTestKlusterletAddonConfig(nil)) {
+		t.Errorf("IsEnabled() = false, want true")
+	}
+	if addon.IsEnabled(&agentv1.KlusterletAddonConfig{}) {
+		t.Errorf("IsEnabled() = true without customAddons, want false")
+	}
+}
+
+func TestTemplateAddon_NewAddonManifests(t *testing.T) {
+	tests := []struct {
+		name                  string
+		template              string
+		imageKeys             []string
+		requiresHubKubeconfig bool
+		values                map[string]string
+		wantKinds             []string
+		wantArgs              []interface{}
+		wantErr               string
+	}{
+		{
+			name:                  "rendered with images & values",
+			template:              testTemplate,
+			imageKeys:             []string{"my_agent"},
+			requiresHubKubeconfig: true,
+			values:                map[string]string{"logLevel": "debug"},
+			wantKinds:             []string{"ServiceAccount", "Deployment"},
+			wantArgs: []interface{}{
+				"--cluster-name=cluster1",
+				"--hub-kubeconfig-secret=my-agent-hub-kubeconfig",
+				"--log-level=debug",
+			},
+		},
+		{
+			name:      "default values",
+			template:  testTemplate,
+			imageKeys: []string{"my_agent"},
+			wantKinds: []string{"ServiceAccount", "Deployment"},
+			wantArgs: []interface{}{
+				"--cluster-name=cluster1",
+				"--hub-kubeconfig-secret=",
+				"--log-level=info",
+			},
+		},
+		{
+			name:      "image not found",
+			template:  testTemplate,
+			imageKeys: []string{"other_agent"},
+			wantErr:   "failed to get image other_agent of addon my-agent",
+		},
+		{
+			name:     "invalid template",
+			template: "kind: {{ .Unknown }}",
+			wantErr:  "failed to render the template of addon my-agent",
+		},
+		{
+			name:     "no manifest",
+			template: "{{ if false }}kind: ConfigMap{{ end }}",
+			wantErr:  "the template of addon my-agent renders no manifest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addon, err := NewTemplateAddon("my-agent", "", tt.requiresHubKubeconfig, tt.imageKeys, nil, tt.template)
+			if err != nil {
+				t.Fatalf("NewTemplateAddon() error = %v", err)
+			}
+			manifests, err := addon.NewAddonManifests(newTestKlusterletAddonConfig(tt.values),
+				"open-cluster-management-agent-addon")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NewAddonManifests() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewAddonManifests() error = %v", err)
+			}
+
+			var kinds []string
+			for _, manifest := range manifests {
+				kinds = append(kinds, manifest.(*unstructured.Unstructured).GetKind())
+			}
+			if !reflect.DeepEqual(kinds, tt.wantKinds) {
+				t.Errorf("NewAddonManifests() kinds = %v, want %v", kinds, tt.wantKinds)
+			}
+
+			deployment := manifests[1].(*unstructured.Unstructured)
+			if deployment.GetNamespace() != "open-cluster-management-agent-addon" {
+				t.Errorf("NewAddonManifests() namespace = %s", deployment.GetNamespace())
+			}
+			containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+			container := containers[0].(map[string]interface{})
+			if container["image"] != "quay.io/example/my-agent:1.0" {
+				t.Errorf("NewAddonManifests() image = %v", container["image"])
+			}
+			if !reflect.DeepEqual(container["args"], tt.wantArgs) {
+				t.Errorf("NewAddonManifests() args = %v, want %v", container["args"], tt.wantArgs)
+			}
+		})
+	}
+}
@@ -11,11 +11,14 @@ package v1
 import (
 	"os"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 )
 
 // constants for work manager
@@ -29,8 +32,63 @@ const (
 
 var log = logf.Log.WithName("workmgr")
 
+var clusterRoleRules = append([]rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"nodes", "namespaces", "pods", "services", "endpoints"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"config.openshift.io"},
+		Resources: []string{"clusterversions", "infrastructures", "clusteroperators", "ingresses"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"route.openshift.io"},
+		Resources: []string{"routes"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"cluster.open-cluster-management.io"},
+		Resources: []string{"clusterclaims"},
+		Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{"view.open-cluster-management.io"},
+		Resources: []string{"managedclusterviews", "managedclusterviews/status"},
+		Verbs:     []string{"get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{"action.open-cluster-management.io"},
+		Resources: []string{"managedclusteractions", "managedclusteractions/status"},
+		Verbs:     []string{"get", "list", "patch", "update", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"create", "patch"},
+	},
+}, addonoperator.NewAgentClusterRoleRules(WorkManager)...)
+
 type AddonWorkMgr struct{}
 
+func init() {
+	registry.MustRegister(AddonWorkMgr{}, registry.Metadata{
+		DisplayName: "Work Manager",
+		Description: "Handles endpoint work requests and managed cluster status.",
+		CRDName:     registry.KlusterletAddonConfigCRDName,
+		ImageKeys: []string{
+			"multicloud_manager",
+			"klusterlet_addon_lease_controller",
+		},
+	})
+}
+
+// GetClusterRoleRules returns the rules the klusterlet addon operator needs to install & run work-manager
+func (addon AddonWorkMgr) GetClusterRoleRules() []rbacv1.PolicyRule {
+	return clusterRoleRules
+}
+
 func (addon AddonWorkMgr) IsEnabled(instance *agentv1.KlusterletAddonConfig) bool {
 	return true
 }
@@ -43,6 +101,11 @@ func (addon AddonWorkMgr) GetAddonName() string {
 	return WorkMgr
 }
 
+// GetDependencies returns no addon, work-manager does not depend on other addons
+func (addon AddonWorkMgr) GetDependencies() []string {
+	return nil
+}
+
 func (addon AddonWorkMgr) GetManagedClusterAddOnName() string {
 	if n := os.Getenv(addonNameEnv); len(n) != 0 {
 		return n
@@ -72,6 +135,8 @@ func newWorkManagerCR(
 		ImagePullSecret: instance.Spec.ImagePullSecret,
 		ImageOverrides:  make(map[string]string, 1),
 	}
+	gv.SetAgentValues(instance.Spec.WorkManagerConfig)
+	gv.SetProxyValues(instance.Spec.ProxyConfig)
 
 	imageRepository, err := instance.GetImage("multicloud_manager")
 	if err != nil {
@@ -0,0 +1,556 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package addonrollout
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
+	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
+	"github.com/open-cluster-management/klusterlet-addon-controller/version"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var log = logf.Log.WithName("controller_addonrollout")
+
+const (
+	// types of condition of ManagedClusterAddOn
+	addonAvailable = "Available"
+	addonDegraded  = "Degraded"
+
+	// health of an upgraded cluster
+	clusterAvailable   = "Available"
+	clusterProgressing = "Progressing"
+	clusterDegraded    = "Degraded"
+
+	// reasons of events
+	eventReasonUpgraded = "ClustersUpgraded"
+	eventReasonHalted   = "RolloutHalted"
+
+	// rolloutResyncPeriod is how often a progressing rollout checks the addons of the upgraded clusters
+	rolloutResyncPeriod = 30 * time.Second
+
+	// AddonRolloutFinalizer releases the klusterletaddonconfigs held by a deleted rollout
+	AddonRolloutFinalizer = "agent.open-cluster-management.io/addonrollout-cleanup"
+)
+
+// Add creates a new AddonRollout Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileAddonRollout{
+		client:   mgr.GetClient(),
+		scheme:   mgr.GetScheme(),
+		recorder: mgr.GetEventRecorderFor("addonrollout-controller"),
+	}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("addonrollout-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource AddonRollout
+	err = c.Watch(&source.Kind{Type: &agentv1.AddonRollout{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to ManagedClusterAddOns, the rollouts advance when the addons of their clusters are available
+	err = c.Watch(
+		&source.Kind{Type: &addonv1alpha1.ManagedClusterAddOn{}},
+		&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(
+			func(obj handler.MapObject) []reconcile.Request {
+				return getRolloutsSelectingCluster(mgr.GetClient(), obj.Meta.GetNamespace())
+			},
+		)},
+		addons.NewAddonNamePredicate(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getRolloutsSelectingCluster returns the requests of the rollouts selecting the ManagedCluster
+func getRolloutsSelectingCluster(c client.Client, clusterName string) []reconcile.Request {
+	managedCluster := &managedclusterv1.ManagedCluster{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: clusterName}, managedCluster); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "Failed to get managedcluster", "name", clusterName)
+		}
+		return nil
+	}
+	rollouts := &agentv1.AddonRolloutList{}
+	if err := c.List(context.TODO(), rollouts); err != nil {
+		log.Error(err, "Failed to list addonrollouts")
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for i := range rollouts.Items {
+		selector, err := getClusterSelector(&rollouts.Items[i])
+		if err != nil || !selector.Matches(labels.Set(managedCluster.Labels)) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: rollouts.Items[i].Name},
+		})
+	}
+	return requests
+}
+
+// blank assignment to verify that ReconcileAddonRollout implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileAddonRollout{}
+
+// ReconcileAddonRollout reconciles an AddonRollout object
+type ReconcileAddonRollout struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// Reconcile upgrades the klusterletaddonconfigs of the clusters selected by the AddonRollout to its version, at most
+// maxUnavailable clusters are upgraded until their addons are available. The rollout halts when an addon of an
+// upgraded cluster is degraded, or when another rollout created before it selects one of its clusters. The selected
+// clusters are held on their version until the rollout upgrades them, and released when the rollout is deleted
+func (r *ReconcileAddonRollout) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Name", request.Name)
+	reqLogger.Info("Reconciling AddonRollout")
+
+	rollout := &agentv1.AddonRollout{}
+	if err := r.client.Get(context.TODO(), request.NamespacedName, rollout); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if rollout.DeletionTimestamp != nil {
+		if !utils.HasFinalizer(rollout, AddonRolloutFinalizer) {
+			return reconcile.Result{}, nil
+		}
+		if err := r.releaseClusters(rollout); err != nil {
+			return reconcile.Result{}, err
+		}
+		utils.RemoveFinalizer(rollout, AddonRolloutFinalizer)
+		return reconcile.Result{}, r.client.Update(context.TODO(), rollout)
+	}
+	if !utils.HasFinalizer(rollout, AddonRolloutFinalizer) {
+		utils.AddFinalizer(rollout, AddonRolloutFinalizer)
+		if err := r.client.Update(context.TODO(), rollout); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// a rollout halted on degraded addons resumes when its spec changes
+	if rollout.Status.Phase == agentv1.RolloutPhaseHalted && len(rollout.Status.DegradedClusters) > 0 &&
+		rollout.Status.ObservedGeneration == rollout.Generation {
+		return reconcile.Result{}, nil
+	}
+
+	status := agentv1.AddonRolloutStatus{ObservedGeneration: rollout.Generation}
+	klusterletaddonconfigs, err := r.getKlusterletAddonConfigs(rollout)
+	if err != nil {
+		status.Phase = agentv1.RolloutPhaseHalted
+		status.Message = fmt.Sprintf("Invalid clusterSelector: %v.", err)
+		return reconcile.Result{}, r.updateStatus(rollout, status)
+	}
+	status.Clusters = int32(len(klusterletaddonconfigs))
+
+	// the clusters of a rollout are upgraded by this rollout only
+	overlappingRollout, overlappingClusters, err := r.getOverlappingRollout(rollout, klusterletaddonconfigs)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if overlappingRollout != "" {
+		status.Phase = agentv1.RolloutPhaseHalted
+		status.Message = fmt.Sprintf("Halted because %s are selected by rollout %s, the clusterSelectors of the "+
+			"rollouts must not overlap.", strings.Join(overlappingClusters, ", "), overlappingRollout)
+		return reconcile.Result{RequeueAfter: rolloutResyncPeriod}, r.updateStatus(rollout, status)
+	}
+
+	// hold the selected clusters on the version they run, so upgrading the controller does not upgrade them
+	for _, klusterletaddonconfig := range klusterletaddonconfigs {
+		if err := r.hold(klusterletaddonconfig); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	maxUnavailable, err := getMaxUnavailable(rollout, len(klusterletaddonconfigs))
+	if err != nil {
+		status.Phase = agentv1.RolloutPhaseHalted
+		status.Message = fmt.Sprintf("Invalid maxUnavailable: %v.", err)
+		return reconcile.Result{}, r.updateStatus(rollout, status)
+	}
+
+	// the image manifest of the version may be loaded later
+	if err := agentv1.CheckImageManifest(rollout.Spec.Version); err != nil {
+		status.Phase = agentv1.RolloutPhaseProgressing
+		status.Message = fmt.Sprintf("Waiting for an image manifest of version %s: %v.", rollout.Spec.Version, err)
+		return reconcile.Result{RequeueAfter: rolloutResyncPeriod}, r.updateStatus(rollout, status)
+	}
+
+	now := time.Now()
+	pending := []*agentv1.KlusterletAddonConfig{}
+	for _, klusterletaddonconfig := range klusterletaddonconfigs {
+		if klusterletaddonconfig.GetAnnotations()[agentv1.KlusterletAddonConfigAnnotationRolloutVersion] !=
+			rollout.Spec.Version {
+			pending = append(pending, klusterletaddonconfig)
+			continue
+		}
+		status.UpdatedClusters++
+		health, err := r.getClusterHealth(rollout, klusterletaddonconfig, now)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		switch health {
+		case clusterAvailable:
+			status.AvailableClusters++
+		case clusterDegraded:
+			status.DegradedClusters = append(status.DegradedClusters, klusterletaddonconfig.Namespace)
+		default:
+			status.ProgressingClusters = append(status.ProgressingClusters, klusterletaddonconfig.Namespace)
+		}
+	}
+
+	if len(status.DegradedClusters) > 0 {
+		status.Phase = agentv1.RolloutPhaseHalted
+		status.Message = fmt.Sprintf("Halted because addons of %s are degraded, change the spec to resume.",
+			strings.Join(status.DegradedClusters, ", "))
+		r.recorder.Event(rollout, corev1.EventTypeWarning, eventReasonHalted, status.Message)
+		return reconcile.Result{}, r.updateStatus(rollout, status)
+	}
+
+	// upgrade the next clusters in the free slots
+	upgraded := []string{}
+	for _, klusterletaddonconfig := range pending {
+		if len(status.ProgressingClusters) >= maxUnavailable {
+			break
+		}
+		if err := r.upgrade(klusterletaddonconfig, rollout.Spec.Version, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		status.UpdatedClusters++
+		status.ProgressingClusters = append(status.ProgressingClusters, klusterletaddonconfig.Namespace)
+		upgraded = append(upgraded, klusterletaddonconfig.Namespace)
+	}
+	if len(upgraded) > 0 {
+		reqLogger.Info("Upgraded clusters", "version", rollout.Spec.Version, "clusters", upgraded)
+		r.recorder.Event(rollout, corev1.EventTypeNormal, eventReasonUpgraded,
+			fmt.Sprintf("Upgraded the addons of %s to version %s.", strings.Join(upgraded, ", "), rollout.Spec.Version))
+	}
+
+	if status.AvailableClusters == status.Clusters {
+		status.Phase = agentv1.RolloutPhaseCompleted
+		status.Message = fmt.Sprintf("All %d clusters are upgraded to version %s.", status.Clusters,
+			rollout.Spec.Version)
+		return reconcile.Result{}, r.updateStatus(rollout, status)
+	}
+	status.Phase = agentv1.RolloutPhaseProgressing
+	status.Message = fmt.Sprintf("%d of %d clusters are upgraded to version %s.", status.UpdatedClusters,
+		status.Clusters, rollout.Spec.Version)
+	return reconcile.Result{RequeueAfter: rolloutResyncPeriod}, r.updateStatus(rollout, status)
+}
+
+// getClusterSelector returns the selector of the ManagedClusters of the rollout
+func getClusterSelector(rollout *agentv1.AddonRollout) (labels.Selector, error) {
+	if rollout.Spec.ClusterSelector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(rollout.Spec.ClusterSelector)
+}
+
+// getSelectedClusters returns the names of the ManagedClusters selected by the rollout, ordered by name
+func (r *ReconcileAddonRollout) getSelectedClusters(rollout *agentv1.AddonRollout) ([]string, error) {
+	selector, err := getClusterSelector(rollout)
+	if err != nil {
+		return nil, err
+	}
+	managedClusters := &managedclusterv1.ManagedClusterList{}
+	if err := r.client.List(context.TODO(), managedClusters,
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(managedClusters.Items))
+	for _, managedCluster := range managedClusters.Items {
+		names = append(names, managedCluster.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// getKlusterletAddonConfigs returns the klusterletaddonconfigs of the ManagedClusters selected by the rollout,
+// ordered by cluster name. The klusterletaddonconfigs pinned to a version are held on it, they are skipped
+func (r *ReconcileAddonRollout) getKlusterletAddonConfigs(
+	rollout *agentv1.AddonRollout,
+) ([]*agentv1.KlusterletAddonConfig, error) {
+	clusterNames, err := r.getSelectedClusters(rollout)
+	if err != nil {
+		return nil, err
+	}
+
+	klusterletaddonconfigs := []*agentv1.KlusterletAddonConfig{}
+	for _, clusterName := range clusterNames {
+		klusterletaddonconfig := &agentv1.KlusterletAddonConfig{}
+		err := r.client.Get(context.TODO(), types.NamespacedName{
+			Name:      clusterName,
+			Namespace: clusterName,
+		}, klusterletaddonconfig)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if klusterletaddonconfig.GetPinnedVersion() != "" {
+			continue
+		}
+		klusterletaddonconfigs = append(klusterletaddonconfigs, klusterletaddonconfig)
+	}
+	return klusterletaddonconfigs, nil
+}
+
+// getOverlappingRollout returns the first rollout created before the given one which selects some of its
+// klusterletaddonconfigs, and the names of their clusters. The rollouts being deleted are ignored
+func (r *ReconcileAddonRollout) getOverlappingRollout(
+	rollout *agentv1.AddonRollout,
+	klusterletaddonconfigs []*agentv1.KlusterletAddonConfig,
+) (string, []string, error) {
+	rollouts := &agentv1.AddonRolloutList{}
+	if err := r.client.List(context.TODO(), rollouts); err != nil {
+		return "", nil, err
+	}
+	sort.Slice(rollouts.Items, func(i, j int) bool {
+		return createdBefore(&rollouts.Items[i], &rollouts.Items[j])
+	})
+	for i := range rollouts.Items {
+		other := &rollouts.Items[i]
+		if !createdBefore(other, rollout) {
+			break
+		}
+		if other.DeletionTimestamp != nil {
+			continue
+		}
+		clusterNames, err := r.getSelectedClusters(other)
+		if err != nil {
+			continue
+		}
+		selected := sets.NewString(clusterNames...)
+		overlapping := []string{}
+		for _, klusterletaddonconfig := range klusterletaddonconfigs {
+			if selected.Has(klusterletaddonconfig.Namespace) {
+				overlapping = append(overlapping, klusterletaddonconfig.Namespace)
+			}
+		}
+		if len(overlapping) > 0 {
+			return other.Name, overlapping, nil
+		}
+	}
+	return "", nil, nil
+}
+
+// createdBefore returns true if the rollout a was created before b, the rollouts created at the same time are
+// ordered by name
+func createdBefore(a, b *agentv1.AddonRollout) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// getMaxUnavailable returns how many clusters are upgraded at once, it is at least 1
+func getMaxUnavailable(rollout *agentv1.AddonRollout, clusters int) (int, error) {
+	defaultMaxUnavailable := intstr.FromInt(1)
+	maxUnavailable, err := intstr.GetValueFromIntOrPercent(
+		intstr.ValueOrDefault(rollout.Spec.MaxUnavailable, defaultMaxUnavailable), clusters, true)
+	if err != nil {
+		return 0, err
+	}
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable, nil
+}
+
+// getClusterHealth returns the health of an upgraded cluster: Degraded if one of its checked addons is degraded,
+// Available if they are all available since minReadySeconds after the upgrade, Progressing otherwise
+func (r *ReconcileAddonRollout) getClusterHealth(
+	rollout *agentv1.AddonRollout,
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	now time.Time,
+) (string, error) {
+	managedClusterAddOns := &addonv1alpha1.ManagedClusterAddOnList{}
+	if err := r.client.List(context.TODO(), managedClusterAddOns,
+		client.InNamespace(klusterletaddonconfig.Namespace)); err != nil {
+		return "", err
+	}
+	conditions := make(map[string][]metav1.Condition, len(managedClusterAddOns.Items))
+	for _, managedClusterAddOn := range managedClusterAddOns.Items {
+		conditions[managedClusterAddOn.Name] = managedClusterAddOn.Status.Conditions
+	}
+
+	health := clusterAvailable
+	for _, name := range getCheckedAddons(rollout, klusterletaddonconfig) {
+		addonConditions, ok := conditions[name]
+		switch {
+		case meta.IsStatusConditionTrue(addonConditions, addonDegraded):
+			return clusterDegraded, nil
+		case !ok || !meta.IsStatusConditionTrue(addonConditions, addonAvailable):
+			health = clusterProgressing
+		}
+	}
+
+	// the addons may still report the availability of the previous version right after the upgrade
+	upgradedAt, err := time.Parse(time.RFC3339,
+		klusterletaddonconfig.GetAnnotations()[agentv1.KlusterletAddonConfigAnnotationRolloutTime])
+	if err == nil && now.Before(upgradedAt.Add(time.Duration(rollout.Spec.MinReadySeconds)*time.Second)) {
+		health = clusterProgressing
+	}
+	return health, nil
+}
+
+// getCheckedAddons returns the names of the ManagedClusterAddOns of the enabled addons of the cluster which are
+// listed in the rollout, or of all enabled addons when the rollout lists none
+func getCheckedAddons(rollout *agentv1.AddonRollout, klusterletaddonconfig *agentv1.KlusterletAddonConfig) []string {
+	names := []string{}
+	for _, addon := range addons.GetAddons() {
+		if !addon.IsEnabled(klusterletaddonconfig) {
+			continue
+		}
+		if len(rollout.Spec.Addons) == 0 || containsAddon(rollout.Spec.Addons, addon) {
+			names = append(names, addon.GetManagedClusterAddOnName())
+		}
+	}
+	return names
+}
+
+// containsAddon returns true if the list contains the addon or ManagedClusterAddOn name of the addon
+func containsAddon(list []string, addon addons.KlusterletAddon) bool {
+	for _, name := range list {
+		if name == addon.GetAddonName() || name == addon.GetManagedClusterAddOnName() {
+			return true
+		}
+	}
+	return false
+}
+
+// upgrade sets the version the images of the addons of the klusterletaddonconfig are resolved from
+func (r *ReconcileAddonRollout) upgrade(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	version string,
+	now time.Time,
+) error {
+	annotations := klusterletaddonconfig.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[agentv1.KlusterletAddonConfigAnnotationRolloutVersion] = version
+	annotations[agentv1.KlusterletAddonConfigAnnotationRolloutTime] = now.UTC().Format(time.RFC3339)
+	klusterletaddonconfig.SetAnnotations(annotations)
+	return r.client.Update(context.TODO(), klusterletaddonconfig)
+}
+
+// hold records the version the klusterletaddonconfig runs if no rollout upgraded it yet, its images are then resolved
+// from this version when the controller is upgraded
+func (r *ReconcileAddonRollout) hold(klusterletaddonconfig *agentv1.KlusterletAddonConfig) error {
+	annotations := klusterletaddonconfig.GetAnnotations()
+	if annotations[agentv1.KlusterletAddonConfigAnnotationRolloutVersion] != "" {
+		return nil
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	heldVersion := klusterletaddonconfig.Status.ResolvedVersion
+	if heldVersion == "" {
+		heldVersion = version.Version
+	}
+	annotations[agentv1.KlusterletAddonConfigAnnotationRolloutVersion] = heldVersion
+	klusterletaddonconfig.SetAnnotations(annotations)
+	return r.client.Update(context.TODO(), klusterletaddonconfig)
+}
+
+// releaseClusters removes the rollout annotations from the klusterletaddonconfigs which are not selected by another
+// rollout, their images are resolved from the version of the controller again
+func (r *ReconcileAddonRollout) releaseClusters(rollout *agentv1.AddonRollout) error {
+	rollouts := &agentv1.AddonRolloutList{}
+	if err := r.client.List(context.TODO(), rollouts); err != nil {
+		return err
+	}
+	held := sets.NewString()
+	for i := range rollouts.Items {
+		other := &rollouts.Items[i]
+		if other.Name == rollout.Name || other.DeletionTimestamp != nil {
+			continue
+		}
+		clusterNames, err := r.getSelectedClusters(other)
+		if err != nil {
+			continue
+		}
+		held.Insert(clusterNames...)
+	}
+
+	klusterletaddonconfigs := &agentv1.KlusterletAddonConfigList{}
+	if err := r.client.List(context.TODO(), klusterletaddonconfigs); err != nil {
+		return err
+	}
+	for i := range klusterletaddonconfigs.Items {
+		klusterletaddonconfig := &klusterletaddonconfigs.Items[i]
+		annotations := klusterletaddonconfig.GetAnnotations()
+		if _, ok := annotations[agentv1.KlusterletAddonConfigAnnotationRolloutVersion]; !ok ||
+			held.Has(klusterletaddonconfig.Namespace) {
+			continue
+		}
+		delete(annotations, agentv1.KlusterletAddonConfigAnnotationRolloutVersion)
+		delete(annotations, agentv1.KlusterletAddonConfigAnnotationRolloutTime)
+		klusterletaddonconfig.SetAnnotations(annotations)
+		if err := r.client.Update(context.TODO(), klusterletaddonconfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatus updates the status of the rollout when it changes
+func (r *ReconcileAddonRollout) updateStatus(rollout *agentv1.AddonRollout, status agentv1.AddonRolloutStatus) error {
+	if reflect.DeepEqual(rollout.Status, status) {
+		return nil
+	}
+	rollout.Status = status
+	return r.client.Status().Update(context.TODO(), rollout)
+}
@@ -0,0 +1,497 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package addonrollout
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
+	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func loadImageManifest(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap-2.3.0",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				"ocm-configmap-type":  "image-manifest",
+				"ocm-release-version": "2.3.0",
+			},
+		},
+		Data: map[string]string{
+			"multicloud_manager": "sample-registry/uniquePath/multicloud-manager@sha256:fake-sha256-2-3-0",
+		},
+	}
+	if err := agentv1.LoadConfigmaps(fake.NewFakeClient(configMap)); err != nil {
+		t.Fatalf("failed to load the image manifest: %v", err)
+	}
+}
+
+func newManagedCluster(name string, labels map[string]string) *managedclusterv1.ManagedCluster {
+	return &managedclusterv1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+	}
+}
+
+// newKlusterletAddonConfig returns a klusterletaddonconfig running 2.2.0, upgraded by a rollout to version when it is
+// not empty
+func newKlusterletAddonConfig(name, version string, upgradedAt time.Time) *agentv1.KlusterletAddonConfig {
+	klusterletaddonconfig := &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: name},
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			ClusterName:      name,
+			ClusterNamespace: name,
+		},
+		Status: agentv1.KlusterletAddonConfigStatus{ResolvedVersion: "2.2.0"},
+	}
+	if version != "" {
+		klusterletaddonconfig.SetAnnotations(map[string]string{
+			agentv1.KlusterletAddonConfigAnnotationRolloutVersion: version,
+			agentv1.KlusterletAddonConfigAnnotationRolloutTime:    upgradedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return klusterletaddonconfig
+}
+
+func newWorkManagerAddOn(namespace string, conditions ...metav1.Condition) *addonv1alpha1.ManagedClusterAddOn {
+	return &addonv1alpha1.ManagedClusterAddOn{
+		ObjectMeta: metav1.ObjectMeta{Name: "work-manager", Namespace: namespace},
+		Status:     addonv1alpha1.ManagedClusterAddOnStatus{Conditions: conditions},
+	}
+}
+
+func newTestScheme() *runtime.Scheme {
+	testscheme := scheme.Scheme
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.AddonRollout{}, &agentv1.AddonRolloutList{},
+		&agentv1.KlusterletAddonConfig{}, &agentv1.KlusterletAddonConfigList{})
+	testscheme.AddKnownTypes(managedclusterv1.SchemeGroupVersion, &managedclusterv1.ManagedCluster{},
+		&managedclusterv1.ManagedClusterList{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
+	return testscheme
+}
+
+func TestReconcileAddonRollout_Reconcile(t *testing.T) {
+	loadImageManifest(t)
+	testscheme := newTestScheme()
+
+	prod := map[string]string{"env": "prod"}
+	longAgo := time.Now().Add(-time.Hour)
+	available := metav1.Condition{Type: addonAvailable, Status: metav1.ConditionTrue}
+	unavailable := metav1.Condition{Type: addonAvailable, Status: metav1.ConditionFalse}
+	degraded := metav1.Condition{Type: addonDegraded, Status: metav1.ConditionTrue}
+	clusters := []runtime.Object{
+		newManagedCluster("cluster1", prod),
+		newManagedCluster("cluster2", prod),
+		newManagedCluster("cluster3", prod),
+		newManagedCluster("cluster4", prod),
+		newManagedCluster("cluster5", nil),
+		newKlusterletAddonConfig("cluster5", "", time.Time{}),
+	}
+
+	newRollout := func(
+		version string,
+		maxUnavailable intstr.IntOrString,
+		status agentv1.AddonRolloutStatus,
+	) *agentv1.AddonRollout {
+		return &agentv1.AddonRollout{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-rollout", Generation: 1},
+			Spec: agentv1.AddonRolloutSpec{
+				Version:         version,
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: prod},
+				MaxUnavailable:  &maxUnavailable,
+				MinReadySeconds: 60,
+			},
+			Status: status,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		rollout      *agentv1.AddonRollout
+		objects      []runtime.Object
+		wantStatus   agentv1.AddonRolloutStatus
+		wantUpgraded []string
+		// wantVersions are the rollout versions of the klusterletaddonconfigs which are not upgraded
+		wantVersions map[string]string
+	}{
+		{
+			name:    "upgrade the first batch",
+			rollout: newRollout("2.3.0", intstr.FromString("50%"), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "", time.Time{}),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+				newKlusterletAddonConfig("cluster3", "", time.Time{}),
+				newKlusterletAddonConfig("cluster4", "", time.Time{}),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration:  1,
+				Phase:               agentv1.RolloutPhaseProgressing,
+				Message:             "2 of 4 clusters are upgraded to version 2.3.0.",
+				Clusters:            4,
+				UpdatedClusters:     2,
+				ProgressingClusters: []string{"cluster1", "cluster2"},
+			},
+			wantUpgraded: []string{"cluster1", "cluster2"},
+			wantVersions: map[string]string{"cluster3": "2.2.0", "cluster4": "2.2.0", "cluster5": ""},
+		},
+		{
+			name:    "wait for the addons of the upgraded clusters",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "2.3.0", longAgo),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+				newWorkManagerAddOn("cluster1", unavailable),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration:  1,
+				Phase:               agentv1.RolloutPhaseProgressing,
+				Message:             "1 of 2 clusters are upgraded to version 2.3.0.",
+				Clusters:            2,
+				UpdatedClusters:     1,
+				ProgressingClusters: []string{"cluster1"},
+			},
+			wantUpgraded: []string{"cluster1"},
+		},
+		{
+			name:    "wait for minReadySeconds",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "2.3.0", time.Now()),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+				newWorkManagerAddOn("cluster1", available),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration:  1,
+				Phase:               agentv1.RolloutPhaseProgressing,
+				Message:             "1 of 2 clusters are upgraded to version 2.3.0.",
+				Clusters:            2,
+				UpdatedClusters:     1,
+				ProgressingClusters: []string{"cluster1"},
+			},
+			wantUpgraded: []string{"cluster1"},
+		},
+		{
+			name:    "advance when the addons are available",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "2.3.0", longAgo),
+				newKlusterletAddonConfig("cluster2", "2.2.0", longAgo),
+				newWorkManagerAddOn("cluster1", available),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration:  1,
+				Phase:               agentv1.RolloutPhaseProgressing,
+				Message:             "2 of 2 clusters are upgraded to version 2.3.0.",
+				Clusters:            2,
+				UpdatedClusters:     2,
+				AvailableClusters:   1,
+				ProgressingClusters: []string{"cluster2"},
+			},
+			wantUpgraded: []string{"cluster1", "cluster2"},
+		},
+		{
+			name:    "halt on a degraded addon",
+			rollout: newRollout("2.3.0", intstr.FromInt(2), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "2.3.0", longAgo),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+				newWorkManagerAddOn("cluster1", available, degraded),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration: 1,
+				Phase:              agentv1.RolloutPhaseHalted,
+				Message:            "Halted because addons of cluster1 are degraded, change the spec to resume.",
+				Clusters:           2,
+				UpdatedClusters:    1,
+				DegradedClusters:   []string{"cluster1"},
+			},
+			wantUpgraded: []string{"cluster1"},
+		},
+		{
+			name:    "skip the clusters pinned to a version",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				func() runtime.Object {
+					klusterletaddonconfig := newKlusterletAddonConfig("cluster1", "", time.Time{})
+					klusterletaddonconfig.Spec.Version = "2.2.0"
+					klusterletaddonconfig.Annotations = map[string]string{
+						agentv1.KlusterletAddonConfigAnnotationVersionMigrated: "true",
+					}
+					return klusterletaddonconfig
+				}(),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration:  1,
+				Phase:               agentv1.RolloutPhaseProgressing,
+				Message:             "1 of 1 clusters are upgraded to version 2.3.0.",
+				Clusters:            1,
+				UpdatedClusters:     1,
+				ProgressingClusters: []string{"cluster2"},
+			},
+			wantUpgraded: []string{"cluster2"},
+		},
+		{
+			name: "a halted rollout stays halted",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{
+				ObservedGeneration: 1,
+				Phase:              agentv1.RolloutPhaseHalted,
+				DegradedClusters:   []string{"cluster1"},
+			}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "2.3.0", longAgo),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+				newWorkManagerAddOn("cluster1", available),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration: 1,
+				Phase:              agentv1.RolloutPhaseHalted,
+				DegradedClusters:   []string{"cluster1"},
+			},
+			wantUpgraded: []string{"cluster1"},
+		},
+		{
+			name:    "halt when an older rollout selects the same clusters",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				&agentv1.AddonRollout{
+					ObjectMeta: metav1.ObjectMeta{Name: "older-rollout"},
+					Spec: agentv1.AddonRolloutSpec{
+						Version:         "2.2.0",
+						ClusterSelector: &metav1.LabelSelector{MatchLabels: prod},
+					},
+				},
+				newKlusterletAddonConfig("cluster1", "", time.Time{}),
+				newKlusterletAddonConfig("cluster2", "", time.Time{}),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration: 1,
+				Phase:              agentv1.RolloutPhaseHalted,
+				Message: "Halted because cluster1, cluster2 are selected by rollout older-rollout, " +
+					"the clusterSelectors of the rollouts must not overlap.",
+				Clusters: 2,
+			},
+			wantVersions: map[string]string{"cluster1": "", "cluster2": ""},
+		},
+		{
+			name:    "complete",
+			rollout: newRollout("2.3.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "2.3.0", longAgo),
+				newKlusterletAddonConfig("cluster2", "2.3.0", longAgo),
+				newWorkManagerAddOn("cluster1", available),
+				newWorkManagerAddOn("cluster2", available),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration: 1,
+				Phase:              agentv1.RolloutPhaseCompleted,
+				Message:            "All 2 clusters are upgraded to version 2.3.0.",
+				Clusters:           2,
+				UpdatedClusters:    2,
+				AvailableClusters:  2,
+			},
+			wantUpgraded: []string{"cluster1", "cluster2"},
+		},
+		{
+			name:    "wait for the image manifest of the version",
+			rollout: newRollout("3.0.0", intstr.FromInt(1), agentv1.AddonRolloutStatus{}),
+			objects: []runtime.Object{
+				newKlusterletAddonConfig("cluster1", "", time.Time{}),
+			},
+			wantStatus: agentv1.AddonRolloutStatus{
+				ObservedGeneration: 1,
+				Phase:              agentv1.RolloutPhaseProgressing,
+				Message:            "Waiting for an image manifest of version 3.0.0: version 3.0.0 not supported.",
+				Clusters:           1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := append([]runtime.Object{tt.rollout}, clusters...)
+			c := fake.NewFakeClientWithScheme(testscheme, append(objects, tt.objects...)...)
+			r := &ReconcileAddonRollout{client: c, scheme: testscheme, recorder: record.NewFakeRecorder(10)}
+			if _, err := r.Reconcile(reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: tt.rollout.Name},
+			}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			rollout := &agentv1.AddonRollout{}
+			if err := c.Get(context.TODO(), types.NamespacedName{Name: tt.rollout.Name}, rollout); err != nil {
+				t.Fatalf("failed to get the rollout: %v", err)
+			}
+			if !reflect.DeepEqual(rollout.Status, tt.wantStatus) {
+				t.Errorf("status = %+v, want %+v", rollout.Status, tt.wantStatus)
+			}
+
+			upgraded := []string{}
+			for _, name := range []string{"cluster1", "cluster2", "cluster3", "cluster4", "cluster5"} {
+				klusterletaddonconfig := &agentv1.KlusterletAddonConfig{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: name},
+					klusterletaddonconfig); err != nil {
+					continue
+				}
+				if klusterletaddonconfig.GetAnnotations()[agentv1.KlusterletAddonConfigAnnotationRolloutVersion] ==
+					tt.rollout.Spec.Version {
+					upgraded = append(upgraded, name)
+				}
+			}
+			if len(upgraded) == 0 {
+				upgraded = nil
+			}
+			if !reflect.DeepEqual(upgraded, tt.wantUpgraded) {
+				t.Errorf("upgraded clusters = %v, want %v", upgraded, tt.wantUpgraded)
+			}
+			for name, wantVersion := range tt.wantVersions {
+				klusterletaddonconfig := &agentv1.KlusterletAddonConfig{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: name},
+					klusterletaddonconfig); err != nil {
+					t.Fatalf("failed to get klusterletaddonconfig %s: %v", name, err)
+				}
+				version := klusterletaddonconfig.GetAnnotations()[agentv1.KlusterletAddonConfigAnnotationRolloutVersion]
+				if version != wantVersion {
+					t.Errorf("rollout version of %s = %q, want %q", name, version, wantVersion)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileAddonRollout_ReconcileDeleted(t *testing.T) {
+	loadImageManifest(t)
+	testscheme := newTestScheme()
+
+	now := metav1.Now()
+	deleted := &agentv1.AddonRollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-rollout",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{AddonRolloutFinalizer},
+		},
+		Spec: agentv1.AddonRolloutSpec{
+			Version:         "2.3.0",
+			ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+		},
+	}
+	staging := &agentv1.AddonRollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "staging-rollout"},
+		Spec: agentv1.AddonRolloutSpec{
+			Version:         "2.3.0",
+			ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "staging"}},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(testscheme,
+		deleted, staging,
+		newManagedCluster("cluster1", map[string]string{"env": "prod"}),
+		newManagedCluster("cluster2", map[string]string{"env": "staging"}),
+		newKlusterletAddonConfig("cluster1", "2.3.0", time.Now()),
+		newKlusterletAddonConfig("cluster2", "2.3.0", time.Now()),
+	)
+	r := &ReconcileAddonRollout{client: c, scheme: testscheme, recorder: record.NewFakeRecorder(10)}
+	if _, err := r.Reconcile(reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: deleted.Name},
+	}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	rollout := &agentv1.AddonRollout{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: deleted.Name}, rollout); err == nil &&
+		len(rollout.Finalizers) > 0 {
+		t.Errorf("finalizers = %v, want none", rollout.Finalizers)
+	}
+	for name, wantAnnotations := range map[string]int{"cluster1": 0, "cluster2": 2} {
+		klusterletaddonconfig := &agentv1.KlusterletAddonConfig{}
+		if err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: name},
+			klusterletaddonconfig); err != nil {
+			t.Fatalf("failed to get klusterletaddonconfig %s: %v", name, err)
+		}
+		if len(klusterletaddonconfig.GetAnnotations()) != wantAnnotations {
+			t.Errorf("annotations of %s = %v, want %d annotations", name, klusterletaddonconfig.GetAnnotations(),
+				wantAnnotations)
+		}
+	}
+}
+
+func Test_getRolloutsSelectingCluster(t *testing.T) {
+	testscheme := newTestScheme()
+	newRollout := func(name string, selector *metav1.LabelSelector) *agentv1.AddonRollout {
+		return &agentv1.AddonRollout{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       agentv1.AddonRolloutSpec{Version: "2.3.0", ClusterSelector: selector},
+		}
+	}
+	c := fake.NewFakeClientWithScheme(testscheme,
+		newRollout("all", nil),
+		newRollout("prod", &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}),
+		newRollout("staging", &metav1.LabelSelector{MatchLabels: map[string]string{"env": "staging"}}),
+		newManagedCluster("cluster1", map[string]string{"env": "prod"}),
+	)
+
+	tests := []struct {
+		name        string
+		clusterName string
+		want        []string
+	}{
+		{name: "rollouts selecting the cluster", clusterName: "cluster1", want: []string{"all", "prod"}},
+		{name: "unknown cluster", clusterName: "cluster2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, request := range getRolloutsSelectingCluster(c, tt.clusterName) {
+				got = append(got, request.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getRolloutsSelectingCluster() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getMaxUnavailable(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxUnavailable *intstr.IntOrString
+		clusters       int
+		want           int
+		wantErr        bool
+	}{
+		{name: "defaults to 1", clusters: 10, want: 1},
+		{name: "number", maxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 3}, clusters: 10, want: 3},
+		{name: "percentage rounded up", maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+			clusters: 10, want: 3},
+		{name: "at least 1", maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "0%"},
+			clusters: 10, want: 1},
+		{name: "invalid percentage", maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "a%"},
+			clusters: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rollout := &agentv1.AddonRollout{Spec: agentv1.AddonRolloutSpec{MaxUnavailable: tt.maxUnavailable}}
+			got, err := getMaxUnavailable(rollout, tt.clusters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getMaxUnavailable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getMaxUnavailable() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -73,7 +73,7 @@ func (r *ReconcileClusterManagementAddOn) Reconcile(request reconcile.Request) (
 	clusterManagementAddOn := &addonv1alpha1.ClusterManagementAddOn{}
 	if err := r.client.Get(context.TODO(), request.NamespacedName, clusterManagementAddOn); err != nil {
 		if errors.IsNotFound(err) {
-			clusterManagementAddonMeta := ClusterManagementAddOnMap[request.Name]
+			clusterManagementAddonMeta := ClusterManagementAddOnMetadata(request.Name)
 			clusterManagementAddon := newClusterManagementAddon(request.Name, clusterManagementAddonMeta)
 			if err := r.client.Create(context.TODO(), clusterManagementAddon); err != nil {
 				log.Error(err, fmt.Sprintf("Failed to create %s clustermanagementaddon ", request.Name))
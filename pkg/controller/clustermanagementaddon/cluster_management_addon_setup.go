@@ -11,6 +11,7 @@ import (
 
 	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
 	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	//"github.com/prometheus/common/log"
@@ -30,63 +31,27 @@ var (
 	WorkManager          = addons.WorkMgr.GetManagedClusterAddOnName()
 )
 
-// ClusterManagementAddOnNames - list of clustermanagementaddon name for addon
-var ClusterManagementAddOnNames = []string{
-	ApplicationManager,
-	CertPolicyController,
-	IamPolicyController,
-	PolicyController,
-	SearchCollector,
-	WorkManager,
-}
-
-// clusterManagementAddOnSpec holds DisplayName, Description and CRDName
-type clusterManagementAddOnSpec struct {
-	DisplayName string `json:"displayName"`
-	Description string `json:"description"`
-	CRDName     string `json:"crdName"`
+// ClusterManagementAddOnNames returns the clustermanagementaddon names of the registered addons
+func ClusterManagementAddOnNames() []string {
+	var names []string
+	for _, registration := range registry.Registrations() {
+		names = append(names, registration.ManagedClusterAddOnName)
+	}
+	return names
 }
 
-// ClusterManagementAddOnMap - map to hold clusterManagementAddOn spec information
-var ClusterManagementAddOnMap = map[string]clusterManagementAddOnSpec{
-	ApplicationManager: clusterManagementAddOnSpec{
-		DisplayName: "Application Manager",
-		Description: "Processes events and other requests to managed resources.",
-		CRDName:     "klusterletaddonconfigs.agent.open-cluster-management.io",
-	},
-	CertPolicyController: clusterManagementAddOnSpec{
-		DisplayName: "Cert Policy Controller",
-		Description: "Monitors certificate expiration based on distributed policies.",
-		CRDName:     "klusterletaddonconfigs.agent.open-cluster-management.io",
-	},
-	IamPolicyController: clusterManagementAddOnSpec{
-		DisplayName: "IAM Policy Controller",
-		Description: "Monitors identity controls based on distributed policies.",
-		CRDName:     "klusterletaddonconfigs.agent.open-cluster-management.io",
-	},
-	PolicyController: clusterManagementAddOnSpec{
-		DisplayName: "Policy Controller",
-		Description: "Distributes configured policies and monitors Kubernetes-based policies.",
-		CRDName:     "klusterletaddonconfigs.agent.open-cluster-management.io",
-	},
-	SearchCollector: clusterManagementAddOnSpec{
-		DisplayName: "Search Collector",
-		Description: "Collects cluster data to be indexed by search components on the hub cluster.",
-		CRDName:     "klusterletaddonconfigs.agent.open-cluster-management.io",
-	},
-	WorkManager: clusterManagementAddOnSpec{
-		DisplayName: "Work Manager",
-		Description: "Handles endpoint work requests and managed cluster status.",
-		CRDName:     "klusterletaddonconfigs.agent.open-cluster-management.io",
-	},
+// ClusterManagementAddOnMetadata returns the metadata of the addon of a clustermanagementaddon
+func ClusterManagementAddOnMetadata(name string) registry.Metadata {
+	registration, _ := registry.GetByManagedClusterAddOnName(name)
+	return registration.Metadata
 }
 
 // CreateClusterManagementAddon - creates ClusterManagementAddOns for all add-ons in klusterletaddonconfig
 func CreateClusterManagementAddon(c client.Client) {
 	for !getAllClusterManagementAddons(c) {
-		for _, name := range ClusterManagementAddOnNames {
+		for _, name := range ClusterManagementAddOnNames() {
 			clusterManagementAddon := &addonv1alpha1.ClusterManagementAddOn{}
-			clusterManagementAddonSpec := ClusterManagementAddOnMap[name]
+			clusterManagementAddonSpec := ClusterManagementAddOnMetadata(name)
 			if err := c.Get(context.TODO(), types.NamespacedName{Name: name}, clusterManagementAddon); err != nil {
 				if errors.IsNotFound(err) {
 					clusterManagementAddon := newClusterManagementAddon(name, clusterManagementAddonSpec)
@@ -112,7 +77,7 @@ func CreateClusterManagementAddon(c client.Client) {
 	}
 }
 
-func newClusterManagementAddon(addOnName string, clusterManagementAddonSpec clusterManagementAddOnSpec) *addonv1alpha1.ClusterManagementAddOn {
+func newClusterManagementAddon(addOnName string, clusterManagementAddonSpec registry.Metadata) *addonv1alpha1.ClusterManagementAddOn {
 	return &addonv1alpha1.ClusterManagementAddOn{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: addonv1alpha1.SchemeGroupVersion.String(),
@@ -134,7 +99,7 @@ func newClusterManagementAddon(addOnName string, clusterManagementAddonSpec clus
 }
 
 func updateClusterManagementAddOn(client client.Client, addOnName string, oldClusterManagementAddOn *addonv1alpha1.ClusterManagementAddOn) error {
-	clusterManagementAddonMeta := ClusterManagementAddOnMap[addOnName]
+	clusterManagementAddonMeta := ClusterManagementAddOnMetadata(addOnName)
 
 	newClusterManagementAddon := newClusterManagementAddon(addOnName, clusterManagementAddonMeta)
 	if !reflect.DeepEqual(oldClusterManagementAddOn.Spec, newClusterManagementAddon.Spec) {
@@ -148,7 +113,7 @@ func updateClusterManagementAddOn(client client.Client, addOnName string, oldClu
 }
 
 func getAllClusterManagementAddons(client client.Client) bool {
-	for _, name := range ClusterManagementAddOnNames {
+	for _, name := range ClusterManagementAddOnNames() {
 		clusterManagementAddon := &addonv1alpha1.ClusterManagementAddOn{}
 		if err := client.Get(context.TODO(), types.NamespacedName{Name: name}, clusterManagementAddon); err != nil {
 			return false
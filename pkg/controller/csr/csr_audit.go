@@ -0,0 +1,182 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package csr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CSRAuditConfigMapName is the name of the configmap in the namespace of a managed cluster which keeps
+	// the decisions taken on the addon CSRs of the cluster
+	CSRAuditConfigMapName = "klusterlet-addon-csr-audit"
+	// csrAuditKey is the key of the records in the configmap
+	csrAuditKey = "records.json"
+	// maxCSRAuditRecords is the number of records kept per cluster, the oldest records are dropped first
+	maxCSRAuditRecords = 200
+)
+
+// decisions of CSRAuditRecord
+const (
+	CSRDecisionApproved = "Approved"
+	CSRDecisionDenied   = "Denied"
+)
+
+// CSRAuditRecord is the decision taken by the controller on an addon CSR
+type CSRAuditRecord struct {
+	CSRName  string `json:"csrName"`
+	Cluster  string `json:"cluster"`
+	Addon    string `json:"addon"`
+	Username string `json:"username"`
+	// Subject is the subject of the certificate request, empty if the request cannot be parsed
+	Subject string `json:"subject,omitempty"`
+	// PublicKeyFingerprint is the SHA256 of the DER encoded public key of the certificate request
+	PublicKeyFingerprint string `json:"publicKeyFingerprint,omitempty"`
+	// Decision is Approved or Denied
+	Decision string `json:"decision"`
+	// Reason is the reason of the Approved or Denied condition
+	Reason    string      `json:"reason"`
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// CSRAuditQuery selects audit records, empty fields match all records
+type CSRAuditQuery struct {
+	Addon    string
+	Decision string
+	// Since selects the records taken at or after the time
+	Since time.Time
+}
+
+// newCSRAuditRecord returns the audit record of the decision taken on the csr
+func newCSRAuditRecord(csr *certificatesv1.CertificateSigningRequest, decision, reason string) CSRAuditRecord {
+	labels := csr.GetLabels()
+	record := CSRAuditRecord{
+		CSRName:   csr.Name,
+		Cluster:   labels[clusterNameLabel],
+		Addon:     labels[managedClusterAddonNameLabel],
+		Username:  csr.Spec.Username,
+		Decision:  decision,
+		Reason:    reason,
+		Timestamp: metav1.NewTime(time.Now().UTC().Truncate(time.Second)),
+	}
+	if x509cr, err := parseCSRRequest(csr); err == nil {
+		record.Subject = x509cr.Subject.String()
+		record.PublicKeyFingerprint = fmt.Sprintf("SHA256:%x", sha256.Sum256(x509cr.RawSubjectPublicKeyInfo))
+	}
+	return record
+}
+
+// appendCSRAuditRecord adds the record to the audit configmap of its cluster. The configmap is read from the
+// apiserver, a stale cache would drop the records written since. A former record of the same csr is replaced
+func appendCSRAuditRecord(apiReader client.Reader, c client.Writer, record CSRAuditRecord) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		err := apiReader.Get(context.TODO(), types.NamespacedName{Name: CSRAuditConfigMapName, Namespace: record.Cluster}, cm)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		exists := err == nil
+
+		records, err := decodeCSRAuditRecords(cm)
+		if err != nil {
+			return err
+		}
+		records = addCSRAuditRecord(records, record)
+		data, err := json.Marshal(records)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      CSRAuditConfigMapName,
+					Namespace: record.Cluster,
+				},
+				Data: map[string]string{csrAuditKey: string(data)},
+			}
+			return c.Create(context.TODO(), cm)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[csrAuditKey] = string(data)
+		return c.Update(context.TODO(), cm)
+	})
+}
+
+// addCSRAuditRecord appends or replaces the record of the csr, and drops the oldest records over maxCSRAuditRecords
+func addCSRAuditRecord(records []CSRAuditRecord, record CSRAuditRecord) []CSRAuditRecord {
+	for i := range records {
+		if records[i].CSRName == record.CSRName {
+			records = append(records[:i], records[i+1:]...)
+			break
+		}
+	}
+	records = append(records, record)
+	if len(records) > maxCSRAuditRecords {
+		records = records[len(records)-maxCSRAuditRecords:]
+	}
+	return records
+}
+
+// decodeCSRAuditRecords returns the records of the audit configmap
+func decodeCSRAuditRecords(cm *corev1.ConfigMap) ([]CSRAuditRecord, error) {
+	data := cm.Data[csrAuditKey]
+	if data == "" {
+		return nil, nil
+	}
+	records := []CSRAuditRecord{}
+	if err := json.Unmarshal([]byte(data), &records); err != nil {
+		return nil, fmt.Errorf("invalid %s in configmap %s/%s: %w", csrAuditKey, cm.Namespace, cm.Name, err)
+	}
+	return records, nil
+}
+
+// QueryCSRAudit returns the audit records of a managed cluster matching the query, oldest first
+func QueryCSRAudit(c client.Client, cluster string, query CSRAuditQuery) ([]CSRAuditRecord, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: CSRAuditConfigMapName, Namespace: cluster}, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	records, err := decodeCSRAuditRecords(cm)
+	if err != nil {
+		return nil, err
+	}
+	var matched []CSRAuditRecord
+	for _, record := range records {
+		if query.matches(record) {
+			matched = append(matched, record)
+		}
+	}
+	return matched, nil
+}
+
+func (q CSRAuditQuery) matches(record CSRAuditRecord) bool {
+	if q.Addon != "" && q.Addon != record.Addon {
+		return false
+	}
+	if q.Decision != "" && q.Decision != record.Decision {
+		return false
+	}
+	if !q.Since.IsZero() && record.Timestamp.Time.Before(q.Since) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package csr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_newCSRAuditRecord(t *testing.T) {
+	requester := "system:open-cluster-management:cluster1:agent1"
+	csr := newCSR("csr1", "application-manager", "cluster1", requester, nil)
+
+	record := newCSRAuditRecord(csr, CSRDecisionApproved, "AutoApprovedByHubCSRController")
+	if record.CSRName != "csr1" || record.Cluster != "cluster1" || record.Addon != "application-manager" ||
+		record.Username != requester || record.Decision != CSRDecisionApproved {
+		t.Errorf("unexpected record %+v", record)
+	}
+	wantSubject := "CN=system:open-cluster-management:cluster:cluster1:addon:application-manager:agent:agent1," +
+		"O=system:open-cluster-management:cluster:cluster1:addon:application-manager"
+	if record.Subject != wantSubject {
+		t.Errorf("subject = %q, want %q", record.Subject, wantSubject)
+	}
+	if !strings.HasPrefix(record.PublicKeyFingerprint, "SHA256:") || len(record.PublicKeyFingerprint) != 71 {
+		t.Errorf("unexpected public key fingerprint %q", record.PublicKeyFingerprint)
+	}
+
+	csr.Spec.Request = []byte("invalid csr")
+	record = newCSRAuditRecord(csr, CSRDecisionDenied, deniedReasonInvalidRequest)
+	if record.Subject != "" || record.PublicKeyFingerprint != "" {
+		t.Errorf("subject & fingerprint should be empty for an invalid request, got %+v", record)
+	}
+}
+
+func Test_addCSRAuditRecord(t *testing.T) {
+	var records []CSRAuditRecord
+	for i := 0; i < maxCSRAuditRecords+10; i++ {
+		records = addCSRAuditRecord(records, CSRAuditRecord{CSRName: fmt.Sprintf("csr%d", i)})
+	}
+	if len(records) != maxCSRAuditRecords {
+		t.Fatalf("len(records) = %d, want %d", len(records), maxCSRAuditRecords)
+	}
+	if records[0].CSRName != "csr10" {
+		t.Errorf("oldest record = %s, want csr10", records[0].CSRName)
+	}
+
+	last := records[len(records)-1].CSRName
+	records = addCSRAuditRecord(records, CSRAuditRecord{CSRName: "csr10", Decision: CSRDecisionDenied})
+	if len(records) != maxCSRAuditRecords {
+		t.Fatalf("len(records) = %d, want %d", len(records), maxCSRAuditRecords)
+	}
+	if records[0].CSRName != "csr11" || records[len(records)-2].CSRName != last ||
+		records[len(records)-1].Decision != CSRDecisionDenied {
+		t.Errorf("record of csr10 should be replaced by the last record")
+	}
+}
+
+func TestQueryCSRAudit(t *testing.T) {
+	c := fake.NewFakeClientWithScheme(scheme.Scheme)
+	now := time.Now().UTC().Truncate(time.Second)
+	records := []CSRAuditRecord{
+		{
+			CSRName: "csr1", Cluster: "cluster1", Addon: "application-manager", Decision: CSRDecisionApproved,
+			Timestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+		},
+		{
+			CSRName: "csr2", Cluster: "cluster1", Addon: "search-collector", Decision: CSRDecisionDenied,
+			Timestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+		},
+		{
+			CSRName: "csr3", Cluster: "cluster1", Addon: "application-manager", Decision: CSRDecisionApproved,
+			Timestamp: metav1.NewTime(now),
+		},
+		{
+			CSRName: "csr4", Cluster: "cluster2", Addon: "application-manager", Decision: CSRDecisionApproved,
+			Timestamp: metav1.NewTime(now),
+		},
+	}
+	for _, record := range records {
+		if err := appendCSRAuditRecord(c, c, record); err != nil {
+			t.Fatalf("appendCSRAuditRecord() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		cluster string
+		query   CSRAuditQuery
+		want    []string
+	}{
+		{
+			name:    "all records of cluster",
+			cluster: "cluster1",
+			want:    []string{"csr1", "csr2", "csr3"},
+		},
+		{
+			name:    "records of addon",
+			cluster: "cluster1",
+			query:   CSRAuditQuery{Addon: "application-manager"},
+			want:    []string{"csr1", "csr3"},
+		},
+		{
+			name:    "denied records",
+			cluster: "cluster1",
+			query:   CSRAuditQuery{Decision: CSRDecisionDenied},
+			want:    []string{"csr2"},
+		},
+		{
+			name:    "recent records",
+			cluster: "cluster1",
+			query:   CSRAuditQuery{Since: now.Add(-1 * time.Hour)},
+			want:    []string{"csr2", "csr3"},
+		},
+		{
+			name:    "cluster without records",
+			cluster: "cluster3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := QueryCSRAudit(c, tt.cluster, tt.query)
+			if err != nil {
+				t.Fatalf("QueryCSRAudit() error = %v", err)
+			}
+			var names []string
+			for _, record := range got {
+				names = append(names, record.CSRName)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("QueryCSRAudit() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
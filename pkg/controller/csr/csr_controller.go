@@ -8,11 +8,13 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
 	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/metrics"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -22,6 +24,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	csrclientv1 "k8s.io/client-go/kubernetes/typed/certificates/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -34,6 +38,19 @@ import (
 const (
 	clusterNameLabel             = "open-cluster-management.io/cluster-name"
 	managedClusterAddonNameLabel = "open-cluster-management.io/addon-name"
+
+	// reasons of the Denied condition of csrs
+	deniedReasonPolicyViolation     = "DeniedByCSRApprovalPolicy"
+	deniedReasonInvalidRequest      = "InvalidCertificateRequest"
+	deniedReasonInvalidOrganization = "InvalidOrganization"
+	deniedReasonCommonNameMismatch  = "CommonNameMismatch"
+	deniedReasonAddonNotFound       = "ManagedClusterAddOnNotFound"
+	deniedReasonRateLimited         = "ApprovalRateLimitExceeded"
+
+	// Degraded condition set on the ManagedClusterAddOn when its csrs exceed the approval rate limit,
+	// the ManagedClusterAddOn controller keeps it until the approval budget of the addon is refilled
+	managedClusterAddOnDegraded  = "Degraded"
+	degradedReasonCSRRateLimited = "CSRApprovalRateLimitExceeded"
 )
 
 var log = logf.Log.WithName("controller_csr")
@@ -56,9 +73,13 @@ func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
 	}
 
 	return &ReconcileCSR{
-		client:    mgr.GetClient(),
-		scheme:    mgr.GetScheme(),
-		csrClient: kubeClient.CertificatesV1().CertificateSigningRequests(),
+		client:          mgr.GetClient(),
+		apiReader:       mgr.GetAPIReader(),
+		scheme:          mgr.GetScheme(),
+		csrClient:       kubeClient.CertificatesV1().CertificateSigningRequests(),
+		recorder:        mgr.GetEventRecorderFor("csr-controller"),
+		policyNamespace: os.Getenv("POD_NAMESPACE"),
+		rateLimiter:     newCSRRateLimiter(ApprovalInterval, ApprovalBurst),
 	}, nil
 }
 
@@ -90,9 +111,18 @@ type ReconcileCSR struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client client.Client
-	scheme *runtime.Scheme
+	// apiReader reads objects from the apiserver, it checks the objects which are not found in the cache
+	apiReader client.Reader
+	scheme    *runtime.Scheme
 
 	csrClient csrclientv1.CertificateSigningRequestInterface
+	recorder  record.EventRecorder
+
+	// policyNamespace is the namespace of the CSRApprovalPolicy configmap
+	policyNamespace string
+
+	// rateLimiter limits the approvals per cluster & addon, nothing is limited if it is nil
+	rateLimiter *csrRateLimiter
 }
 
 // Reconcile reads that state of the ManagedCluster and ManagedClusterAddOn object and approve the csr if it is
@@ -111,40 +141,45 @@ func (r *ReconcileCSR) Reconcile(request reconcile.Request) (reconcile.Result, e
 		return reconcile.Result{}, err
 	}
 
-	// skip csr which has been processed
-	if len(csr.Status.Conditions) > 0 {
-		return reconcile.Result{}, nil
-	}
-
 	labels := csr.GetLabels()
 	clusterName := labels[clusterNameLabel]
 	managedClusterAddonName := labels[managedClusterAddonNameLabel]
 
+	// skip csr which has been processed, a csr denied by the rate limit is requeued until the approval budget
+	// of its addon is refilled to clear the Degraded condition of the addon
+	if len(csr.Status.Conditions) > 0 {
+		if !isRateLimited(csr) {
+			return reconcile.Result{}, nil
+		}
+		if delay := r.rateLimiter.delay(clusterName, managedClusterAddonName); delay > 0 {
+			return reconcile.Result{RequeueAfter: delay}, nil
+		}
+		r.updateRateLimitedCondition(clusterName, managedClusterAddonName, false)
+		return reconcile.Result{}, nil
+	}
+
 	// skip csr which is not created by registration agent
 	requestorPrefix := fmt.Sprintf("system:open-cluster-management:%s:", clusterName)
 	if ok := strings.HasPrefix(csr.Spec.Username, requestorPrefix); !ok {
 		return reconcile.Result{}, nil
 	}
 
-	// skip invalid addon registration csr
-	if !isValidAddonCSR(csr, managedClusterAddonName, clusterName) {
-		return reconcile.Result{}, nil
+	// deny invalid addon registration csr
+	if reason, message := validateAddonCSR(csr, managedClusterAddonName, clusterName); reason != "" {
+		return reconcile.Result{}, r.denyCSR(csr, reason, message)
 	}
 
 	// check if ManagedClusterAddOn exists
-	if err := r.client.Get(
-		context.TODO(),
-		types.NamespacedName{
-			Name:      managedClusterAddonName,
-			Namespace: clusterName,
-		},
-		&addonv1alpha1.ManagedClusterAddOn{},
-	); err != nil && errors.IsNotFound(err) {
-		// skip csr if ManagedClusterAddOn does not exist
-		return reconcile.Result{}, nil
-	} else if err != nil {
+	managedClusterAddOn, err := r.getManagedClusterAddOn(clusterName, managedClusterAddonName)
+	if err != nil {
 		return reconcile.Result{}, err
 	}
+	if managedClusterAddOn == nil {
+		// deny csr if ManagedClusterAddOn does not exist, its approval budget is dropped with it
+		r.rateLimiter.forget(clusterName, managedClusterAddonName)
+		return reconcile.Result{}, r.denyCSR(csr, deniedReasonAddonNotFound,
+			fmt.Sprintf("ManagedClusterAddOn %s/%s does not exist.", clusterName, managedClusterAddonName))
+	}
 
 	// fetch the ManagedCluster instance
 	managedCluster := &managedclusterv1.ManagedCluster{}
@@ -162,49 +197,193 @@ func (r *ReconcileCSR) Reconcile(request reconcile.Request) (reconcile.Result, e
 		return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
 	}
 
-	// Auto approve the spoke cluster csr
+	// check the csr against the approval policy, it is read from the apiserver so configmaps are not cached
+	policy, err := getCSRApprovalPolicy(r.apiReader, r.policyNamespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	x509cr, err := parseCSRRequest(csr)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if violations := policy.checkCompliance(csr, x509cr); len(violations) > 0 {
+		message := "Addon certificate signing request does not comply with the approval policy: " +
+			strings.Join(violations, "; ") + "."
+		return reconcile.Result{}, r.denyCSR(csr, deniedReasonPolicyViolation, message)
+	}
+	manualApproval, err := policy.requiresManualApproval(managedClusterAddonName, managedCluster.Labels)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if manualApproval {
+		reqLogger.Info("csr is left for manual approval by the approval policy", "csrName", csr.Name,
+			"addonName", managedClusterAddonName, "clusterName", clusterName)
+		return reconcile.Result{}, nil
+	}
+
+	// deny csr over the approval rate limit of the addon on the cluster
+	if delay := r.rateLimiter.delay(clusterName, managedClusterAddonName); delay > 0 {
+		r.updateRateLimitedCondition(clusterName, managedClusterAddonName, true)
+		if err := r.denyCSR(csr, deniedReasonRateLimited, fmt.Sprintf(
+			"Addon certificate signing requests of %s exceed the approval rate limit (burst of %d, then one every %s).",
+			managedClusterAddonName, r.rateLimiter.burst, r.rateLimiter.interval)); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: delay}, nil
+	}
+
+	// Auto approve the spoke cluster csr, only approved csrs consume the approval budget
 	if err := r.approveCSR(csr); err != nil {
 		return reconcile.Result{}, err
 	}
+	r.rateLimiter.approved(clusterName, managedClusterAddonName)
+	r.updateRateLimitedCondition(clusterName, managedClusterAddonName, false)
+	metrics.CSRs.WithLabelValues(metrics.ResultApproved).Inc()
 	reqLogger.Info("csr is auto approved by csr controller", "csrName", csr.Name, "addonName", managedClusterAddonName, "clusterName", clusterName)
 	return reconcile.Result{}, nil
 }
 
-// approveCSR approves the given csr
+// getManagedClusterAddOn returns the ManagedClusterAddOn, or nil if it does not exist. An addon created right before
+// its csr may not be in the cache yet, so it is read from the apiserver before being reported as missing
+func (r *ReconcileCSR) getManagedClusterAddOn(clusterName, name string) (*addonv1alpha1.ManagedClusterAddOn, error) {
+	key := types.NamespacedName{Name: name, Namespace: clusterName}
+	managedClusterAddOn := &addonv1alpha1.ManagedClusterAddOn{}
+	err := r.client.Get(context.TODO(), key, managedClusterAddOn)
+	if errors.IsNotFound(err) {
+		err = r.apiReader.Get(context.TODO(), key, managedClusterAddOn)
+	}
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return managedClusterAddOn, nil
+}
+
+// approveCSR approves the given csr, the approval is recorded in the audit trail of the cluster once applied
 func (r *ReconcileCSR) approveCSR(csr *certificatesv1.CertificateSigningRequest) error {
+	reason := "AutoApprovedByHubCSRController"
 	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
 		Type:    certificatesv1.CertificateApproved,
-		Reason:  "AutoApprovedByHubCSRController",
+		Reason:  reason,
 		Message: "Auto approving addon certificate signing request.",
 		Status:  corev1.ConditionTrue,
 	})
-	_, err := r.csrClient.UpdateApproval(context.TODO(), csr.Name, csr, metav1.UpdateOptions{})
-	return err
+	if _, err := r.csrClient.UpdateApproval(context.TODO(), csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	r.auditCSR(csr, CSRDecisionApproved, reason)
+	return nil
 }
 
-func isValidAddonCSR(csr *certificatesv1.CertificateSigningRequest, managedClusterAddonName, clusterName string) bool {
-	block, _ := pem.Decode(csr.Spec.Request)
-	if block == nil || block.Type != "CERTIFICATE REQUEST" {
-		return false
+// denyCSR denies the given csr with the reason & message, and records an event & the metrics of the denial.
+// The denial is recorded in the audit trail of the cluster once applied
+func (r *ReconcileCSR) denyCSR(csr *certificatesv1.CertificateSigningRequest, reason, message string) error {
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:    certificatesv1.CertificateDenied,
+		Reason:  reason,
+		Message: message,
+		Status:  corev1.ConditionTrue,
+	})
+	if _, err := r.csrClient.UpdateApproval(context.TODO(), csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+		return err
 	}
+	r.auditCSR(csr, CSRDecisionDenied, reason)
+	r.recorder.Event(csr, corev1.EventTypeWarning, reason, message)
+	metrics.CSRs.WithLabelValues(metrics.ResultDenied).Inc()
+	metrics.CSRDenials.WithLabelValues(reason).Inc()
+	log.Info("csr is denied by csr controller", "csrName", csr.Name, "reason", reason, "message", message)
+	return nil
+}
 
-	x509cr, err := x509.ParseCertificateRequest(block.Bytes)
+// auditCSR records the decision applied on the csr in the audit trail of the cluster. The decision is not retried
+// once applied, so a failure is reported by an event on the csr
+func (r *ReconcileCSR) auditCSR(csr *certificatesv1.CertificateSigningRequest, decision, reason string) {
+	if err := appendCSRAuditRecord(r.apiReader, r.client, newCSRAuditRecord(csr, decision, reason)); err != nil {
+		log.Error(err, "failed to audit the decision on csr", "csrName", csr.Name, "decision", decision)
+		r.recorder.Event(csr, corev1.EventTypeWarning, "CSRAuditFailed",
+			fmt.Sprintf("Failed to record the %s decision in the audit trail: %v", decision, err))
+	}
+}
+
+// updateRateLimitedCondition sets the Degraded condition of the ManagedClusterAddOn when its csrs exceed the approval
+// rate limit, and removes it when its approval budget is refilled. A Degraded condition with another reason is kept.
+// The ManagedClusterAddOn is read from the apiserver and the update is retried on conflict
+func (r *ReconcileCSR) updateRateLimitedCondition(clusterName, managedClusterAddonName string, limited bool) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		mca := &addonv1alpha1.ManagedClusterAddOn{}
+		if err := r.apiReader.Get(context.TODO(), types.NamespacedName{
+			Name:      managedClusterAddonName,
+			Namespace: clusterName,
+		}, mca); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		degraded := meta.FindStatusCondition(mca.Status.Conditions, managedClusterAddOnDegraded)
+		if limited {
+			if degraded != nil && degraded.Status == metav1.ConditionTrue {
+				return nil
+			}
+			meta.SetStatusCondition(&mca.Status.Conditions, metav1.Condition{
+				Type:    managedClusterAddOnDegraded,
+				Status:  metav1.ConditionTrue,
+				Reason:  degradedReasonCSRRateLimited,
+				Message: "Certificate signing requests of the add-on exceed the approval rate limit, they are denied.",
+			})
+		} else {
+			if degraded == nil || degraded.Reason != degradedReasonCSRRateLimited {
+				return nil
+			}
+			meta.RemoveStatusCondition(&mca.Status.Conditions, managedClusterAddOnDegraded)
+		}
+		return r.client.Status().Update(context.TODO(), mca)
+	})
 	if err != nil {
-		return false
+		log.Error(err, "failed to update the Degraded condition of ManagedClusterAddOn",
+			"namespace", clusterName, "name", managedClusterAddonName)
+	}
+}
+
+// isRateLimited returns true if the csr is denied because it exceeds the approval rate limit
+func isRateLimited(csr *certificatesv1.CertificateSigningRequest) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1.CertificateDenied && condition.Reason == deniedReasonRateLimited {
+			return true
+		}
 	}
+	return false
+}
 
-	if len(x509cr.Subject.Organization) != 1 {
-		return false
+// parseCSRRequest returns the x509 certificate request of the csr
+func parseCSRRequest(csr *certificatesv1.CertificateSigningRequest) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("csr %s does not contain a PEM encoded certificate request", csr.Name)
+	}
+	return x509.ParseCertificateRequest(block.Bytes)
+}
+
+// validateAddonCSR returns the reason & message of the Denied condition if the csr is not a valid addon csr,
+// or an empty reason if it is valid
+func validateAddonCSR(
+	csr *certificatesv1.CertificateSigningRequest,
+	managedClusterAddonName, clusterName string,
+) (reason, message string) {
+	x509cr, err := parseCSRRequest(csr)
+	if err != nil {
+		return deniedReasonInvalidRequest, fmt.Sprintf("Failed to parse the certificate request: %v.", err)
 	}
 
-	organization := x509cr.Subject.Organization[0]
-	if organization != fmt.Sprintf("system:open-cluster-management:cluster:%s:addon:%s", clusterName, managedClusterAddonName) {
-		return false
+	organization := fmt.Sprintf("system:open-cluster-management:cluster:%s:addon:%s", clusterName, managedClusterAddonName)
+	if len(x509cr.Subject.Organization) != 1 || x509cr.Subject.Organization[0] != organization {
+		return deniedReasonInvalidOrganization, fmt.Sprintf("Subject organization %v should be [%s].",
+			x509cr.Subject.Organization, organization)
 	}
 
 	if !strings.HasPrefix(x509cr.Subject.CommonName, organization) {
-		return false
+		return deniedReasonCommonNameMismatch, fmt.Sprintf("Subject common name %q should start with %q.",
+			x509cr.Subject.CommonName, organization)
 	}
 
-	return true
+	return "", ""
 }
@@ -20,20 +20,46 @@ import (
 	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
 	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// cacheClient is a client whose cache does not have the uncached objects yet
+type cacheClient struct {
+	client.Client
+	uncached []runtime.Object
+}
+
+func (c *cacheClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	for _, o := range c.uncached {
+		accessor, err := meta.Accessor(o)
+		if err != nil {
+			return err
+		}
+		uncachedKey := client.ObjectKey{Namespace: accessor.GetNamespace(), Name: accessor.GetName()}
+		if reflect.TypeOf(o) == reflect.TypeOf(obj) && uncachedKey == key {
+			return errors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+	}
+	return c.Client.Get(ctx, key, obj)
+}
+
 func TestReconcileCSR_Reconcile(t *testing.T) {
 	testClusterName := "cluster1"
 	testAddonName := "application-manager"
 	testCSRName := "csr1"
 	testRequester := fmt.Sprintf("system:open-cluster-management:%s:agent1", testClusterName)
+	testPolicyNamespace := "open-cluster-management"
 
 	testscheme := scheme.Scheme
 	_ = managedclusterv1.AddToScheme(testscheme)
@@ -52,12 +78,33 @@ func TestReconcileCSR_Reconcile(t *testing.T) {
 		},
 	}
 
+	acceptedManagedCluster := &managedclusterv1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   testClusterName,
+			Labels: map[string]string{"environment": "production"},
+		},
+		Status: managedclusterv1.ManagedClusterStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   managedclusterv1.ManagedClusterConditionHubAccepted,
+					Status: metav1.ConditionTrue,
+				},
+			},
+		},
+	}
+
 	tests := []struct {
-		name     string
-		initObjs []runtime.Object
-		csr      *certificatesv1.CertificateSigningRequest
-		want     reconcile.Result
-		approved bool
+		name        string
+		initObjs    []runtime.Object
+		uncached    []runtime.Object
+		csr         *certificatesv1.CertificateSigningRequest
+		want        reconcile.Result
+		approved    bool
+		denied      bool
+		rateLimiter *csrRateLimiter
+		degraded    bool
+		// rateLimited is true if the csr is requeued until the approval budget is refilled
+		rateLimited bool
 	}{
 		{
 			name: "denied csr",
@@ -83,8 +130,17 @@ func TestReconcileCSR_Reconcile(t *testing.T) {
 					},
 				},
 			},
-			csr:  newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
-			want: reconcile.Result{Requeue: false},
+			csr:    newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			want:   reconcile.Result{Requeue: false},
+			denied: true,
+		},
+		{
+			name:     "approve csr of addon not in the cache yet",
+			initObjs: []runtime.Object{managedClusterAddOn, acceptedManagedCluster},
+			uncached: []runtime.Object{managedClusterAddOn},
+			csr:      newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			want:     reconcile.Result{},
+			approved: true,
 		},
 		{
 			name: "created by invalid requestor",
@@ -142,15 +198,117 @@ func TestReconcileCSR_Reconcile(t *testing.T) {
 			want:     reconcile.Result{},
 			approved: true,
 		},
+		{
+			name: "deny csr not complying with policy",
+			initObjs: []runtime.Object{
+				managedClusterAddOn,
+				acceptedManagedCluster,
+				newCSRPolicyConfigMap(testPolicyNamespace, "allowedKeyAlgorithms: [RSA]"),
+			},
+			csr:    newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			want:   reconcile.Result{},
+			denied: true,
+		},
+		{
+			name: "leave csr for manual approval",
+			initObjs: []runtime.Object{
+				managedClusterAddOn,
+				acceptedManagedCluster,
+				newCSRPolicyConfigMap(testPolicyNamespace, `
+manualApproval:
+- addons: [application-manager]
+  clusterSelector:
+    matchLabels:
+      environment: production
+`),
+			},
+			csr:  newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			want: reconcile.Result{},
+		},
+		{
+			name: "approve csr complying with policy",
+			initObjs: []runtime.Object{
+				managedClusterAddOn,
+				acceptedManagedCluster,
+				newCSRPolicyConfigMap(testPolicyNamespace, `
+allowedKeyAlgorithms: [ECDSA]
+minECDSAKeySize: 256
+manualApproval:
+- addons: [search-collector]
+`),
+			},
+			csr:      newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			want:     reconcile.Result{},
+			approved: true,
+		},
+		{
+			name:     "deny csr over the approval rate limit",
+			initObjs: []runtime.Object{managedClusterAddOn, acceptedManagedCluster},
+			csr:      newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			rateLimiter: func() *csrRateLimiter {
+				l := newCSRRateLimiter(time.Hour, 1)
+				l.approved(testClusterName, testAddonName)
+				return l
+			}(),
+			denied:      true,
+			degraded:    true,
+			rateLimited: true,
+		},
+		{
+			name: "keep degraded until the approval budget is refilled",
+			initObjs: []runtime.Object{
+				newRateLimitedManagedClusterAddOn(testClusterName, testAddonName),
+				acceptedManagedCluster,
+			},
+			csr: newCSR(testCSRName, testAddonName, testClusterName, testRequester, []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateDenied, Reason: deniedReasonRateLimited},
+			}),
+			rateLimiter: func() *csrRateLimiter {
+				l := newCSRRateLimiter(time.Hour, 1)
+				l.approved(testClusterName, testAddonName)
+				return l
+			}(),
+			degraded:    true,
+			rateLimited: true,
+		},
+		{
+			name: "clear degraded when the approval budget is refilled",
+			initObjs: []runtime.Object{
+				newRateLimitedManagedClusterAddOn(testClusterName, testAddonName),
+				acceptedManagedCluster,
+			},
+			csr: newCSR(testCSRName, testAddonName, testClusterName, testRequester, []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateDenied, Reason: deniedReasonRateLimited},
+			}),
+			rateLimiter: newCSRRateLimiter(time.Hour, 1),
+			want:        reconcile.Result{},
+		},
+		{
+			name: "approve csr of rate limited addon",
+			initObjs: []runtime.Object{
+				newRateLimitedManagedClusterAddOn(testClusterName, testAddonName),
+				acceptedManagedCluster,
+			},
+			csr:         newCSR(testCSRName, testAddonName, testClusterName, testRequester, nil),
+			rateLimiter: newCSRRateLimiter(time.Hour, 1),
+			want:        reconcile.Result{},
+			approved:    true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeKubeClient := kubefake.NewSimpleClientset(tt.csr)
+			recorder := record.NewFakeRecorder(10)
+			apiClient := fake.NewFakeClientWithScheme(testscheme, append(tt.initObjs, tt.csr)...)
 			reconcileCSR := &ReconcileCSR{
-				client:    fake.NewFakeClientWithScheme(testscheme, append(tt.initObjs, tt.csr)...),
-				scheme:    testscheme,
-				csrClient: fakeKubeClient.CertificatesV1().CertificateSigningRequests(),
+				client:          &cacheClient{Client: apiClient, uncached: tt.uncached},
+				apiReader:       apiClient,
+				scheme:          testscheme,
+				csrClient:       fakeKubeClient.CertificatesV1().CertificateSigningRequests(),
+				recorder:        recorder,
+				policyNamespace: testPolicyNamespace,
+				rateLimiter:     tt.rateLimiter,
 			}
 
 			actual, err := reconcileCSR.Reconcile(request)
@@ -158,90 +316,191 @@ func TestReconcileCSR_Reconcile(t *testing.T) {
 				t.Errorf("unexpected error: %v", err)
 			}
 
-			if !reflect.DeepEqual(actual, tt.want) {
+			if tt.rateLimited {
+				if actual.RequeueAfter <= 0 || actual.RequeueAfter > tt.rateLimiter.interval {
+					t.Errorf("expected a requeue within %v but got %v", tt.rateLimiter.interval, actual)
+				}
+			} else if !reflect.DeepEqual(actual, tt.want) {
 				t.Errorf("expected %v but got %v", tt.want, actual)
 			}
 
-			if !tt.approved {
-				return
-			}
-
 			csr, err := fakeKubeClient.CertificatesV1().CertificateSigningRequests().Get(context.Background(), testCSRName, metav1.GetOptions{})
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 			}
 
+			approved, denied := false, false
 			for _, condition := range csr.Status.Conditions {
-				if condition.Type == certificatesv1.CertificateApproved {
-					return
+				switch condition.Type {
+				case certificatesv1.CertificateApproved:
+					approved = true
+				case certificatesv1.CertificateDenied:
+					denied = true
 				}
 			}
+			if tt.approved != approved {
+				t.Errorf("csr approved = %t, want %t", approved, tt.approved)
+			}
+			if tt.denied && !denied {
+				t.Errorf("csr should have been denied")
+			}
+			if tt.denied && len(recorder.Events) != 1 {
+				t.Errorf("an event should have been recorded for the denied csr")
+			}
+
+			mca := &addonv1alpha1.ManagedClusterAddOn{}
+			if err := reconcileCSR.client.Get(context.Background(), types.NamespacedName{
+				Name: testAddonName, Namespace: testClusterName,
+			}, mca); err == nil {
+				degraded := meta.IsStatusConditionTrue(mca.Status.Conditions, managedClusterAddOnDegraded)
+				if degraded != tt.degraded {
+					t.Errorf("ManagedClusterAddOn degraded = %t, want %t", degraded, tt.degraded)
+				}
+			}
+
+			records, err := QueryCSRAudit(reconcileCSR.client, testClusterName, CSRAuditQuery{})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if (tt.approved || tt.denied) != (len(records) == 1) {
+				t.Errorf("expected one audit record for a decision, got %v", records)
+			}
+		})
+	}
+}
+
+func TestReconcileCSR_auditAfterDecision(t *testing.T) {
+	testscheme := scheme.Scheme
+	csr := newCSR("csr1", "application-manager", "cluster1", "system:open-cluster-management:cluster1:agent1", nil)
 
-			t.Errorf("csr should have been approved")
+	tests := []struct {
+		name        string
+		csrObjs     []runtime.Object
+		wantErr     bool
+		wantRecords int
+	}{
+		{
+			name:        "decision applied",
+			csrObjs:     []runtime.Object{csr.DeepCopy()},
+			wantRecords: 1,
+		},
+		{
+			name:        "decision failed to apply",
+			wantErr:     true,
+			wantRecords: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(testscheme)
+			r := &ReconcileCSR{
+				client:    c,
+				apiReader: c,
+				csrClient: kubefake.NewSimpleClientset(tt.csrObjs...).CertificatesV1().CertificateSigningRequests(),
+				recorder:  record.NewFakeRecorder(10),
+			}
+			if err := r.approveCSR(csr.DeepCopy()); (err != nil) != tt.wantErr {
+				t.Errorf("approveCSR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			records, err := QueryCSRAudit(c, "cluster1", CSRAuditQuery{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(records) != tt.wantRecords {
+				t.Errorf("audit records = %v, want %d records", records, tt.wantRecords)
+			}
 		})
 	}
 }
 
-func Test_isValidAddonCSR(t *testing.T) {
+func Test_validateAddonCSR(t *testing.T) {
 	testClusterName := "cluster1"
 	testAddonName := "application-manager"
 	testOrganization := fmt.Sprintf("system:open-cluster-management:cluster:%s:addon:%s", testClusterName, testAddonName)
 
 	tests := []struct {
-		name     string
-		csr      *certificatesv1.CertificateSigningRequest
-		expected bool
+		name       string
+		csr        *certificatesv1.CertificateSigningRequest
+		wantReason string
 	}{
 		{
 			name: "invalid request",
 			csr: &certificatesv1.CertificateSigningRequest{
 				Spec: certificatesv1.CertificateSigningRequestSpec{
-					Request: []byte("invalid csr"),
+					SignerName: certificatesv1.KubeAPIServerClientSignerName,
+					Request:    []byte("invalid csr"),
 				},
 			},
+			wantReason: deniedReasonInvalidRequest,
 		},
 		{
 			name: "invalid organization",
 			csr: &certificatesv1.CertificateSigningRequest{
 				Spec: certificatesv1.CertificateSigningRequestSpec{
-					Request: newCSRRequestData("user1", []string{"org1"}),
+					SignerName: certificatesv1.KubeAPIServerClientSignerName,
+					Request:    newCSRRequestData("user1", []string{"org1"}),
 				},
 			},
+			wantReason: deniedReasonInvalidOrganization,
 		},
 		{
 			name: "invalid common name",
 			csr: &certificatesv1.CertificateSigningRequest{
 				Spec: certificatesv1.CertificateSigningRequestSpec{
+					SignerName: certificatesv1.KubeAPIServerClientSignerName,
 					Request: newCSRRequestData("user1", []string{
 						testOrganization,
 					}),
 				},
 			},
+			wantReason: deniedReasonCommonNameMismatch,
 		},
 		{
 			name: "valid csr",
 			csr: &certificatesv1.CertificateSigningRequest{
 				Spec: certificatesv1.CertificateSigningRequestSpec{
+					SignerName: certificatesv1.KubeAPIServerClientSignerName,
 					Request: newCSRRequestData(
 						fmt.Sprintf("%s:agent:agent1", testOrganization), []string{
 							testOrganization,
 						}),
 				},
 			},
-			expected: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual := isValidAddonCSR(tt.csr, testAddonName, testClusterName)
-			if actual != tt.expected {
-				t.Errorf("expected %v but got %v", tt.expected, actual)
+			reason, message := validateAddonCSR(tt.csr, testAddonName, testClusterName)
+			if reason != tt.wantReason {
+				t.Errorf("expected reason %q but got %q (%s)", tt.wantReason, reason, message)
+			}
+			if reason != "" && message == "" {
+				t.Errorf("expected a message with reason %q", reason)
 			}
 		})
 	}
 }
 
+func newRateLimitedManagedClusterAddOn(clusterName, addonName string) *addonv1alpha1.ManagedClusterAddOn {
+	return &addonv1alpha1.ManagedClusterAddOn{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: clusterName,
+			Name:      addonName,
+		},
+		Status: addonv1alpha1.ManagedClusterAddOnStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   managedClusterAddOnDegraded,
+					Status: metav1.ConditionTrue,
+					Reason: degradedReasonCSRRateLimited,
+				},
+			},
+		},
+	}
+}
+
 func newCSR(csrName, addonName, clusterName, requester string, conditions []certificatesv1.CertificateSigningRequestCondition) *certificatesv1.CertificateSigningRequest {
 
 	organization := fmt.Sprintf("system:open-cluster-management:cluster:%s:addon:%s", clusterName, addonName)
@@ -256,8 +515,9 @@ func newCSR(csrName, addonName, clusterName, requester string, conditions []cert
 			},
 		},
 		Spec: certificatesv1.CertificateSigningRequestSpec{
-			Request:  newCSRRequestData(commonName, []string{organization}),
-			Username: requester,
+			Request:    newCSRRequestData(commonName, []string{organization}),
+			SignerName: certificatesv1.KubeAPIServerClientSignerName,
+			Username:   requester,
 		},
 		Status: certificatesv1.CertificateSigningRequestStatus{
 			Conditions: conditions,
@@ -0,0 +1,168 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package csr
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CSRPolicyConfigMapName is the name of the configmap in the namespace of the controller which contains
+	// the approval policy of addon CSRs
+	CSRPolicyConfigMapName = "klusterlet-addon-csr-policy"
+	// csrPolicyKey is the key of the policy in the configmap
+	csrPolicyKey = "policy.yaml"
+)
+
+// names of the public key algorithms in AllowedKeyAlgorithms
+const (
+	keyAlgorithmRSA     = "RSA"
+	keyAlgorithmECDSA   = "ECDSA"
+	keyAlgorithmEd25519 = "Ed25519"
+)
+
+// CSRApprovalPolicy restricts the addon CSRs approved by the csr controller. Without policy every valid addon CSR
+// of an accepted ManagedCluster is approved
+type CSRApprovalPolicy struct {
+	// ManualApproval lists the addons & clusters whose CSRs are left pending for an administrator to approve
+	ManualApproval []ManualApprovalRule `json:"manualApproval,omitempty"`
+
+	// AllowedUsages are the key usages a CSR can request, any usage is allowed if empty
+	AllowedUsages []certificatesv1.KeyUsage `json:"allowedUsages,omitempty"`
+
+	// AllowedKeyAlgorithms are the public key algorithms (RSA, ECDSA or Ed25519) a CSR can use,
+	// any algorithm is allowed if empty
+	AllowedKeyAlgorithms []string `json:"allowedKeyAlgorithms,omitempty"`
+
+	// MinRSAKeySize is the minimum size in bits of a RSA public key
+	MinRSAKeySize int `json:"minRSAKeySize,omitempty"`
+
+	// MinECDSAKeySize is the minimum size in bits of the curve of an ECDSA public key
+	MinECDSAKeySize int `json:"minECDSAKeySize,omitempty"`
+}
+
+// ManualApprovalRule matches the CSRs of the listed addons on the clusters selected by ClusterSelector.
+// All addons are matched if Addons is empty, and all clusters are matched if ClusterSelector is nil
+type ManualApprovalRule struct {
+	// +optional
+	Addons []string `json:"addons,omitempty"`
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+}
+
+// getCSRApprovalPolicy returns the policy in the given namespace, or nil if there is no policy
+func getCSRApprovalPolicy(c client.Reader, namespace string) (*CSRApprovalPolicy, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: CSRPolicyConfigMapName, Namespace: namespace}, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	policy := &CSRApprovalPolicy{}
+	if err := yaml.Unmarshal([]byte(cm.Data[csrPolicyKey]), policy); err != nil {
+		return nil, fmt.Errorf("invalid %s in configmap %s/%s: %w", csrPolicyKey, namespace, CSRPolicyConfigMapName, err)
+	}
+	return policy, nil
+}
+
+// requiresManualApproval returns true if the CSRs of the addon on the cluster match a manual approval rule
+func (p *CSRApprovalPolicy) requiresManualApproval(addonName string, clusterLabels map[string]string) (bool, error) {
+	if p == nil {
+		return false, nil
+	}
+	for _, rule := range p.ManualApproval {
+		if len(rule.Addons) > 0 && !containsString(rule.Addons, addonName) {
+			continue
+		}
+		if rule.ClusterSelector == nil {
+			return true, nil
+		}
+		selector, err := metav1.LabelSelectorAsSelector(rule.ClusterSelector)
+		if err != nil {
+			return false, err
+		}
+		if selector.Matches(labels.Set(clusterLabels)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkCompliance returns the reasons why the csr does not comply with the policy, nothing if it complies
+func (p *CSRApprovalPolicy) checkCompliance(
+	csr *certificatesv1.CertificateSigningRequest,
+	x509cr *x509.CertificateRequest,
+) []string {
+	if p == nil {
+		return nil
+	}
+	var violations []string
+
+	if len(p.AllowedUsages) > 0 {
+		for _, usage := range csr.Spec.Usages {
+			if !containsKeyUsage(p.AllowedUsages, usage) {
+				violations = append(violations, fmt.Sprintf("usage %q is not allowed", usage))
+			}
+		}
+	}
+
+	algorithm, size := publicKeyAlgorithmAndSize(x509cr)
+	if len(p.AllowedKeyAlgorithms) > 0 && !containsString(p.AllowedKeyAlgorithms, algorithm) {
+		violations = append(violations, fmt.Sprintf("key algorithm %q is not allowed", algorithm))
+	}
+	switch {
+	case algorithm == keyAlgorithmRSA && size < p.MinRSAKeySize:
+		violations = append(violations, fmt.Sprintf("RSA key size %d is less than %d", size, p.MinRSAKeySize))
+	case algorithm == keyAlgorithmECDSA && size < p.MinECDSAKeySize:
+		violations = append(violations, fmt.Sprintf("ECDSA key size %d is less than %d", size, p.MinECDSAKeySize))
+	}
+	return violations
+}
+
+// publicKeyAlgorithmAndSize returns the name of the public key algorithm of the request and its size in bits
+func publicKeyAlgorithmAndSize(x509cr *x509.CertificateRequest) (string, int) {
+	switch key := x509cr.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return keyAlgorithmRSA, key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return keyAlgorithmECDSA, key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return keyAlgorithmEd25519, len(key) * 8
+	}
+	return strings.TrimSpace(x509cr.PublicKeyAlgorithm.String()), 0
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKeyUsage(list []certificatesv1.KeyUsage, usage certificatesv1.KeyUsage) bool {
+	for _, item := range list {
+		if item == usage {
+			return true
+		}
+	}
+	return false
+}
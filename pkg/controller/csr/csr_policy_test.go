@@ -0,0 +1,225 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package csr
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"reflect"
+	"testing"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newCSRPolicyConfigMap(namespace, policy string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CSRPolicyConfigMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{csrPolicyKey: policy},
+	}
+}
+
+func Test_getCSRApprovalPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		objs    []runtime.Object
+		want    *CSRApprovalPolicy
+		wantErr bool
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "policy",
+			objs: []runtime.Object{newCSRPolicyConfigMap("open-cluster-management", `
+allowedUsages: [digital signature, key encipherment, client auth]
+minRSAKeySize: 2048
+manualApproval:
+- addons: [search-collector]
+`)},
+			want: &CSRApprovalPolicy{
+				AllowedUsages: []certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageClientAuth,
+				},
+				MinRSAKeySize:  2048,
+				ManualApproval: []ManualApprovalRule{{Addons: []string{"search-collector"}}},
+			},
+		},
+		{
+			name:    "invalid policy",
+			objs:    []runtime.Object{newCSRPolicyConfigMap("open-cluster-management", "minRSAKeySize: large")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme, tt.objs...)
+			got, err := getCSRApprovalPolicy(c, "open-cluster-management")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getCSRApprovalPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getCSRApprovalPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRApprovalPolicy_requiresManualApproval(t *testing.T) {
+	policy := &CSRApprovalPolicy{
+		ManualApproval: []ManualApprovalRule{
+			{Addons: []string{"search-collector"}},
+			{
+				Addons: []string{"policy-controller"},
+				ClusterSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"environment": "production"},
+				},
+			},
+			{
+				ClusterSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"restricted": "true"},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		policy        *CSRApprovalPolicy
+		addonName     string
+		clusterLabels map[string]string
+		want          bool
+	}{
+		{
+			name:      "no policy",
+			addonName: "search-collector",
+			want:      false,
+		},
+		{
+			name:      "addon on any cluster",
+			policy:    policy,
+			addonName: "search-collector",
+			want:      true,
+		},
+		{
+			name:          "addon on selected cluster",
+			policy:        policy,
+			addonName:     "policy-controller",
+			clusterLabels: map[string]string{"environment": "production"},
+			want:          true,
+		},
+		{
+			name:          "addon on other cluster",
+			policy:        policy,
+			addonName:     "policy-controller",
+			clusterLabels: map[string]string{"environment": "dev"},
+			want:          false,
+		},
+		{
+			name:          "any addon on selected cluster",
+			policy:        policy,
+			addonName:     "work-manager",
+			clusterLabels: map[string]string{"restricted": "true"},
+			want:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.policy.requiresManualApproval(tt.addonName, tt.clusterLabels)
+			if err != nil {
+				t.Fatalf("requiresManualApproval() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("requiresManualApproval() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRApprovalPolicy_checkCompliance(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaRequest := &x509.CertificateRequest{PublicKey: &rsaKey.PublicKey}
+	ecdsaRequest := &x509.CertificateRequest{PublicKey: &ecdsaKey.PublicKey}
+	clientCSR := &certificatesv1.CertificateSigningRequest{
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Usages: []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth},
+		},
+	}
+	serverCSR := &certificatesv1.CertificateSigningRequest{
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Usages: []certificatesv1.KeyUsage{certificatesv1.UsageServerAuth},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *CSRApprovalPolicy
+		csr     *certificatesv1.CertificateSigningRequest
+		request *x509.CertificateRequest
+		want    []string
+	}{
+		{
+			name:    "no policy",
+			csr:     serverCSR,
+			request: rsaRequest,
+		},
+		{
+			name: "compliant",
+			policy: &CSRApprovalPolicy{
+				AllowedUsages: []certificatesv1.KeyUsage{
+					certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth,
+				},
+				AllowedKeyAlgorithms: []string{"ECDSA"},
+				MinECDSAKeySize:      256,
+			},
+			csr:     clientCSR,
+			request: ecdsaRequest,
+		},
+		{
+			name:    "usage not allowed",
+			policy:  &CSRApprovalPolicy{AllowedUsages: []certificatesv1.KeyUsage{certificatesv1.UsageClientAuth}},
+			csr:     serverCSR,
+			request: ecdsaRequest,
+			want:    []string{`usage "server auth" is not allowed`},
+		},
+		{
+			name:    "algorithm not allowed & key too small",
+			policy:  &CSRApprovalPolicy{AllowedKeyAlgorithms: []string{"ECDSA"}, MinRSAKeySize: 2048},
+			csr:     clientCSR,
+			request: rsaRequest,
+			want:    []string{`key algorithm "RSA" is not allowed`, "RSA key size 1024 is less than 2048"},
+		},
+		{
+			name:    "curve too small",
+			policy:  &CSRApprovalPolicy{MinECDSAKeySize: 384},
+			csr:     clientCSR,
+			request: ecdsaRequest,
+			want:    []string{"ECDSA key size 256 is less than 384"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.checkCompliance(tt.csr, tt.request)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkCompliance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -47,7 +47,8 @@ func newCSRPredicate() predicate.Predicate {
 				return false
 			}
 
-			// ignore csr whose signer is not "kubernetes.io/kube-apiserver-client"
+			// ignore csr whose signer is not "kubernetes.io/kube-apiserver-client", the controller is not
+			// allowed to approve or deny the csrs of other signers
 			if csr.Spec.SignerName != certificatesv1.KubeAPIServerClientSignerName {
 				return false
 			}
@@ -55,8 +55,10 @@ func Test_newCSRPredicate(t *testing.T) {
 				},
 				Spec: certificatesv1.CertificateSigningRequestSpec{
 					SignerName: "example.com/signer1",
+					Username:   fmt.Sprintf("system:open-cluster-management:%s:agent1", testClusterName),
 				},
 			},
+			expected: false,
 		},
 		{
 			name: "invalid requester",
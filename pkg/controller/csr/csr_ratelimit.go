@@ -0,0 +1,106 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package csr
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	// ApprovalInterval is the interval at which the approval budget of an addon on a cluster is refilled by one CSR,
+	// the approvals are not limited if it is 0
+	ApprovalInterval = time.Minute
+	// ApprovalBurst is the number of CSRs of an addon on a cluster which can be approved at once
+	ApprovalBurst = 5
+)
+
+// csrRateLimiter limits the approvals of addon CSRs with a token bucket per cluster & addon. The buckets which are
+// full again are dropped, so the buckets of deleted clusters & addons do not pile up
+type csrRateLimiter struct {
+	sync.Mutex
+	interval  time.Duration
+	burst     int
+	limiters  map[string]*rate.Limiter
+	lastPrune time.Time
+}
+
+// newCSRRateLimiter returns a rate limiter refilled by one CSR every interval up to burst CSRs,
+// it returns nil if interval is 0 so no approval is limited
+func newCSRRateLimiter(interval time.Duration, burst int) *csrRateLimiter {
+	if interval <= 0 {
+		return nil
+	}
+	return &csrRateLimiter{
+		interval: interval,
+		burst:    burst,
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// delay returns how long until a CSR of the addon on the cluster can be approved, 0 if it can be approved now.
+// No token is consumed, approved consumes it once the CSR is approved
+func (l *csrRateLimiter) delay(cluster, addon string) time.Duration {
+	if l == nil {
+		return 0
+	}
+	l.Lock()
+	defer l.Unlock()
+	now := time.Now()
+	l.prune(now)
+	limiter, found := l.limiters[cluster+"/"+addon]
+	if !found {
+		return 0
+	}
+	reservation := limiter.ReserveN(now, 1)
+	defer reservation.CancelAt(now)
+	if !reservation.OK() {
+		return l.interval
+	}
+	return reservation.DelayFrom(now)
+}
+
+// approved consumes a token of the addon on the cluster for an approved CSR
+func (l *csrRateLimiter) approved(cluster, addon string) {
+	if l == nil {
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	key := cluster + "/" + addon
+	limiter, found := l.limiters[key]
+	if !found {
+		limiter = rate.NewLimiter(rate.Every(l.interval), l.burst)
+		l.limiters[key] = limiter
+	}
+	limiter.Allow()
+}
+
+// forget drops the bucket of the addon on the cluster, it is called when the addon is deleted
+func (l *csrRateLimiter) forget(cluster, addon string) {
+	if l == nil {
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	delete(l.limiters, cluster+"/"+addon)
+}
+
+// prune drops the full buckets, at most once per interval. A full bucket is the same as a new one
+func (l *csrRateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < l.interval {
+		return
+	}
+	l.lastPrune = now
+	for key, limiter := range l.limiters {
+		reservation := limiter.ReserveN(now, l.burst)
+		full := reservation.OK() && reservation.DelayFrom(now) == 0
+		reservation.CancelAt(now)
+		if full {
+			delete(l.limiters, key)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package csr
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_csrRateLimiter(t *testing.T) {
+	if newCSRRateLimiter(0, 1) != nil {
+		t.Errorf("rate limiter should be disabled when interval is 0")
+	}
+	var disabled *csrRateLimiter
+	for i := 0; i < 10; i++ {
+		disabled.approved("cluster1", "search-collector")
+		if disabled.delay("cluster1", "search-collector") != 0 {
+			t.Fatalf("disabled rate limiter should allow every csr")
+		}
+	}
+
+	l := newCSRRateLimiter(time.Hour, 2)
+	for i := 0; i < 2; i++ {
+		if l.delay("cluster1", "search-collector") != 0 {
+			t.Errorf("csr %d should be allowed within the burst", i)
+		}
+		l.approved("cluster1", "search-collector")
+	}
+	if delay := l.delay("cluster1", "search-collector"); delay <= 0 || delay > time.Hour {
+		t.Errorf("csr over the burst should be delayed up to the interval, got %v", delay)
+	}
+	if l.delay("cluster1", "search-collector") <= 0 {
+		t.Errorf("checking the rate limit should not refill the budget")
+	}
+	if l.delay("cluster1", "work-manager") != 0 {
+		t.Errorf("csr of another addon should be allowed")
+	}
+	if l.delay("cluster2", "search-collector") != 0 {
+		t.Errorf("csr of another cluster should be allowed")
+	}
+
+	l.forget("cluster1", "search-collector")
+	if l.delay("cluster1", "search-collector") != 0 {
+		t.Errorf("csr of a forgotten addon should be allowed")
+	}
+}
+
+func Test_csrRateLimiter_delayDoesNotConsume(t *testing.T) {
+	l := newCSRRateLimiter(time.Hour, 1)
+	for i := 0; i < 5; i++ {
+		if l.delay("cluster1", "search-collector") != 0 {
+			t.Fatalf("csrs which are not approved should not consume the budget")
+		}
+	}
+	l.approved("cluster1", "search-collector")
+	if l.delay("cluster1", "search-collector") == 0 {
+		t.Errorf("approved csr should consume the budget")
+	}
+}
+
+func Test_csrRateLimiter_prune(t *testing.T) {
+	l := newCSRRateLimiter(time.Millisecond, 1)
+	l.approved("cluster1", "search-collector")
+	l.approved("cluster2", "search-collector")
+	time.Sleep(10 * time.Millisecond)
+
+	// the buckets are full again, they are dropped on the next check
+	l.delay("cluster3", "search-collector")
+	if len(l.limiters) != 0 {
+		t.Errorf("full buckets should be pruned, got %d buckets", len(l.limiters))
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Package imagemanifest contains the controller which reloads image manifests from configmaps
+package imagemanifest
+
+import (
+	"context"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var log = logf.Log.WithName("controller_imagemanifest")
+
+// reloadEvents carries a generic event after image manifests are changed. One pending event is enough, every
+// KlusterletAddonConfig is enqueued when it is handled
+var reloadEvents = make(chan event.GenericEvent, 1)
+
+// NewReloadSource returns a source which emits an event every time the image manifests are reloaded with changes,
+// NewReloadHandler maps it to every KlusterletAddonConfig
+func NewReloadSource() source.Source {
+	return &source.Channel{Source: reloadEvents}
+}
+
+// NewReloadHandler returns a handler which enqueues every KlusterletAddonConfig for the events of NewReloadSource
+func NewReloadHandler(c client.Client) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(
+		func(obj handler.MapObject) []reconcile.Request {
+			klusterletAddonConfigList := &agentv1.KlusterletAddonConfigList{}
+			if err := c.List(context.TODO(), klusterletAddonConfigList); err != nil {
+				log.Error(err, "Failed to list klusterletaddonconfigs")
+				return nil
+			}
+			requests := make([]reconcile.Request, 0, len(klusterletAddonConfigList.Items))
+			for _, klusterletAddonConfig := range klusterletAddonConfigList.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+					Name:      klusterletAddonConfig.Name,
+					Namespace: klusterletAddonConfig.Namespace,
+				}})
+			}
+			return requests
+		},
+	)}
+}
+
+// Add creates a new image manifest Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileImageManifest{client: mgr.GetAPIReader(), scheme: mgr.GetScheme(), events: reloadEvents}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("imagemanifest-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to image manifest configmaps. The informer only lists the image manifest configmaps, the
+	// informer of the manager would cache every configmap of the hub
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	informer := coreinformers.NewFilteredConfigMapInformer(clientset, metav1.NamespaceAll, 0, toolscache.Indexers{},
+		func(options *metav1.ListOptions) {
+			options.LabelSelector = labels.SelectorFromSet(agentv1.ImageManifestConfigmapLabelSelector).String()
+		})
+	if err := mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		informer.Run(stop)
+		return nil
+	})); err != nil {
+		return err
+	}
+	if err := c.Watch(
+		&source.Informer{Informer: informer},
+		&handler.EnqueueRequestForObject{},
+		newImageManifestConfigmapPredicate(),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileImageManifest implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileImageManifest{}
+
+// ReconcileImageManifest reloads image manifests when an image manifest configmap is changed
+type ReconcileImageManifest struct {
+	// This client, initialized using mgr.GetAPIReader() above, reads the configmaps from the apiserver so they are
+	// not cached by the manager
+	client client.Reader
+	scheme *runtime.Scheme
+
+	// events is used to re-enqueue all KlusterletAddonConfigs after image manifests are changed
+	events chan<- event.GenericEvent
+}
+
+// Reconcile rebuilds the image manifests from all image manifest configmaps, and re-enqueues every
+// KlusterletAddonConfig if the manifests are changed.
+func (r *ReconcileImageManifest) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling image manifest configmap")
+
+	changed, err := agentv1.ReloadConfigmaps(r.client)
+	if err != nil {
+		reqLogger.Error(err, "Failed to reload image manifests")
+		return reconcile.Result{}, err
+	}
+	if !changed {
+		return reconcile.Result{}, nil
+	}
+
+	reqLogger.Info("Image manifests are changed, requeue all KlusterletAddonConfigs")
+	configmapMeta := &metav1.ObjectMeta{Name: request.Name, Namespace: request.Namespace}
+	select {
+	case r.events <- event.GenericEvent{Meta: configmapMeta}:
+	default:
+		// a reload event is pending, all KlusterletAddonConfigs are enqueued when it is handled
+	}
+
+	return reconcile.Result{}, nil
+}
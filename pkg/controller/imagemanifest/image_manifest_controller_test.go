@@ -0,0 +1,187 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package imagemanifest
+
+import (
+	"context"
+	"testing"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/version"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcileImageManifest_Reconcile(t *testing.T) {
+	testscheme := scheme.Scheme
+
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{}, &agentv1.KlusterletAddonConfigList{})
+
+	testConfigMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				"ocm-configmap-type":  "image-manifest",
+				"ocm-release-version": version.Version,
+			},
+		},
+		Data: map[string]string{
+			"search_collector": "sample-registry/uniquePath/search-collector@sha256:fake-sha256-1",
+		},
+	}
+
+	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: agentv1.SchemeGroupVersion.String(),
+			Kind:       "KlusterletAddonConfig",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-managedcluster",
+			Namespace: "test-managedcluster",
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(testscheme, testConfigMap, testKlusterletAddonConfig)
+	events := make(chan event.GenericEvent, 10)
+	r := &ReconcileImageManifest{client: c, scheme: testscheme, events: events}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-configmap", Namespace: "test-namespace"}}
+
+	t.Run("first load requeues klusterletaddonconfigs", func(t *testing.T) {
+		if _, err := r.Reconcile(req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if len(events) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events))
+		}
+		e := <-events
+		if e.Meta.GetName() != "test-configmap" || e.Meta.GetNamespace() != "test-namespace" {
+			t.Errorf("unexpected event for %s/%s", e.Meta.GetNamespace(), e.Meta.GetName())
+		}
+		image, err := testKlusterletAddonConfig.GetImage("search_collector")
+		if err != nil || image != "sample-registry/uniquePath/search-collector@sha256:fake-sha256-1" {
+			t.Errorf("GetImage() = %s, %v", image, err)
+		}
+	})
+
+	t.Run("no changes does not requeue", func(t *testing.T) {
+		if _, err := r.Reconcile(req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if len(events) != 0 {
+			t.Errorf("expected no event, got %d", len(events))
+		}
+	})
+
+	t.Run("updated configmap is reloaded", func(t *testing.T) {
+		cm := testConfigMap.DeepCopy()
+		cm.Data["search_collector"] = "sample-registry/uniquePath/search-collector@sha256:fake-sha256-2"
+		if err := c.Update(context.TODO(), cm); err != nil {
+			t.Fatalf("failed to update configmap: %v", err)
+		}
+		if _, err := r.Reconcile(req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if len(events) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events))
+		}
+		<-events
+		image, err := testKlusterletAddonConfig.GetImage("search_collector")
+		if err != nil || image != "sample-registry/uniquePath/search-collector@sha256:fake-sha256-2" {
+			t.Errorf("GetImage() = %s, %v", image, err)
+		}
+	})
+
+	t.Run("pending reload event does not block", func(t *testing.T) {
+		pending := make(chan event.GenericEvent, 1)
+		pending <- event.GenericEvent{Meta: &metav1.ObjectMeta{Name: "pending"}}
+		r := &ReconcileImageManifest{client: c, scheme: testscheme, events: pending}
+		cm := &corev1.ConfigMap{}
+		if err := c.Get(context.TODO(), req.NamespacedName, cm); err != nil {
+			t.Fatalf("failed to get configmap: %v", err)
+		}
+		cm.Data["search_collector"] = "sample-registry/uniquePath/search-collector@sha256:fake-sha256-3"
+		if err := c.Update(context.TODO(), cm); err != nil {
+			t.Fatalf("failed to update configmap: %v", err)
+		}
+		if _, err := r.Reconcile(req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if len(pending) != 1 {
+			t.Errorf("expected 1 pending event, got %d", len(pending))
+		}
+	})
+}
+
+func TestNewReloadHandler(t *testing.T) {
+	testscheme := scheme.Scheme
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{}, &agentv1.KlusterletAddonConfigList{})
+
+	c := fake.NewFakeClientWithScheme(testscheme,
+		&agentv1.KlusterletAddonConfig{ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: "cluster1"}},
+		&agentv1.KlusterletAddonConfig{ObjectMeta: metav1.ObjectMeta{Name: "cluster2", Namespace: "cluster2"}},
+	)
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	NewReloadHandler(c).Generic(event.GenericEvent{
+		Meta: &metav1.ObjectMeta{Name: "test-configmap", Namespace: "test-namespace"},
+	}, queue)
+
+	if queue.Len() != 2 {
+		t.Fatalf("expected 2 requests, got %d", queue.Len())
+	}
+	for _, name := range []string{"cluster1", "cluster2"} {
+		item, _ := queue.Get()
+		want := reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: name}}
+		if item != want {
+			t.Errorf("request = %v, want %v", item, want)
+		}
+		queue.Done(item)
+	}
+}
+
+func Test_isImageManifestConfigmap(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  metav1.Object
+		want bool
+	}{
+		{
+			name: "nil object",
+			obj:  nil,
+			want: false,
+		},
+		{
+			name: "no labels",
+			obj:  &corev1.ConfigMap{},
+			want: false,
+		},
+		{
+			name: "image manifest",
+			obj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"ocm-configmap-type": "image-manifest"},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isImageManifestConfigmap(tt.obj); got != tt.want {
+				t.Errorf("isImageManifestConfigmap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
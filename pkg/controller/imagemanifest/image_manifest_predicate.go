@@ -0,0 +1,42 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package imagemanifest
+
+import (
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// isImageManifestConfigmap returns true if the object has all labels of an image manifest configmap
+func isImageManifestConfigmap(obj metav1.Object) bool {
+	if obj == nil {
+		return false
+	}
+	labels := obj.GetLabels()
+	for k, v := range agentv1.ImageManifestConfigmapLabelSelector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// newImageManifestConfigmapPredicate allows image manifest configmaps to reconcile, an update event is allowed
+// if the configmap is an image manifest configmap before or after the update
+func newImageManifestConfigmapPredicate() predicate.Predicate {
+	return predicate.Predicate(predicate.Funcs{
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isImageManifestConfigmap(e.Meta)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isImageManifestConfigmap(e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isImageManifestConfigmap(e.MetaOld) || isImageManifestConfigmap(e.MetaNew)
+		},
+	})
+}
@@ -10,13 +10,17 @@
 package klusterletaddon
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
 	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
-	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
 )
 
 // const for addon operator
@@ -28,6 +32,25 @@ const (
 func createManifestWorkComponentOperator(
 	klusterletaddoncfg *agentv1.KlusterletAddonConfig,
 	r *ReconcileKlusterletAddon) error {
+	manifestWork, err := newComponentOperatorManifestWork(klusterletaddoncfg, r)
+	if err != nil {
+		return err
+	}
+
+	if err := r.applyManifestWork(klusterletaddoncfg, manifestWork,
+		getDeferReason(klusterletaddoncfg, nil, time.Now())); err != nil {
+		log.Error(err, "Failed to create manifest work for component")
+		return err
+	}
+
+	return nil
+}
+
+// newComponentOperatorManifestWork returns the manifest work of the klusterlet addon operator, the image pull secret
+// is copied from the hub
+func newComponentOperatorManifestWork(
+	klusterletaddoncfg *agentv1.KlusterletAddonConfig,
+	r *ReconcileKlusterletAddon) (*manifestworkv1.ManifestWork, error) {
 
 	var manifests []manifestworkv1.Manifest
 
@@ -35,7 +58,7 @@ func createManifestWorkComponentOperator(
 	klusterletaddonNamespace := addonoperator.NewNamespace()
 
 	// Create Component Operator ClusteRole
-	clusterRole := addonoperator.NewClusterRole(klusterletaddoncfg)
+	clusterRole := addonoperator.NewClusterRole(klusterletaddoncfg, getEnabledAddonsClusterRoleRules(klusterletaddoncfg))
 
 	// create cluster role binding
 	clusterRoleBinding := addonoperator.NewClusterRoleBinding(klusterletaddoncfg)
@@ -47,14 +70,18 @@ func createManifestWorkComponentOperator(
 	imagePullSecret, err := addonoperator.NewImagePullSecret(klusterletaddoncfg, r.client)
 	if err != nil {
 		log.Error(err, "Fail to create imagePullSecret")
-		return err
+		r.recorder.Eventf(klusterletaddoncfg, corev1.EventTypeWarning, eventReasonImagePullSecretCopyFailed,
+			"Failed to copy imagePullSecret %s: %v", klusterletaddoncfg.Spec.ImagePullSecret, err)
+		return nil, err
 	}
 
 	// create deployment for klusterlet addon operator
 	deployment, err := addonoperator.NewDeployment(klusterletaddoncfg, addonoperator.KlusterletAddonNamespace)
 	if err != nil {
 		log.Error(err, "Fail to crreate desired klusterlet addon operator deployment")
-		return err
+		r.recorder.Eventf(klusterletaddoncfg, corev1.EventTypeWarning, eventReasonImageResolutionFailed,
+			"Failed to get the image of the klusterlet addon operator: %v", err)
+		return nil, err
 	}
 	// add namespace, clusterrole, clusterrolebinding, serviceaccount
 	nsManifest := manifestworkv1.Manifest{RawExtension: runtime.RawExtension{Object: klusterletaddonNamespace}}
@@ -82,11 +109,17 @@ func createManifestWorkComponentOperator(
 			},
 		},
 	}
+	return manifestWork, nil
+}
 
-	if err := utils.CreateOrUpdateManifestWork(manifestWork, r.client, klusterletaddoncfg, r.scheme); err != nil {
-		log.Error(err, "Failed to create manifest work for component")
-		return err
+// getEnabledAddonsClusterRoleRules returns the rules needed by the enabled addons, so the operator clusterrole
+// shrinks when addons are disabled
+func getEnabledAddonsClusterRoleRules(klusterletaddoncfg *agentv1.KlusterletAddonConfig) []rbacv1.PolicyRule {
+	var rules []rbacv1.PolicyRule
+	for _, addon := range addons.GetAddons() {
+		if addon.IsEnabled(klusterletaddoncfg) {
+			rules = append(rules, addon.GetClusterRoleRules()...)
+		}
 	}
-
-	return nil
+	return rules
 }
@@ -10,16 +10,24 @@ package klusterletaddon
 
 import (
 	"os"
+	"reflect"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	search "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/searchcollector/v1"
+	workmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/workmgr/v1"
 )
 
 func TestMain(m *testing.M) {
@@ -75,7 +83,8 @@ func Test_createManifestWorkComponentOperator(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -140,7 +149,8 @@ func Test_createManifestWorkComponentOperator(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig, testSecret,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 			},
@@ -153,7 +163,8 @@ func Test_createManifestWorkComponentOperator(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig, testWrongSecret,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 			},
@@ -171,3 +182,126 @@ func Test_createManifestWorkComponentOperator(t *testing.T) {
 		})
 	}
 }
+
+func Test_getEnabledAddonsClusterRoleRules(t *testing.T) {
+	searchRules := search.AddonSearch{}.GetClusterRoleRules()
+	workmgrRules := workmgr.AddonWorkMgr{}.GetClusterRoleRules()
+
+	tests := []struct {
+		name string
+		spec agentv1.KlusterletAddonConfigSpec
+		want []rbacv1.PolicyRule
+	}{
+		{
+			name: "only workmgr enabled",
+			spec: agentv1.KlusterletAddonConfigSpec{},
+			want: workmgrRules,
+		},
+		{
+			name: "search enabled",
+			spec: agentv1.KlusterletAddonConfigSpec{
+				SearchCollectorConfig: agentv1.KlusterletAddonConfigSearchCollectorSpec{
+					Enabled: true,
+				},
+			},
+			want: append(append([]rbacv1.PolicyRule{}, searchRules...), workmgrRules...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getEnabledAddonsClusterRoleRules(&agentv1.KlusterletAddonConfig{Spec: tt.spec})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getEnabledAddonsClusterRoleRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_operatorClusterRoleNoWildcard(t *testing.T) {
+	klusterletaddoncfg := &agentv1.KlusterletAddonConfig{
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			SearchCollectorConfig:      agentv1.KlusterletAddonConfigSearchCollectorSpec{Enabled: true},
+			PolicyController:           agentv1.KlusterletAddonConfigPolicyControllerSpec{Enabled: true},
+			ApplicationManagerConfig:   agentv1.KlusterletAddonConfigApplicationManagerSpec{Enabled: true},
+			CertPolicyControllerConfig: agentv1.KlusterletAddonConfigCertPolicyControllerSpec{Enabled: true},
+			IAMPolicyControllerConfig:  agentv1.KlusterletAddonConfigIAMPolicyControllerSpec{Enabled: true},
+		},
+	}
+
+	readOnly := sets.NewString("get", "list", "watch")
+	// the operator can only grant or change the clusterroles of the agents, it cannot bind cluster-admin to itself
+	clusterScopedRBAC := sets.NewString("clusterroles", "clusterrolebindings")
+	byName := sets.NewString("bind", "escalate", "delete", "patch", "update")
+	agentClusterRoles := sets.NewString(
+		"open-cluster-management:klusterlet-addon-appmgr",
+		"open-cluster-management:klusterlet-addon-certpolicyctrl",
+		"open-cluster-management:klusterlet-addon-iampolicyctrl",
+		"open-cluster-management:klusterlet-addon-policyctrl",
+		"open-cluster-management:klusterlet-addon-search",
+		"open-cluster-management:klusterlet-addon-workmgr",
+	)
+	clusterRole := addonoperator.NewClusterRole(klusterletaddoncfg, getEnabledAddonsClusterRoleRules(klusterletaddoncfg))
+	for _, rule := range clusterRole.Rules {
+		if sets.NewString(rule.APIGroups...).Has("rbac.authorization.k8s.io") &&
+			clusterScopedRBAC.HasAny(rule.Resources...) && byName.HasAny(rule.Verbs...) {
+			if len(rule.ResourceNames) == 0 || !agentClusterRoles.HasAll(rule.ResourceNames...) {
+				t.Errorf("rule %v grants %v on clusterroles which are not of an agent", rule, rule.Verbs)
+			}
+		}
+		if sets.NewString(rule.Verbs...).Has("*") {
+			t.Errorf("rule %v grants all verbs", rule)
+		}
+		// search-collector indexes every resource, so only read-only rules may match all resources
+		wildcard := sets.NewString(rule.APIGroups...).Has("*") || sets.NewString(rule.Resources...).Has("*")
+		if wildcard && !readOnly.IsSuperset(sets.NewString(rule.Verbs...)) {
+			t.Errorf("rule %v grants %v on all resources", rule, rule.Verbs)
+		}
+	}
+}
+
+func Test_addonOperatorDeploymentPlacement(t *testing.T) {
+	tolerations := []corev1.Toleration{
+		{
+			Key:      "node-role.kubernetes.io/infra",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("50m"),
+		},
+	}
+	klusterletaddoncfg := &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-managedcluster",
+			Namespace: "test-managedcluster",
+		},
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			Version: "2.3.0",
+			AddonOperatorConfig: &agentv1.KlusterletAddonAgentSpec{
+				NodePlacement: &agentv1.NodePlacement{
+					NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+					Tolerations:  tolerations,
+				},
+				Resources: &resources,
+			},
+		},
+	}
+
+	deployment, err := addonoperator.NewDeployment(klusterletaddoncfg, addonoperator.KlusterletAddonNamespace)
+	if err != nil {
+		t.Fatalf("NewDeployment() error = %v", err)
+	}
+	podSpec := deployment.Spec.Template.Spec
+	if !reflect.DeepEqual(podSpec.NodeSelector, map[string]string{"node-role.kubernetes.io/infra": ""}) {
+		t.Errorf("nodeSelector = %v", podSpec.NodeSelector)
+	}
+	if !reflect.DeepEqual(podSpec.Tolerations, tolerations) {
+		t.Errorf("tolerations = %v, want %v", podSpec.Tolerations, tolerations)
+	}
+	if !reflect.DeepEqual(podSpec.Containers[0].Resources, resources) {
+		t.Errorf("resources = %v, want %v", podSpec.Containers[0].Resources, resources)
+	}
+}
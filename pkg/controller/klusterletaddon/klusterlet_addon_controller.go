@@ -11,7 +11,7 @@ package klusterletaddon
 
 import (
 	"context"
-	"os"
+	"reflect"
 	"strings"
 	"time"
 
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -33,6 +34,8 @@ import (
 	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/imagemanifest"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/metrics"
 	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
 )
 
@@ -51,7 +54,11 @@ func Add(mgr manager.Manager) error {
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	client := newCustomClient(mgr.GetClient(), mgr.GetAPIReader())
-	return &ReconcileKlusterletAddon{client: client, scheme: mgr.GetScheme()}
+	return &ReconcileKlusterletAddon{
+		client:   client,
+		scheme:   mgr.GetScheme(),
+		recorder: mgr.GetEventRecorderFor("klusterletaddon-controller"),
+	}
 }
 
 // customClient will do get secret without cache, other operations are like normal cache client
@@ -108,14 +115,33 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
-	// watch for deletion of managedclusteraddons owned by a klusterletaddonconfig
+	// watch for deletion & condition changes of managedclusteraddons owned by a klusterletaddonconfig
 	err = c.Watch(
 		&source.Kind{Type: &addonv1alpha1.ManagedClusterAddOn{}},
 		&handler.EnqueueRequestForOwner{
 			OwnerType:    &agentv1.KlusterletAddonConfig{},
 			IsController: true,
 		},
-		newManagedClusterAddonDeletionPredicate(),
+		newManagedClusterAddonPredicate(),
+	)
+	if err != nil {
+		return err
+	}
+
+	// requeue all klusterletaddonconfigs when image manifests are reloaded with changes
+	err = c.Watch(imagemanifest.NewReloadSource(), imagemanifest.NewReloadHandler(mgr.GetClient()))
+	if err != nil {
+		return err
+	}
+
+	// watch for status changes of manifestworks owned by a klusterletaddonconfig
+	err = c.Watch(
+		&source.Kind{Type: &manifestworkv1.ManifestWork{}},
+		&handler.EnqueueRequestForOwner{
+			OwnerType:    &agentv1.KlusterletAddonConfig{},
+			IsController: true,
+		},
+		newManifestWorkStatusPredicate(),
 	)
 	if err != nil {
 		return err
@@ -131,8 +157,13 @@ var _ reconcile.Reconciler = &ReconcileKlusterletAddon{}
 type ReconcileKlusterletAddon struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client client.Client
-	scheme *runtime.Scheme
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+	// pendingChanges collects the changes of the ManifestWorks in dry-run mode, it is nil otherwise
+	pendingChanges *[]agentv1.ManifestWorkChange
+	// deferredUpdates collects the ManifestWorks whose update is deferred, with the reason
+	deferredUpdates *[]string
 }
 
 // Reconcile reads that state of the cluster for a KlusterletAddonConfig object
@@ -159,6 +190,8 @@ func (r *ReconcileKlusterletAddon) Reconcile(request reconcile.Request) (reconci
 	klusterletAddonConfig := &agentv1.KlusterletAddonConfig{}
 	if err := r.client.Get(context.TODO(), request.NamespacedName, klusterletAddonConfig); err != nil {
 		if errors.IsNotFound(err) {
+			metrics.DeleteAddonPhases(request.Namespace)
+			metrics.SetUnresolvedImageVersion(request.Namespace, "")
 			// remove finalizer on ManagedCluster if klusterlet not found
 			if !managedClusterIsNotFound && utils.HasFinalizer(managedCluster, KlusterletAddonFinalizer) {
 				utils.RemoveFinalizer(managedCluster, KlusterletAddonFinalizer)
@@ -175,11 +208,15 @@ func (r *ReconcileKlusterletAddon) Reconcile(request reconcile.Request) (reconci
 	if klusterletAddonConfig.DeletionTimestamp != nil {
 		// if ManagedCluster not online, force delete all manifestwork
 		removeFinalizers := managedClusterIsNotFound || !IsManagedClusterOnline(managedCluster)
+		if removeFinalizers {
+			r.recorder.Event(klusterletAddonConfig, corev1.EventTypeWarning, eventReasonFinalizersRemoved,
+				"ManagedCluster is not available, removing the finalizers of the ManifestWorks to delete them")
+		}
 
 		// delete & wait all CRs
 		if isCompleted, err := deleteManifestWorkCRs(klusterletAddonConfig, r.client, removeFinalizers); err != nil {
 			reqLogger.Error(err, "Fail to delete all ManifestWorks for Addon CRs")
-			return reconcile.Result{}, err
+			return r.syncFailed(klusterletAddonConfig, err)
 		} else if !isCompleted {
 			return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, err
 		}
@@ -192,7 +229,7 @@ func (r *ReconcileKlusterletAddon) Reconcile(request reconcile.Request) (reconci
 			removeFinalizers,
 		); err != nil {
 			reqLogger.Error(err, "Fail to delete ManifestWork of Klusterlet Addon Operator")
-			return reconcile.Result{}, err
+			return r.syncFailed(klusterletAddonConfig, err)
 		} else if !isCompleted {
 			return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, err
 		}
@@ -205,7 +242,7 @@ func (r *ReconcileKlusterletAddon) Reconcile(request reconcile.Request) (reconci
 			removeFinalizers,
 		); err != nil {
 			reqLogger.Error(err, "Fail to delete ManifestWork of CRDs")
-			return reconcile.Result{}, err
+			return r.syncFailed(klusterletAddonConfig, err)
 		} else if !isCompleted {
 			return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, err
 		}
@@ -261,54 +298,133 @@ func (r *ReconcileKlusterletAddon) Reconcile(request reconcile.Request) (reconci
 		return reconcile.Result{}, nil
 	}
 
-	// Fill with default imagePullSecret if empty
-	if klusterletAddonConfig.Spec.ImagePullSecret == "" {
-		klusterletAddonConfig.Spec.ImagePullSecret = os.Getenv("DEFAULT_IMAGE_PULL_SECRET")
+	// Older controllers ignored spec.version, it is cleared once from the klusterletaddonconfigs created before it
+	// was honored instead of pinning them to a version which was never validated
+	if !klusterletAddonConfig.IsVersionMigrated() {
+		migrated := klusterletAddonConfig.DeepCopy()
+		legacyVersion := migrated.MigrateVersion()
+		if err := r.client.Update(context.TODO(), migrated); err != nil && errors.IsConflict(err) {
+			return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+		} else if err != nil {
+			reqLogger.Error(err, "Fail to migrate the version of KlusterletAddonConfig")
+			return reconcile.Result{}, err
+		}
+		if legacyVersion != "" {
+			r.recorder.Eventf(migrated, corev1.EventTypeWarning, eventReasonLegacyVersionCleared,
+				"Cleared spec.version %s which was ignored by older controllers, set it again to pin the version",
+				legacyVersion)
+		}
+		klusterletAddonConfig = migrated
+	}
+
+	// The defaulting webhook records the default imagePullSecret, imageRegistry & proxyConfig on create. The
+	// klusterletaddonconfigs created before the webhook or while it is disabled get the current defaults of the
+	// controller recorded once here, changing the defaults does not change their spec afterwards
+	defaulted := klusterletAddonConfig.DeepCopy()
+	defaulted.Default()
+	if !reflect.DeepEqual(defaulted.Spec, klusterletAddonConfig.Spec) {
+		if err := r.client.Update(context.TODO(), defaulted); err != nil && errors.IsConflict(err) {
+			return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+		} else if err != nil {
+			reqLogger.Error(err, "Fail to record the defaults of KlusterletAddonConfig")
+			return reconcile.Result{}, err
+		}
+		r.recorder.Event(defaulted, corev1.EventTypeNormal, eventReasonDefaultsRecorded,
+			"Recorded the default imagePullSecret, imageRegistry & proxyConfig of the controller in the spec")
+		klusterletAddonConfig = defaulted
 	}
 
-	// Fill with default imageRegistry if empty
-	if klusterletAddonConfig.Spec.ImageRegistry == "" {
-		klusterletAddonConfig.Spec.ImageRegistry = os.Getenv("DEFAULT_IMAGE_REGISTRY")
+	// the ManifestWork changes which are not applied are collected for the status
+	rc := *r
+	rc.deferredUpdates = &[]string{}
+	if isDryRun(klusterletAddonConfig) {
+		reqLogger.Info("KlusterletAddonConfig is in dry-run mode, the changes of the ManifestWorks are not applied")
+		rc.pendingChanges = &[]agentv1.ManifestWorkChange{}
 	}
+	r = &rc
 
 	// Create manifest work for crds
 	if err := createManifestWorkCRD(klusterletAddonConfig, managedCluster.Status.Version.Kubernetes, r); err != nil {
 		reqLogger.Error(err, "Fail to create manifest work for CRD")
-		return reconcile.Result{}, err
+		return r.syncFailed(klusterletAddonConfig, err)
 	}
 
 	// Create manifest work for Klusterlet Addon operator
 	if err := createManifestWorkComponentOperator(klusterletAddonConfig, r); err != nil {
 		reqLogger.Error(err, "Fail to create manifest work for klusterlet addon opearator")
-		return reconcile.Result{}, err
+		return r.syncFailed(klusterletAddonConfig, err)
 	}
 
 	// Sync ManagedClusterAddon for component crs according to klusterletAddonConfig enable/disable settings
 	if err := syncManagedClusterAddonCRs(klusterletAddonConfig, r); err != nil {
 		reqLogger.Error(err, "Fail to create ManagedClusterAddon for CRs")
-		return reconcile.Result{}, err
+		return r.syncFailed(klusterletAddonConfig, err)
 	}
 
-	manifestWork, err := utils.GetManifestWork(request.Namespace+KlusterletAddonCRDsPostfix, request.Namespace, r.client)
+	manifestWork, err := getManifestWorkIfExists(request.Namespace+KlusterletAddonCRDsPostfix, request.Namespace, r.client)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	result := reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Minute}
 	if manifestWork != nil && len(manifestWork.Status.Conditions) > 0 {
 		if IsCRDManfestWorkAvailable(manifestWork) {
 			// sync manifestWork for component crs according to klusterletAddonConfig enable/disable settings
 			if err := syncManifestWorkCRs(klusterletAddonConfig, r); err != nil {
 				reqLogger.Error(err, "Fail to create manifest work for CRs")
-				return reconcile.Result{}, err
+				return r.syncFailed(klusterletAddonConfig, err)
 			}
 		} else {
-			return reconcile.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
+			result = reconcile.Result{Requeue: true, RequeueAfter: 30 * time.Second}
 		}
 	} else if IsManagedClusterOnline(managedCluster) {
-		return reconcile.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
+		result = reconcile.Result{Requeue: true, RequeueAfter: 30 * time.Second}
+	}
+
+	// report conditions of CRDs, operator & addons
+	if err := r.updateStatus(klusterletAddonConfig); err != nil && errors.IsConflict(err) {
+		return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+	} else if err != nil {
+		reqLogger.Error(err, "Fail to UPDATE status of KlusterletAddonConfig")
+		return reconcile.Result{}, err
+	}
+
+	// apply the deferred updates as soon as the next maintenance window starts
+	if len(*r.deferredUpdates) > 0 {
+		if d := untilNextMaintenanceWindow(klusterletAddonConfig.Spec.MaintenanceWindows, time.Now()); d > 0 &&
+			d < result.RequeueAfter {
+			result.RequeueAfter = d
+		}
+	}
+
+	return result, nil
+}
+
+// updateStatus reports the conditions of the CRDs, the operator & the addons of the klusterletaddonconfig, with the
+// changes of the ManifestWorks which are not applied
+func (r *ReconcileKlusterletAddon) updateStatus(klusterletAddonConfig *agentv1.KlusterletAddonConfig) error {
+	var pendingChanges []agentv1.ManifestWorkChange
+	if r.pendingChanges != nil {
+		pendingChanges = *r.pendingChanges
 	}
+	var deferredUpdates []string
+	if r.deferredUpdates != nil {
+		deferredUpdates = *r.deferredUpdates
+	}
+	return updateKlusterletAddonConfigStatus(klusterletAddonConfig, r.client, pendingChanges, deferredUpdates)
+}
 
-	return reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Minute}, nil
+// syncFailed updates the status of the klusterletaddonconfig before returning the error of a failed sync, so its
+// conditions & metrics report the ManifestWorks left by the failure instead of going stale
+func (r *ReconcileKlusterletAddon) syncFailed(
+	klusterletAddonConfig *agentv1.KlusterletAddonConfig,
+	err error,
+) (reconcile.Result, error) {
+	if statusErr := r.updateStatus(klusterletAddonConfig); statusErr != nil && !errors.IsConflict(statusErr) {
+		log.Error(statusErr, "Fail to UPDATE status of KlusterletAddonConfig",
+			"Request.Namespace", klusterletAddonConfig.Namespace, "Request.Name", klusterletAddonConfig.Name)
+	}
+	return reconcile.Result{}, err
 }
 
 // IsManagedClusterOnline - if cluster is online returns true otherwise returns false
@@ -354,7 +470,8 @@ func isPaused(instance *agentv1.KlusterletAddonConfig) bool {
 	return false
 }
 
-func newManagedClusterAddonDeletionPredicate() predicate.Predicate {
+// newManagedClusterAddonPredicate allows deletion and condition changes of managedclusteraddons to reconcile
+func newManagedClusterAddonPredicate() predicate.Predicate {
 	return predicate.Predicate(predicate.Funcs{
 		GenericFunc: func(e event.GenericEvent) bool { return false },
 		CreateFunc:  func(e event.CreateEvent) bool { return false },
@@ -365,7 +482,31 @@ func newManagedClusterAddonDeletionPredicate() predicate.Predicate {
 			}
 			return true
 		},
-		UpdateFunc: func(e event.UpdateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			newAddon, okNew := e.ObjectNew.(*addonv1alpha1.ManagedClusterAddOn)
+			oldAddon, okOld := e.ObjectOld.(*addonv1alpha1.ManagedClusterAddOn)
+			if !okNew || !okOld {
+				return false
+			}
+			return !reflect.DeepEqual(newAddon.Status.Conditions, oldAddon.Status.Conditions)
+		},
+	})
+}
+
+// newManifestWorkStatusPredicate allows status changes of manifestworks to reconcile
+func newManifestWorkStatusPredicate() predicate.Predicate {
+	return predicate.Predicate(predicate.Funcs{
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+		CreateFunc:  func(e event.CreateEvent) bool { return false },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			newManifestWork, okNew := e.ObjectNew.(*manifestworkv1.ManifestWork)
+			oldManifestWork, okOld := e.ObjectOld.(*manifestworkv1.ManifestWork)
+			if !okNew || !okOld {
+				return false
+			}
+			return !reflect.DeepEqual(newManifestWork.Status, oldManifestWork.Status)
+		},
 	})
 }
 
@@ -10,7 +10,9 @@ package klusterletaddon
 
 import (
 	"context"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -35,10 +38,12 @@ func TestReconcileKlusterletAddon_Reconcile(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
 	testscheme.AddKnownTypes(managedclusterv1.SchemeGroupVersion, &managedclusterv1.ManagedCluster{})
 	testscheme.AddKnownTypes(ocinfrav1.SchemeGroupVersion, &ocinfrav1.Infrastructure{}, &ocinfrav1.APIServer{})
-	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -197,11 +202,12 @@ func TestReconcileKlusterletAddon_Reconcile(t *testing.T) {
 	}
 
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    reconcile.Result
-		wantErr bool
+		name                 string
+		fields               fields
+		args                 args
+		defaultImageRegistry string
+		want                 reconcile.Result
+		wantErr              bool
 	}{
 		{
 			name: "klusterletaddonconfig do not exist",
@@ -288,13 +294,43 @@ func TestReconcileKlusterletAddon_Reconcile(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success, clear the legacy version and record default imageRegistry once",
+			fields: fields{
+				client: fake.NewFakeClientWithScheme(testscheme,
+					testKlusterletAddonConfig,
+					testManagedCluster,
+					testSecret,
+					infrastructConfig,
+					testManifestWorkCRD,
+					testServiceAccountAppmgr,
+					testServiceAccountWorkmgr),
+				scheme: testscheme,
+			},
+			args: args{
+				request: req,
+			},
+			defaultImageRegistry: "mirror.example.com/ocm",
+			want: reconcile.Result{
+				Requeue:      true,
+				RequeueAfter: 5 * time.Minute,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(100)
 			r := &ReconcileKlusterletAddon{
-				client: tt.fields.client,
-				scheme: tt.fields.scheme,
+				client:   tt.fields.client,
+				scheme:   tt.fields.scheme,
+				recorder: recorder,
+			}
+
+			if tt.defaultImageRegistry != "" {
+				os.Setenv(agentv1.DefaultImageRegistryEnv, tt.defaultImageRegistry)
+				defer os.Unsetenv(agentv1.DefaultImageRegistryEnv)
 			}
 
 			got, err := r.Reconcile(tt.args.request)
@@ -307,10 +343,100 @@ func TestReconcileKlusterletAddon_Reconcile(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ReconcileKlusterletAddon.Reconcile() = %v, want %v", got, tt.want)
 			}
+
+			if tt.defaultImageRegistry != "" {
+				klusterletAddonConfig := &agentv1.KlusterletAddonConfig{}
+				if err := r.client.Get(context.TODO(), req.NamespacedName, klusterletAddonConfig); err != nil {
+					t.Fatalf("failed to get klusterletaddonconfig: %v", err)
+				}
+				if klusterletAddonConfig.Spec.ImageRegistry != tt.defaultImageRegistry {
+					t.Errorf("spec.imageRegistry = %q, want the default %q recorded",
+						klusterletAddonConfig.Spec.ImageRegistry, tt.defaultImageRegistry)
+				}
+				if !klusterletAddonConfig.IsVersionMigrated() || klusterletAddonConfig.Spec.Version != "" {
+					t.Errorf("spec.version = %q, annotations = %v, want the legacy version cleared once",
+						klusterletAddonConfig.Spec.Version, klusterletAddonConfig.Annotations)
+				}
+				if !hasEvent(recorder, eventReasonLegacyVersionCleared) {
+					t.Errorf("no %s event recorded", eventReasonLegacyVersionCleared)
+				}
+
+				// a changed default is not recorded again
+				os.Setenv(agentv1.DefaultImageRegistryEnv, "registry.example.com/ocm")
+				if _, err := r.Reconcile(tt.args.request); err != nil {
+					t.Fatalf("ReconcileKlusterletAddon.Reconcile() error = %v", err)
+				}
+				if err := r.client.Get(context.TODO(), req.NamespacedName, klusterletAddonConfig); err != nil {
+					t.Fatalf("failed to get klusterletaddonconfig: %v", err)
+				}
+				if klusterletAddonConfig.Spec.ImageRegistry != tt.defaultImageRegistry {
+					t.Errorf("spec.imageRegistry = %q, want %q", klusterletAddonConfig.Spec.ImageRegistry,
+						tt.defaultImageRegistry)
+				}
+			}
 		})
 	}
 }
 
+// hasEvent returns true if an event with the reason was recorded, the recorded events are consumed
+func hasEvent(recorder *record.FakeRecorder, reason string) bool {
+	for {
+		select {
+		case e := <-recorder.Events:
+			if strings.Contains(e, " "+reason+" ") {
+				return true
+			}
+		default:
+			return false
+		}
+	}
+}
+
+func TestReconcileKlusterletAddon_syncFailed(t *testing.T) {
+	testscheme := scheme.Scheme
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
+
+	klusterletAddonConfig := &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-managedcluster",
+			Namespace:  "test-managedcluster",
+			Generation: 2,
+		},
+	}
+	r := &ReconcileKlusterletAddon{
+		client:   fake.NewFakeClientWithScheme(testscheme, klusterletAddonConfig),
+		scheme:   testscheme,
+		recorder: record.NewFakeRecorder(10),
+	}
+
+	syncErr := errors.NewServiceUnavailable("failed to create manifestwork")
+	got, err := r.syncFailed(klusterletAddonConfig, syncErr)
+	if err != syncErr {
+		t.Errorf("syncFailed() error = %v, want %v", err, syncErr)
+	}
+	if !reflect.DeepEqual(got, reconcile.Result{}) {
+		t.Errorf("syncFailed() = %v, want an empty result", got)
+	}
+
+	updated := &agentv1.KlusterletAddonConfig{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{
+		Name:      klusterletAddonConfig.Name,
+		Namespace: klusterletAddonConfig.Namespace,
+	}, updated); err != nil {
+		t.Fatalf("failed to get klusterletaddonconfig: %v", err)
+	}
+	if updated.Status.ObservedGeneration != 2 {
+		t.Errorf("observedGeneration = %d, want 2", updated.Status.ObservedGeneration)
+	}
+	if len(updated.Status.Conditions) == 0 {
+		t.Errorf("conditions should be reported when the sync fails")
+	}
+}
+
 func TestIsPaused(t *testing.T) {
 	tests := []struct {
 		name string
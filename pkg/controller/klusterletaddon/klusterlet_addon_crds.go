@@ -20,7 +20,6 @@ import (
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
 	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/bindata"
-	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
 )
 
 // constants for delete work and finalizer
@@ -33,6 +32,22 @@ const (
 func createManifestWorkCRD(klusterletaddonconfig *agentv1.KlusterletAddonConfig,
 	kubeVersion string,
 	r *ReconcileKlusterletAddon) error {
+	manifestWork, err := newCRDManifestWork(klusterletaddonconfig, kubeVersion)
+	if err != nil {
+		return err
+	}
+
+	if err := r.applyManifestWork(klusterletaddonconfig, manifestWork, ""); err != nil {
+		log.Error(err, "Failed to create manifest work for CRD")
+		return err
+	}
+
+	return nil
+}
+
+// newCRDManifestWork returns the manifest work of the CRDs matching the kubernetes version of the managed cluster
+func newCRDManifestWork(klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	kubeVersion string) (*manifestworkv1.ManifestWork, error) {
 
 	allFiles := bindata.AssetNames()
 	installFiles := []string{}
@@ -54,17 +69,17 @@ func createManifestWorkCRD(klusterletaddonconfig *agentv1.KlusterletAddonConfig,
 		kubeV, err = semver.NewVersion(kubeVersion)
 		if err != nil {
 			log.Error(err, "Invalid kubernetes version")
-			return err
+			return nil, err
 		}
 		version, err := semver.NewVersion("1.12.0")
 		if err != nil {
 			log.Error(err, "Invalid version")
-			return err
+			return nil, err
 		}
 		maxversion, err := semver.NewVersion("1.16.0")
 		if err != nil {
 			log.Error(err, "Invalid version")
-			return err
+			return nil, err
 		}
 		if kubeV.LessThan(version) {
 			installFiles = []string{}
@@ -98,12 +113,12 @@ func createManifestWorkCRD(klusterletaddonconfig *agentv1.KlusterletAddonConfig,
 		data, err := bindata.Asset(file)
 		if err != nil {
 			log.Error(err, "Fail to get file "+file)
-			return err
+			return nil, err
 		}
 		b, err := yaml.YAMLToJSON(data)
 		if err != nil {
 			log.Error(err, "Fail to unmarshal crd yaml", "content", data)
-			return err
+			return nil, err
 		}
 		manifest := manifestworkv1.Manifest{RawExtension: runtime.RawExtension{Raw: b}}
 		manifests = append(manifests, manifest)
@@ -121,11 +136,5 @@ func createManifestWorkCRD(klusterletaddonconfig *agentv1.KlusterletAddonConfig,
 			},
 		},
 	}
-
-	if err := utils.CreateOrUpdateManifestWork(manifestWork, r.client, klusterletaddonconfig, r.scheme); err != nil {
-		log.Error(err, "Failed to create manifest work for CRD")
-		return err
-	}
-
-	return nil
+	return manifestWork, nil
 }
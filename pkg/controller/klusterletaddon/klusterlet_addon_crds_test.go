@@ -13,6 +13,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -24,7 +25,8 @@ func Test_createManifestWorkCRD(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -60,7 +62,8 @@ func Test_createManifestWorkCRD(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 				kubeversion:        "1.17.0",
@@ -74,7 +77,8 @@ func Test_createManifestWorkCRD(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 				kubeversion:        "1.11.0",
@@ -88,7 +92,8 @@ func Test_createManifestWorkCRD(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 				kubeversion:        "1.15.0",
@@ -13,20 +13,16 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
 	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
 	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
-	appmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/appmgr/v1"
-	certpolicyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/certpolicycontroller/v1"
-	iampolicyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/iampolicycontroller/v1"
-	policyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/policyctrl/v1"
-	search "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/searchcollector/v1"
-	workmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/workmgr/v1"
-	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/controller/clustermanagementaddon"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
 	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -35,92 +31,206 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-var addonsArray = []addons.KlusterletAddon{
-	appmgr.AddonAppMgr{},
-	certpolicyctrl.AddonCertPolicyCtrl{},
-	iampolicyctrl.AddonIAMPolicyCtrl{},
-	policyctrl.AddonPolicyCtrl{},
-	search.AddonSearch{},
-	workmgr.AddonWorkMgr{},
-}
-
-// newCRManifestWork returns ManifestWork of a component CR
+// newCRManifestWork returns ManifestWork of a component CR, or of the manifests of an addon rendered from a template
 func newCRManifestWork(
 	addon addons.KlusterletAddon,
 	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
 	client client.Client) (*manifestworkv1.ManifestWork, error) {
-	var cr runtime.Object
-
-	var err error
-	cr, err = addon.NewAddonCR(klusterletaddonconfig, addonoperator.KlusterletAddonNamespace)
-
+	objects, err := addons.NewAddonManifests(addon, klusterletaddonconfig, addonoperator.KlusterletAddonNamespace)
 	if err != nil {
 		return nil, err
 	}
 
+	manifests := make([]manifestworkv1.Manifest, 0, len(objects))
+	for _, object := range objects {
+		manifests = append(manifests, manifestworkv1.Manifest{
+			RawExtension: runtime.RawExtension{Object: object},
+		})
+	}
+
 	// construct manifestwork
 	manifestWork := &manifestworkv1.ManifestWork{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      addons.ConstructManifestWorkName(klusterletaddonconfig, addon),
 			Namespace: klusterletaddonconfig.Namespace,
+			Labels:    templateAddonLabels(addon),
 		},
 		Spec: manifestworkv1.ManifestWorkSpec{
 			Workload: manifestworkv1.ManifestsTemplate{
-				Manifests: []manifestworkv1.Manifest{
-					{
-						RawExtension: runtime.RawExtension{Object: cr},
-					},
-				},
+				Manifests: manifests,
 			},
 		},
 	}
 	return manifestWork, nil
 }
 
+// templateAddonLabels returns the labels marking the ManifestWork & ManagedClusterAddOn of a template addon,
+// or nil for the built-in addons
+func templateAddonLabels(addon addons.KlusterletAddon) map[string]string {
+	if _, ok := addon.(registry.ManifestsAddon); !ok {
+		return nil
+	}
+	return map[string]string{registry.TemplateAddonLabel: addon.GetAddonName()}
+}
+
 // syncManifestWorkCRs creates/updates/deletes all CR Manifestworks according to klusterletAddonConfig's configuration
-// loops through all the components, and return the last error if there are errors, or return nil if succeeded
+// loops through all the components, and return the last error if there are errors, or return nil if succeeded.
+// The ManifestWork of an enabled addon is created only when the addons it depends on are available, and the
+// ManifestWork of a disabled addon is deleted only when no enabled addon depends on it and the ManifestWorks of the
+// disabled addons depending on it are gone
 func syncManifestWorkCRs(klusterletaddonconfig *agentv1.KlusterletAddonConfig, r *ReconcileKlusterletAddon) error {
 	var lastErr error
 	lastErr = nil
 
-	for _, addon := range addonsArray {
+	// the status of the enabled addons is only needed to install an addon with dependencies
+	var addonStatus map[string]agentv1.KlusterletAddonStatus
+	waitingForDependencies := func(addon addons.KlusterletAddon, manifestWorkName string) (bool, error) {
+		if len(addon.GetDependencies()) == 0 {
+			return false, nil
+		}
+		mw, err := getManifestWorkIfExists(manifestWorkName, klusterletaddonconfig.Namespace, r.client)
+		if err != nil || mw != nil {
+			return false, err
+		}
+		if addonStatus == nil {
+			if addonStatus, _, err = getEnabledAddonsStatus(klusterletaddonconfig, r.client); err != nil {
+				return false, err
+			}
+		}
+		return len(getUnavailableDependencies(addon, addonStatus)) > 0, nil
+	}
+
+	orderedAddons := addons.GetAddonsInDependencyOrder()
+	// remaining are the addons which are enabled or whose ManifestWork is not deleted yet
+	remaining := make(map[string]bool, len(orderedAddons))
+	for _, addon := range orderedAddons {
 		addonName := addon.GetAddonName()
+		manifestWorkName := addons.ConstructManifestWorkName(klusterletaddonconfig, addon)
 		if addon.IsEnabled(klusterletaddonconfig) {
+			remaining[addonName] = true
+			if waiting, err := waitingForDependencies(addon, manifestWorkName); err != nil {
+				log.Error(err, "Failed to check the dependencies of addon "+addonName)
+				lastErr = err
+				continue
+			} else if waiting {
+				log.V(2).Info("Waiting for the dependencies of addon "+addonName, "dependencies", addon.GetDependencies())
+				continue
+			}
 			// create Manifestwork if enabled
 			if manifestWork, err := newCRManifestWork(addon, klusterletaddonconfig, r.client); err != nil {
+				if _, ok := addon.(registry.ManifestsAddon); ok {
+					r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeWarning, eventReasonManifestRenderFailed,
+						"Failed to render the manifests of addon %s: %v", addonName, err)
+				} else {
+					r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeWarning, eventReasonImageResolutionFailed,
+						"Failed to get the images of addon %s: %v", addonName, err)
+				}
 				lastErr = err
-			} else if err = utils.CreateOrUpdateManifestWork(
-				manifestWork,
-				r.client,
-				klusterletaddonconfig,
-				r.scheme,
-			); err != nil {
+			} else if err := r.applyManifestWork(klusterletaddonconfig, manifestWork,
+				getDeferReason(klusterletaddonconfig, addon, time.Now())); err != nil {
 				log.Error(err, "Failed to create manifest work for addon "+addonName)
 				lastErr = err
 			}
+		}
+	}
+
+	// delete the ManifestWorks of the disabled addons, the addons depending on an addon are deleted first
+	for i := len(orderedAddons) - 1; i >= 0; i-- {
+		addon := orderedAddons[i]
+		addonName := addon.GetAddonName()
+		manifestWorkName := addons.ConstructManifestWorkName(klusterletaddonconfig, addon)
+		if addon.IsEnabled(klusterletaddonconfig) {
+			continue
+		}
+		if r.pendingChanges != nil {
+			// list the deletion of the Manifestwork if disabled in dry-run mode
+			if err := r.addPendingChange(manifestWorkName, klusterletaddonconfig.Namespace, nil); err != nil {
+				log.Error(err, fmt.Sprintf("Failed to get %s ManifestWork", addonName))
+				lastErr = err
+			}
+		} else if hasRemainingDependents(addon, orderedAddons, remaining) {
+			// keep the Manifestwork while an addon depending on it is enabled or being deleted
+			log.V(2).Info("Waiting for the addons depending on disabled addon " + addonName + " to be deleted")
+			remaining[addonName] = true
 		} else {
 			// delete Manifestwork if disabled
-			if err := utils.DeleteManifestWork(
-				addons.ConstructManifestWorkName(klusterletaddonconfig, addon),
-				klusterletaddonconfig.Namespace,
-				r.client,
-				false,
-			); err != nil && !errors.IsNotFound(err) {
+			err := utils.DeleteManifestWork(manifestWorkName, klusterletaddonconfig.Namespace, r.client, false)
+			switch {
+			case err == nil:
+				remaining[addonName] = true
+				r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeNormal, eventReasonManifestWorkDeleted,
+					"Deleted ManifestWork %s of disabled addon %s", manifestWorkName, addonName)
+			case !errors.IsNotFound(err):
+				remaining[addonName] = true
 				log.Error(err, fmt.Sprintf("Failed to delete %s ManifestWork", addonName))
+				r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeWarning, eventReasonManifestWorkFailed,
+					"Failed to delete ManifestWork %s: %v", manifestWorkName, err)
 				lastErr = err
 			}
 		}
 	}
 
+	// delete the Manifestworks of the addons which are not registered anymore
+	unregistered, err := getUnregisteredManifestWorks(klusterletaddonconfig, r.client)
+	if err != nil {
+		log.Error(err, "Failed to list the ManifestWorks of unregistered addons")
+		return err
+	}
+	for _, manifestWorkName := range unregistered {
+		if r.pendingChanges != nil {
+			if err := r.addPendingChange(manifestWorkName, klusterletaddonconfig.Namespace, nil); err != nil {
+				lastErr = err
+			}
+			continue
+		}
+		err := utils.DeleteManifestWork(manifestWorkName, klusterletaddonconfig.Namespace, r.client, false)
+		switch {
+		case err == nil:
+			r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeNormal, eventReasonManifestWorkDeleted,
+				"Deleted ManifestWork %s of unregistered addon", manifestWorkName)
+		case !errors.IsNotFound(err):
+			log.Error(err, "Failed to delete ManifestWork "+manifestWorkName)
+			r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeWarning, eventReasonManifestWorkFailed,
+				"Failed to delete ManifestWork %s: %v", manifestWorkName, err)
+			lastErr = err
+		}
+	}
+
 	return lastErr
 }
 
+// getUnregisteredManifestWorks returns the names of the template addon ManifestWorks of the klusterletaddonconfig
+// whose addon was removed from the registry configmap
+func getUnregisteredManifestWorks(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	c client.Client,
+) ([]string, error) {
+	manifestWorks := &manifestworkv1.ManifestWorkList{}
+	if err := c.List(context.TODO(), manifestWorks, client.InNamespace(klusterletaddonconfig.Namespace),
+		client.HasLabels{registry.TemplateAddonLabel}); err != nil {
+		return nil, err
+	}
+	prefix := addons.ManifestWorkNamePrefix(klusterletaddonconfig)
+	var unregistered []string
+	for i := range manifestWorks.Items {
+		mw := &manifestWorks.Items[i]
+		addonName := mw.Labels[registry.TemplateAddonLabel]
+		if mw.Name != prefix+addonName || !metav1.IsControlledBy(mw, klusterletaddonconfig) {
+			continue
+		}
+		if registry.Removed(addonName) {
+			unregistered = append(unregistered, mw.Name)
+		}
+	}
+	return unregistered, nil
+}
+
 // syncManagedClusterAddonCRs creates/updates/deletes all CR ManagedClusterAddon according to klusterletAddonConfig's configuration
 // loops through all the components, and return the last error if there are errors, or return nil if succeeded
 func syncManagedClusterAddonCRs(klusterletaddonconfig *agentv1.KlusterletAddonConfig, r *ReconcileKlusterletAddon) error {
 	var lastErr error
 	lastErr = nil
-	for _, addon := range addonsArray {
+	for _, addon := range addons.GetAddons() {
 		if addon.IsEnabled(klusterletaddonconfig) {
 			// create ManagedClusterAddon if enabled, and will not block if failed.
 			// created ManagedClusterAddon should has controller reference points to the klusterletaddonconfig
@@ -131,6 +241,32 @@ func syncManagedClusterAddonCRs(klusterletaddonconfig *agentv1.KlusterletAddonCo
 			}
 		}
 	}
+	if r.pendingChanges != nil {
+		return lastErr
+	}
+
+	// delete the ManagedClusterAddons created for the template addons removed from the registry configmap
+	managedClusterAddons := &addonv1alpha1.ManagedClusterAddOnList{}
+	if err := r.client.List(context.TODO(), managedClusterAddons,
+		client.InNamespace(klusterletaddonconfig.Namespace), client.HasLabels{registry.TemplateAddonLabel}); err != nil {
+		log.Error(err, "Failed to list ManagedClusterAddons")
+		return err
+	}
+	for i := range managedClusterAddons.Items {
+		managedClusterAddon := &managedClusterAddons.Items[i]
+		if !metav1.IsControlledBy(managedClusterAddon, klusterletaddonconfig) {
+			continue
+		}
+		if !registry.Removed(managedClusterAddon.Labels[registry.TemplateAddonLabel]) {
+			continue
+		}
+		if err := r.client.Delete(context.TODO(), managedClusterAddon); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete ManagedClusterAddon "+managedClusterAddon.Name)
+			lastErr = err
+			continue
+		}
+		log.Info("Deleted the ManagedClusterAddon of unregistered addon", "name", managedClusterAddon.Name)
+	}
 	return lastErr
 }
 
@@ -162,6 +298,7 @@ func updateManagedClusterAddon(
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      addon.GetManagedClusterAddOnName(),
 				Namespace: klusterletaddonconfig.Namespace,
+				Labels:    templateAddonLabels(addon),
 			},
 			Spec: addonv1alpha1.ManagedClusterAddOnSpec{
 				InstallNamespace: addonoperator.KlusterletAddonNamespace,
@@ -189,10 +326,10 @@ func updateManagedClusterAddon(
 	}
 	addonMeta := addonv1alpha1.AddOnMeta{}
 	addonConf := addonv1alpha1.ConfigCoordinates{}
-	if addonMap, ok := clustermanagementaddon.ClusterManagementAddOnMap[addon.GetManagedClusterAddOnName()]; ok {
-		addonMeta.Description = addonMap.Description
-		addonMeta.DisplayName = addonMap.DisplayName
-		addonConf.CRDName = addonMap.CRDName
+	if registration, ok := registry.Get(addon.GetAddonName()); ok {
+		addonMeta.Description = registration.Description
+		addonMeta.DisplayName = registration.DisplayName
+		addonConf.CRDName = registration.CRDName
 		addonConf.CRName = klusterletaddonconfig.Name
 	}
 
@@ -213,7 +350,9 @@ func updateManagedClusterAddon(
 }
 
 // deleteManifestWorkCRs deletes all CR Manifestworks
-// returns true if deletion of all components is completed or component not found
+// returns true if deletion of all components is completed or component not found.
+// Unless the finalizers are removed, the ManifestWork of an addon is deleted once the ManifestWorks of the addons
+// depending on it are gone
 func deleteManifestWorkCRs(
 	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
 	client client.Client,
@@ -221,7 +360,16 @@ func deleteManifestWorkCRs(
 	allCompleted := true
 	var lastErr error
 	lastErr = nil
-	for _, addon := range addonsArray {
+	orderedAddons := addons.GetAddonsInDependencyOrder()
+	// remaining are the addons whose ManifestWork is not deleted yet
+	remaining := make(map[string]bool, len(orderedAddons))
+	for i := len(orderedAddons) - 1; i >= 0; i-- {
+		addon := orderedAddons[i]
+		if !removeFinalizers && hasRemainingDependents(addon, orderedAddons, remaining) {
+			allCompleted = false
+			remaining[addon.GetAddonName()] = true
+			continue
+		}
 		err := utils.DeleteManifestWork(
 			addons.ConstructManifestWorkName(klusterletaddonconfig, addon),
 			klusterletaddonconfig.Namespace,
@@ -232,9 +380,43 @@ func deleteManifestWorkCRs(
 			continue
 		}
 		allCompleted = false
+		remaining[addon.GetAddonName()] = true
 		if err != nil { // object still exist
 			lastErr = err
 		}
 	}
+	unregistered, err := getUnregisteredManifestWorks(klusterletaddonconfig, client)
+	if err != nil {
+		return false, err
+	}
+	for _, manifestWorkName := range unregistered {
+		err := utils.DeleteManifestWork(manifestWorkName, klusterletaddonconfig.Namespace, client, removeFinalizers)
+		if err != nil && errors.IsNotFound(err) {
+			continue
+		}
+		allCompleted = false
+		if err != nil {
+			lastErr = err
+		}
+	}
 	return allCompleted, lastErr
 }
+
+// hasRemainingDependents returns true if an addon depending on the given addon is in remaining
+func hasRemainingDependents(
+	addon addons.KlusterletAddon,
+	allAddons []addons.KlusterletAddon,
+	remaining map[string]bool,
+) bool {
+	for _, other := range allAddons {
+		if !remaining[other.GetAddonName()] {
+			continue
+		}
+		for _, dependency := range other.GetDependencies() {
+			if dependency == addon.GetAddonName() {
+				return true
+			}
+		}
+	}
+	return false
+}
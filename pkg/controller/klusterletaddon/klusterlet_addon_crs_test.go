@@ -11,6 +11,7 @@ package klusterletaddon
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
 	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
@@ -20,11 +21,14 @@ import (
 	appmgr "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/appmgr/v1"
 	certpolicyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/certpolicycontroller/v1"
 	iampolicyctrl "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/iampolicycontroller/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	templateaddon "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/templateaddon/v1"
 	ocinfrav1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -34,9 +38,11 @@ func Test_syncManifestWorkCRs(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
 	testscheme.AddKnownTypes(ocinfrav1.SchemeGroupVersion, &ocinfrav1.Infrastructure{}, &ocinfrav1.APIServer{})
-	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
 
 	testSecret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
@@ -127,15 +133,21 @@ func Test_syncManifestWorkCRs(t *testing.T) {
 		},
 	}
 
+	pausedKlusterletAddonConfig := testKlusterletAddonConfig.DeepCopy()
+	pausedKlusterletAddonConfig.Spec.PausedAddons = []string{"work-manager"}
+
 	type args struct {
 		r                  *ReconcileKlusterletAddon
 		klusterletaddoncfg *agentv1.KlusterletAddonConfig
 	}
 
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
+		name                string
+		args                args
+		wantErr             bool
+		wantManifestWorks   []string
+		wantNoManifestWorks []string
+		wantDeferredUpdates []string
 	}{
 		{
 			name: "create manifestwork for all components crs",
@@ -145,14 +157,108 @@ func Test_syncManifestWorkCRs(t *testing.T) {
 						testKlusterletAddonConfig, testServiceAccountAppmgr, testServiceAccountWorkmgr,
 						infrastructConfig, testSecret, testConfigMap,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
+				},
+				klusterletaddoncfg: testKlusterletAddonConfig,
+			},
+			wantErr:             false,
+			wantManifestWorks:   []string{"test-managedcluster-klusterlet-addon-workmgr"},
+			wantNoManifestWorks: []string{"test-managedcluster-klusterlet-addon-appmgr"},
+		},
+		{
+			name: "defer the update of the manifestwork of a paused addon",
+			args: args{
+				r: &ReconcileKlusterletAddon{
+					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
+						pausedKlusterletAddonConfig, testServiceAccountAppmgr, testServiceAccountWorkmgr,
+						infrastructConfig, testSecret, testConfigMap,
+						newTestManifestWork("test-managedcluster-klusterlet-addon-workmgr", "test-managedcluster",
+							metav1.ConditionTrue, ""),
+					}...),
+					scheme:          testscheme,
+					recorder:        record.NewFakeRecorder(100),
+					deferredUpdates: &[]string{},
+				},
+				klusterletaddoncfg: pausedKlusterletAddonConfig,
+			},
+			wantErr:           false,
+			wantManifestWorks: []string{"test-managedcluster-klusterlet-addon-appmgr"},
+			wantDeferredUpdates: []string{
+				"test-managedcluster-klusterlet-addon-workmgr (addon work-manager is paused)",
+			},
+		},
+		{
+			name: "create manifestwork of addon whose dependencies are available",
+			args: args{
+				r: &ReconcileKlusterletAddon{
+					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
+						testKlusterletAddonConfig, testServiceAccountAppmgr, testServiceAccountWorkmgr,
+						infrastructConfig, testSecret, testConfigMap,
+						newTestManifestWork("test-managedcluster-klusterlet-addon-workmgr", "test-managedcluster",
+							metav1.ConditionTrue, ""),
+					}...),
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
+				},
+				klusterletaddoncfg: testKlusterletAddonConfig,
+			},
+			wantErr: false,
+			wantManifestWorks: []string{
+				"test-managedcluster-klusterlet-addon-workmgr",
+				"test-managedcluster-klusterlet-addon-appmgr",
+			},
+		},
+		{
+			name: "keep the manifestwork of a disabled addon until the addons depending on it are deleted",
+			args: args{
+				r: &ReconcileKlusterletAddon{
+					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
+						testKlusterletAddonConfig, testServiceAccountAppmgr, testServiceAccountWorkmgr,
+						infrastructConfig, testSecret, testConfigMap,
+						newTestManifestWork("test-managedcluster-klusterlet-addon-policyctrl", "test-managedcluster",
+							metav1.ConditionTrue, ""),
+						newTestManifestWork("test-managedcluster-klusterlet-addon-certpolicyctrl", "test-managedcluster",
+							metav1.ConditionTrue, ""),
+					}...),
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
+				},
+				klusterletaddoncfg: testKlusterletAddonConfig,
+			},
+			wantErr:             false,
+			wantManifestWorks:   []string{"test-managedcluster-klusterlet-addon-policyctrl"},
+			wantNoManifestWorks: []string{"test-managedcluster-klusterlet-addon-certpolicyctrl"},
+		},
+		{
+			name: "delete manifestwork of unregistered addon",
+			args: args{
+				r: &ReconcileKlusterletAddon{
+					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
+						testKlusterletAddonConfig, testServiceAccountAppmgr, testServiceAccountWorkmgr,
+						infrastructConfig, testSecret, testConfigMap,
+						newTestManifestWork("test-managedcluster-klusterlet-addon-operator", "test-managedcluster",
+							metav1.ConditionTrue, ""),
+						newTestTemplateAddonManifestWork("test-managedcluster-klusterlet-addon-removed", "removed",
+							testKlusterletAddonConfig),
+						newTestTemplateAddonManifestWork("test-managedcluster-klusterlet-addon-unowned", "unowned", nil),
+					}...),
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 			},
 			wantErr: false,
+			wantManifestWorks: []string{
+				"test-managedcluster-klusterlet-addon-workmgr",
+				"test-managedcluster-klusterlet-addon-operator",
+				"test-managedcluster-klusterlet-addon-unowned",
+			},
+			wantNoManifestWorks: []string{"test-managedcluster-klusterlet-addon-removed"},
 		},
 	}
 
+	applyTestRegistryConfig(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := syncManifestWorkCRs(tt.args.klusterletaddoncfg, tt.args.r)
@@ -160,15 +266,63 @@ func Test_syncManifestWorkCRs(t *testing.T) {
 				t.Errorf("syncManifestWorkCRs() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			for _, name := range tt.wantManifestWorks {
+				if mw, err := getManifestWorkIfExists(name, "test-managedcluster", tt.args.r.client); err != nil || mw == nil {
+					t.Errorf("ManifestWork %s should be created, error = %v", name, err)
+				}
+			}
+			for _, name := range tt.wantNoManifestWorks {
+				if mw, err := getManifestWorkIfExists(name, "test-managedcluster", tt.args.r.client); err != nil || mw != nil {
+					t.Errorf("ManifestWork %s should not be created, error = %v", name, err)
+				}
+			}
+			if tt.args.r.deferredUpdates != nil && !reflect.DeepEqual(*tt.args.r.deferredUpdates, tt.wantDeferredUpdates) {
+				t.Errorf("deferred updates = %v, want %v", *tt.args.r.deferredUpdates, tt.wantDeferredUpdates)
+			}
+			for _, name := range tt.wantDeferredUpdates {
+				name = strings.Fields(name)[0]
+				if mw, err := getManifestWorkIfExists(name, "test-managedcluster", tt.args.r.client); err != nil ||
+					mw == nil || len(mw.Spec.Workload.Manifests) != 0 {
+					t.Errorf("ManifestWork %s should not be updated, error = %v", name, err)
+				}
+			}
 		})
 	}
 }
 
+// newTestTemplateAddonManifestWork returns the ManifestWork of a template addon, controlled by owner if not nil
+func newTestTemplateAddonManifestWork(
+	name, addonName string,
+	owner *agentv1.KlusterletAddonConfig,
+) *manifestworkv1.ManifestWork {
+	manifestWork := newTestManifestWork(name, "test-managedcluster", metav1.ConditionTrue, "")
+	manifestWork.Labels = map[string]string{registry.TemplateAddonLabel: addonName}
+	if owner != nil {
+		manifestWork.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(owner, agentv1.SchemeGroupVersion.WithKind("KlusterletAddonConfig")),
+		}
+	}
+	return manifestWork
+}
+
+// applyTestRegistryConfig applies a registry configmap without addons to the default registry, so that the
+// objects of the template addons which are not registered are deleted
+func applyTestRegistryConfig(t *testing.T) {
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: registry.ConfigMapName, Namespace: "test-namespace"},
+		Data:       map[string]string{"addons.yaml": "addons: []"},
+	})
+	if err := registry.LoadConfig(c, "test-namespace"); err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+}
+
 func Test_syncManagedClusterAddonCRs(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -190,15 +344,36 @@ func Test_syncManagedClusterAddonCRs(t *testing.T) {
 		},
 	}
 
+	removedManagedClusterAddon := &addonv1alpha1.ManagedClusterAddOn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "removed-agent",
+			Namespace: "test-managedcluster",
+			Labels:    map[string]string{registry.TemplateAddonLabel: "removed-agent"},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(testKlusterletAddonConfig,
+					agentv1.SchemeGroupVersion.WithKind("KlusterletAddonConfig")),
+			},
+		},
+	}
+	otherManagedClusterAddon := &addonv1alpha1.ManagedClusterAddOn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other-agent",
+			Namespace: "test-managedcluster",
+			Labels:    map[string]string{registry.TemplateAddonLabel: "other-agent"},
+		},
+	}
+
 	type args struct {
 		r                  *ReconcileKlusterletAddon
 		klusterletaddoncfg *agentv1.KlusterletAddonConfig
 	}
 
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
+		name                       string
+		args                       args
+		wantErr                    bool
+		wantManagedClusterAddons   []string
+		wantNoManagedClusterAddons []string
 	}{
 		{
 			name: "create ManagedClusterAddons for all components crs in klusterletaddonconfig",
@@ -207,14 +382,33 @@ func Test_syncManagedClusterAddonCRs(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 			},
-			wantErr: false,
+			wantErr:                  false,
+			wantManagedClusterAddons: []string{"application-manager", "search-collector"},
+		},
+		{
+			name: "delete ManagedClusterAddon of unregistered addon",
+			args: args{
+				r: &ReconcileKlusterletAddon{
+					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
+						testKlusterletAddonConfig, removedManagedClusterAddon, otherManagedClusterAddon,
+					}...),
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
+				},
+				klusterletaddoncfg: testKlusterletAddonConfig,
+			},
+			wantErr:                    false,
+			wantManagedClusterAddons:   []string{"application-manager", "other-agent"},
+			wantNoManagedClusterAddons: []string{"removed-agent"},
 		},
 	}
 
+	applyTestRegistryConfig(t)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := syncManagedClusterAddonCRs(tt.args.klusterletaddoncfg, tt.args.r)
@@ -222,6 +416,18 @@ func Test_syncManagedClusterAddonCRs(t *testing.T) {
 				t.Errorf("syncManagedClusterAddonCRs() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			for _, name := range tt.wantManagedClusterAddons {
+				key := types.NamespacedName{Name: name, Namespace: "test-managedcluster"}
+				if err := tt.args.r.client.Get(context.TODO(), key, &addonv1alpha1.ManagedClusterAddOn{}); err != nil {
+					t.Errorf("ManagedClusterAddon %s should exist, error = %v", name, err)
+				}
+			}
+			for _, name := range tt.wantNoManagedClusterAddons {
+				key := types.NamespacedName{Name: name, Namespace: "test-managedcluster"}
+				if err := tt.args.r.client.Get(context.TODO(), key, &addonv1alpha1.ManagedClusterAddOn{}); err == nil {
+					t.Errorf("ManagedClusterAddon %s should be deleted", name)
+				}
+			}
 		})
 	}
 }
@@ -229,7 +435,8 @@ func Test_newCRManifestWork(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
 	testscheme.AddKnownTypes(ocinfrav1.SchemeGroupVersion, &ocinfrav1.Infrastructure{}, &ocinfrav1.APIServer{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
@@ -336,7 +543,8 @@ func Test_newCRManifestWork(t *testing.T) {
 						testKlusterletAddonConfig, testServiceAccountCert, testSecret,
 						infrastructConfig,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 				addon:              certpolicyctrl.AddonCertPolicyCtrl{},
@@ -351,7 +559,8 @@ func Test_newCRManifestWork(t *testing.T) {
 						testKlusterletAddonConfig, testServiceAccountAppmgr, testSecret, infrastructConfig,
 						testServiceAccountIAM,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 				addon:              iampolicyctrl.AddonIAMPolicyCtrl{},
@@ -365,7 +574,8 @@ func Test_newCRManifestWork(t *testing.T) {
 					client: fake.NewFakeClientWithScheme(testscheme, []runtime.Object{
 						testKlusterletAddonConfig, testServiceAccountAppmgr, testSecret, infrastructConfig,
 					}...),
-					scheme: testscheme,
+					scheme:   testscheme,
+					recorder: record.NewFakeRecorder(100),
 				},
 				klusterletaddoncfg: testKlusterletAddonConfig,
 				addon:              appmgr.AddonAppMgr{},
@@ -396,7 +606,8 @@ func Test_deleteManifestWorkCRs(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -517,11 +728,64 @@ func Test_deleteManifestWorkCRs(t *testing.T) {
 
 }
 
+func Test_deleteManifestWorkCRsInDependencyOrder(t *testing.T) {
+	testscheme := scheme.Scheme
+
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
+
+	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-managedcluster",
+			Namespace: "test-managedcluster",
+		},
+	}
+	c := fake.NewFakeClientWithScheme(testscheme,
+		newTestManifestWork("test-managedcluster-klusterlet-addon-workmgr", "test-managedcluster",
+			metav1.ConditionTrue, ""),
+		newTestManifestWork("test-managedcluster-klusterlet-addon-policyctrl", "test-managedcluster",
+			metav1.ConditionTrue, ""),
+		newTestManifestWork("test-managedcluster-klusterlet-addon-iampolicyctrl", "test-managedcluster",
+			metav1.ConditionTrue, ""),
+	)
+
+	// each pass deletes the ManifestWorks whose dependents are gone, the deletion completes when none is left
+	passes := []struct {
+		wantCompleted bool
+		wantRemaining map[string]bool
+	}{
+		{wantRemaining: map[string]bool{"workmgr": true, "policyctrl": true}},
+		{wantRemaining: map[string]bool{"workmgr": true}},
+		{wantRemaining: map[string]bool{}},
+		{wantCompleted: true, wantRemaining: map[string]bool{}},
+	}
+	for i, pass := range passes {
+		completed, err := deleteManifestWorkCRs(testKlusterletAddonConfig, c, false)
+		if err != nil {
+			t.Fatalf("deleteManifestWorkCRs() error = %v", err)
+		}
+		if completed != pass.wantCompleted {
+			t.Errorf("pass %d: deleteManifestWorkCRs() completed = %v, want %v", i, completed, pass.wantCompleted)
+		}
+		for _, name := range []string{"workmgr", "policyctrl", "iampolicyctrl"} {
+			mw, err := getManifestWorkIfExists("test-managedcluster-klusterlet-addon-"+name, "test-managedcluster", c)
+			if err != nil {
+				t.Fatalf("failed to get ManifestWork: %v", err)
+			}
+			if exists := mw != nil; exists != pass.wantRemaining[name] {
+				t.Errorf("pass %d: ManifestWork of %s exists = %v, want %v", i, name, exists, pass.wantRemaining[name])
+			}
+		}
+	}
+}
+
 func Test_updateManagedClusterAddon(t *testing.T) {
 	testscheme := scheme.Scheme
 
 	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
-	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
 
 	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -650,3 +914,17 @@ func Test_updateManagedClusterAddon(t *testing.T) {
 	}
 
 }
+
+func Test_templateAddonLabels(t *testing.T) {
+	templateAddon, err := templateaddon.NewTemplateAddon("my-agent", "", false, nil, nil, "kind: ConfigMap")
+	if err != nil {
+		t.Fatalf("NewTemplateAddon() error = %v", err)
+	}
+	want := map[string]string{registry.TemplateAddonLabel: "my-agent"}
+	if labels := templateAddonLabels(templateAddon); !reflect.DeepEqual(labels, want) {
+		t.Errorf("templateAddonLabels() = %v, want %v", labels, want)
+	}
+	if labels := templateAddonLabels(appmgr.AddonAppMgr{}); labels != nil {
+		t.Errorf("templateAddonLabels() = %v for a built-in addon, want nil", labels)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"fmt"
+	"strings"
+
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
+)
+
+// KlusterletAddonConfigAnnotationDryRun enables the dry-run mode on a single klusterletaddonconfig
+const KlusterletAddonConfigAnnotationDryRun = "klusterletaddonconfig-dry-run"
+
+// DryRun enables the dry-run mode on all klusterletaddonconfigs: the changes of the ManifestWorks are listed in the
+// status of the klusterletaddonconfigs instead of being applied
+var DryRun = false
+
+// isDryRun returns true if the changes of the ManifestWorks of the KlusterletAddonConfig instance are not applied
+func isDryRun(instance *agentv1.KlusterletAddonConfig) bool {
+	return DryRun || strings.EqualFold(instance.GetAnnotations()[KlusterletAddonConfigAnnotationDryRun], "true")
+}
+
+// applyManifestWork creates or updates the manifestwork. An existing manifestwork is not updated when there is a
+// reason to defer its update, and the deferred update is recorded. In dry-run mode, the change is added to the pending
+// changes instead of being applied
+func (r *ReconcileKlusterletAddon) applyManifestWork(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	manifestWork *manifestworkv1.ManifestWork,
+	deferReason string,
+) error {
+	if deferReason != "" {
+		live, err := getManifestWorkIfExists(manifestWork.Name, manifestWork.Namespace, r.client)
+		if err != nil {
+			return err
+		}
+		if live != nil {
+			change, err := utils.DiffManifestWorks(live, manifestWork)
+			if err != nil {
+				return err
+			}
+			if change != nil {
+				log.V(2).Info("Deferring the update of ManifestWork "+manifestWork.Name, "reason", deferReason)
+				if r.deferredUpdates != nil {
+					*r.deferredUpdates = append(*r.deferredUpdates,
+						fmt.Sprintf("%s (%s)", manifestWork.Name, deferReason))
+				}
+			}
+			return nil
+		}
+	}
+	if r.pendingChanges != nil {
+		return r.addPendingChange(manifestWork.Name, klusterletaddonconfig.Namespace, manifestWork)
+	}
+	result, err := utils.CreateOrUpdateManifestWork(manifestWork, r.client, klusterletaddonconfig, r.scheme)
+	r.recordManifestWorkEvent(klusterletaddonconfig, manifestWork.Name, result, err)
+	return err
+}
+
+// addPendingChange adds the change from the live manifestwork to the desired one to the pending changes, desired is
+// nil when the manifestwork is deleted
+func (r *ReconcileKlusterletAddon) addPendingChange(
+	name, namespace string,
+	desired *manifestworkv1.ManifestWork,
+) error {
+	live, err := getManifestWorkIfExists(name, namespace, r.client)
+	if err != nil {
+		return err
+	}
+	change, err := utils.DiffManifestWorks(live, desired)
+	if err != nil || change == nil {
+		return err
+	}
+	*r.pendingChanges = append(*r.pendingChanges, *change)
+	return nil
+}
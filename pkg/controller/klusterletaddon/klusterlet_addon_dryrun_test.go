@@ -0,0 +1,162 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"context"
+	"testing"
+
+	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
+	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	ocinfrav1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestIsDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name: "no dry-run",
+		},
+		{
+			name:        "dry-run annotation",
+			annotations: map[string]string{KlusterletAddonConfigAnnotationDryRun: "True"},
+			want:        true,
+		},
+		{
+			name:        "dry-run annotation disabled",
+			annotations: map[string]string{KlusterletAddonConfigAnnotationDryRun: "false"},
+		},
+		{
+			name:   "dry-run flag",
+			dryRun: true,
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			DryRun = tt.dryRun
+			defer func() { DryRun = false }()
+			instance := &agentv1.KlusterletAddonConfig{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			if got := isDryRun(instance); got != tt.want {
+				t.Errorf("isDryRun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileKlusterletAddon_ReconcileDryRun(t *testing.T) {
+	testscheme := scheme.Scheme
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
+	testscheme.AddKnownTypes(managedclusterv1.SchemeGroupVersion, &managedclusterv1.ManagedCluster{})
+	testscheme.AddKnownTypes(ocinfrav1.SchemeGroupVersion, &ocinfrav1.Infrastructure{}, &ocinfrav1.APIServer{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
+
+	klusterletAddonConfig := &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-managedcluster",
+			Namespace:   "test-managedcluster",
+			Annotations: map[string]string{KlusterletAddonConfigAnnotationDryRun: "true"},
+			Finalizers:  []string{KlusterletAddonFinalizer},
+		},
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			ClusterName:      "test-managedcluster",
+			ClusterNamespace: "test-managedcluster",
+			Version:          "2.0.0",
+		},
+	}
+	klusterletAddonConfig.Default()
+	managedCluster := &managedclusterv1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-managedcluster",
+			Finalizers: []string{KlusterletAddonFinalizer},
+		},
+		Status: managedclusterv1.ManagedClusterStatus{
+			Conditions: []metav1.Condition{{
+				Type:   managedclusterv1.ManagedClusterConditionAvailable,
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	}
+	// the CRDs are applied by an empty ManifestWork & a ManifestWork of a disabled addon is left
+	crdManifestWork := &manifestworkv1.ManifestWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-managedcluster" + KlusterletAddonCRDsPostfix,
+			Namespace: "test-managedcluster",
+		},
+		Status: manifestworkv1.ManifestWorkStatus{
+			Conditions: []metav1.Condition{{Type: "Available", Status: metav1.ConditionTrue}},
+		},
+	}
+	searchManifestWork := &manifestworkv1.ManifestWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-managedcluster-klusterlet-addon-search",
+			Namespace: "test-managedcluster",
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(testscheme, klusterletAddonConfig, managedCluster, crdManifestWork,
+		searchManifestWork)
+	r := &ReconcileKlusterletAddon{client: c, scheme: testscheme, recorder: record.NewFakeRecorder(100)}
+	if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      "test-managedcluster",
+		Namespace: "test-managedcluster",
+	}}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	wantOperations := map[string]string{
+		"test-managedcluster" + KlusterletAddonCRDsPostfix:     agentv1.ChangeOperationUpdate,
+		"test-managedcluster" + KlusterletAddonOperatorPostfix: agentv1.ChangeOperationCreate,
+		"test-managedcluster-klusterlet-addon-workmgr":         agentv1.ChangeOperationCreate,
+		"test-managedcluster-klusterlet-addon-search":          agentv1.ChangeOperationDelete,
+	}
+	got := &agentv1.KlusterletAddonConfig{}
+	if err := c.Get(context.TODO(), types.NamespacedName{
+		Name:      "test-managedcluster",
+		Namespace: "test-managedcluster",
+	}, got); err != nil {
+		t.Fatalf("failed to get klusterletaddonconfig: %v", err)
+	}
+	operations := make(map[string]string)
+	for _, change := range got.Status.PendingChanges {
+		operations[change.Name] = change.Operation
+	}
+	for name, operation := range wantOperations {
+		if operations[name] != operation {
+			t.Errorf("pending change of %s = %q, want %q", name, operations[name], operation)
+		}
+	}
+
+	// nothing is applied
+	for name := range wantOperations {
+		mw, err := getManifestWorkIfExists(name, "test-managedcluster", c)
+		if err != nil {
+			t.Fatalf("failed to get manifestwork %s: %v", name, err)
+		}
+		if mw != nil && len(mw.Spec.Workload.Manifests) != 0 {
+			t.Errorf("dry-run applied manifestwork %s", name)
+		}
+		if mw == nil && operations[name] != agentv1.ChangeOperationCreate {
+			t.Errorf("dry-run deleted manifestwork %s", name)
+		}
+		if mw != nil && operations[name] == agentv1.ChangeOperationCreate {
+			t.Errorf("dry-run created manifestwork %s", name)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// reasons of the events recorded on klusterletaddonconfigs
+const (
+	eventReasonManifestWorkCreated       = "ManifestWorkCreated"
+	eventReasonManifestWorkUpdated       = "ManifestWorkUpdated"
+	eventReasonManifestWorkDeleted       = "ManifestWorkDeleted"
+	eventReasonManifestWorkFailed        = "ManifestWorkFailed"
+	eventReasonImageResolutionFailed     = "ImageResolutionFailed"
+	eventReasonManifestRenderFailed      = "ManifestRenderFailed"
+	eventReasonImagePullSecretCopyFailed = "ImagePullSecretCopyFailed"
+	eventReasonFinalizersRemoved         = "ManifestWorkFinalizersRemoved"
+	eventReasonDefaultsRecorded          = "DefaultsRecorded"
+	eventReasonLegacyVersionCleared      = "LegacyVersionCleared"
+)
+
+// recordManifestWorkEvent records the creation, update or failure of a ManifestWork on the klusterletaddonconfig.
+// Nothing is recorded when the ManifestWork is unchanged
+func (r *ReconcileKlusterletAddon) recordManifestWorkEvent(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	name string,
+	result controllerutil.OperationResult,
+	err error,
+) {
+	if err != nil {
+		r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeWarning, eventReasonManifestWorkFailed,
+			"Failed to apply ManifestWork %s: %v", name, err)
+		return
+	}
+	switch result {
+	case controllerutil.OperationResultCreated:
+		r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeNormal, eventReasonManifestWorkCreated,
+			"Created ManifestWork %s", name)
+	case controllerutil.OperationResultUpdated:
+		r.recorder.Eventf(klusterletaddonconfig, corev1.EventTypeNormal, eventReasonManifestWorkUpdated,
+			"Updated ManifestWork %s", name)
+	}
+}
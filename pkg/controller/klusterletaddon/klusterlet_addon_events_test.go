@@ -0,0 +1,72 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"fmt"
+	"testing"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func Test_recordManifestWorkEvent(t *testing.T) {
+	klusterletaddonconfig := &agentv1.KlusterletAddonConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-managedcluster",
+			Namespace: "test-managedcluster",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		result    controllerutil.OperationResult
+		err       error
+		wantEvent string
+	}{
+		{
+			name:      "created",
+			result:    controllerutil.OperationResultCreated,
+			wantEvent: "Normal ManifestWorkCreated Created ManifestWork test-managedcluster-klusterlet-addon-crds",
+		},
+		{
+			name:      "updated",
+			result:    controllerutil.OperationResultUpdated,
+			wantEvent: "Normal ManifestWorkUpdated Updated ManifestWork test-managedcluster-klusterlet-addon-crds",
+		},
+		{
+			name:   "unchanged",
+			result: controllerutil.OperationResultNone,
+		},
+		{
+			name:   "failed",
+			result: controllerutil.OperationResultNone,
+			err:    fmt.Errorf("conflict"),
+			wantEvent: "Warning ManifestWorkFailed Failed to apply ManifestWork " +
+				"test-managedcluster-klusterlet-addon-crds: conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			r := &ReconcileKlusterletAddon{recorder: recorder}
+			r.recordManifestWorkEvent(klusterletaddonconfig,
+				klusterletaddonconfig.Name+KlusterletAddonCRDsPostfix, tt.result, tt.err)
+
+			select {
+			case event := <-recorder.Events:
+				if event != tt.wantEvent {
+					t.Errorf("recordManifestWorkEvent() event = %q, want %q", event, tt.wantEvent)
+				}
+			default:
+				if tt.wantEvent != "" {
+					t.Errorf("recordManifestWorkEvent() no event, want %q", tt.wantEvent)
+				}
+			}
+		})
+	}
+}
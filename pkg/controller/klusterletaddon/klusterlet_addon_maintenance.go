@@ -0,0 +1,80 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"fmt"
+	"time"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
+)
+
+// getDeferReason returns why the updates of the ManifestWork of the addon are deferred at the given time, addon is
+// nil for the klusterlet addon operator. An empty reason means the ManifestWork is updated. An invalid maintenance
+// window does not defer the updates, it is reported in the Deferred condition instead
+func getDeferReason(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	addon addons.KlusterletAddon,
+	now time.Time,
+) string {
+	if addon != nil && isAddonPaused(klusterletaddonconfig, addon) {
+		return fmt.Sprintf("addon %s is paused", addon.GetManagedClusterAddOnName())
+	}
+	inWindow, next, err := inMaintenanceWindow(klusterletaddonconfig.Spec.MaintenanceWindows, now)
+	switch {
+	case err != nil || inWindow:
+		return ""
+	case next.IsZero():
+		return "out of the maintenance windows"
+	}
+	return fmt.Sprintf("out of the maintenance windows, the next one starts at %s", next.Format(time.RFC3339))
+}
+
+// untilNextMaintenanceWindow returns how long until the next maintenance window starts when the given time is out of
+// the maintenance windows, or 0
+func untilNextMaintenanceWindow(windows []agentv1.MaintenanceWindow, now time.Time) time.Duration {
+	inWindow, next, err := inMaintenanceWindow(windows, now)
+	if err != nil || inWindow || next.IsZero() {
+		return 0
+	}
+	return next.Sub(now)
+}
+
+// isAddonPaused returns true if the addon is listed in the pausedAddons of the klusterletaddonconfig, by addon or
+// ManagedClusterAddOn name
+func isAddonPaused(klusterletaddonconfig *agentv1.KlusterletAddonConfig, addon addons.KlusterletAddon) bool {
+	for _, name := range klusterletaddonconfig.Spec.PausedAddons {
+		if name == addon.GetAddonName() || name == addon.GetManagedClusterAddOnName() {
+			return true
+		}
+	}
+	return false
+}
+
+// inMaintenanceWindow returns true if now is in one of the maintenance windows, or if there is no maintenance window.
+// Otherwise it returns the start of the next window, which is zero if no window ever starts. An invalid window is
+// returned as an error
+func inMaintenanceWindow(windows []agentv1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if len(windows) == 0 {
+		return true, time.Time{}, nil
+	}
+	var next time.Time
+	for i, window := range windows {
+		schedule, location, err := utils.ParseMaintenanceWindow(window)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("maintenance window %d is invalid: %v", i, err)
+		}
+		local := now.In(location)
+		// the window is open if it started less than its duration ago
+		if start := schedule.Next(local.Add(-window.Duration.Duration)); !start.IsZero() && !start.After(local) {
+			return true, time.Time{}, nil
+		}
+		if start := schedule.Next(local); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return false, next, nil
+}
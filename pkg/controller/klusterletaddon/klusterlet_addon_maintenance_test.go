@@ -0,0 +1,124 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"testing"
+	"time"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	searchcollector "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/searchcollector/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getDeferReason(t *testing.T) {
+	// a Saturday
+	now := time.Date(2021, time.March, 13, 3, 0, 0, 0, time.UTC)
+	saturdayNight := agentv1.MaintenanceWindow{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+	sundayNight := agentv1.MaintenanceWindow{Schedule: "0 2 * * 0", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+	// 03:00 UTC is 04:00 in Paris
+	parisNight := agentv1.MaintenanceWindow{
+		Schedule: "0 2 * * *",
+		Duration: metav1.Duration{Duration: time.Hour},
+		TimeZone: "Europe/Paris",
+	}
+
+	tests := []struct {
+		name               string
+		pausedAddons       []string
+		maintenanceWindows []agentv1.MaintenanceWindow
+		addon              addons.KlusterletAddon
+		want               string
+	}{
+		{
+			name:  "no pause & no maintenance window",
+			addon: searchcollector.AddonSearch{},
+		},
+		{
+			name:         "paused by addon name",
+			pausedAddons: []string{"search"},
+			addon:        searchcollector.AddonSearch{},
+			want:         "addon search-collector is paused",
+		},
+		{
+			name:         "paused by ManagedClusterAddOn name",
+			pausedAddons: []string{"search-collector"},
+			addon:        searchcollector.AddonSearch{},
+			want:         "addon search-collector is paused",
+		},
+		{
+			name:         "the operator is not paused",
+			pausedAddons: []string{"search-collector"},
+		},
+		{
+			name:               "in a maintenance window",
+			maintenanceWindows: []agentv1.MaintenanceWindow{sundayNight, saturdayNight},
+		},
+		{
+			name:               "out of the maintenance windows",
+			maintenanceWindows: []agentv1.MaintenanceWindow{sundayNight, parisNight},
+			addon:              searchcollector.AddonSearch{},
+			want:               "out of the maintenance windows, the next one starts at 2021-03-14T02:00:00+01:00",
+		},
+		{
+			name: "invalid maintenance window fails open",
+			maintenanceWindows: []agentv1.MaintenanceWindow{
+				{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			klusterletaddonconfig := &agentv1.KlusterletAddonConfig{
+				Spec: agentv1.KlusterletAddonConfigSpec{
+					PausedAddons:       tt.pausedAddons,
+					MaintenanceWindows: tt.maintenanceWindows,
+				},
+			}
+			if got := getDeferReason(klusterletaddonconfig, tt.addon, now); got != tt.want {
+				t.Errorf("getDeferReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_untilNextMaintenanceWindow(t *testing.T) {
+	// a Saturday
+	now := time.Date(2021, time.March, 13, 3, 0, 0, 0, time.UTC)
+	saturdayNight := agentv1.MaintenanceWindow{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+	sundayNight := agentv1.MaintenanceWindow{Schedule: "0 2 * * 0", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+
+	tests := []struct {
+		name               string
+		maintenanceWindows []agentv1.MaintenanceWindow
+		want               time.Duration
+	}{
+		{
+			name: "no maintenance window",
+		},
+		{
+			name:               "in a maintenance window",
+			maintenanceWindows: []agentv1.MaintenanceWindow{saturdayNight},
+		},
+		{
+			name:               "out of the maintenance windows",
+			maintenanceWindows: []agentv1.MaintenanceWindow{sundayNight},
+			want:               23 * time.Hour,
+		},
+		{
+			name: "invalid maintenance window",
+			maintenanceWindows: []agentv1.MaintenanceWindow{
+				{Schedule: "0 2 * * 0", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := untilNextMaintenanceWindow(tt.maintenanceWindows, now); got != tt.want {
+				t.Errorf("untilNextMaintenanceWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Package klusterletaddon contains the main reconcile function & related functions for klusterletAddonConfigs
+package klusterletaddon
+
+import (
+	"fmt"
+
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RenderManifestWorks returns the ManifestWorks of the CRDs, of the klusterlet addon operator and of the enabled
+// addons, as the controller creates them for the klusterletaddonconfig on a managed cluster of the given kubernetes
+// version. The images are resolved from the loaded image manifests, and the client only has to serve the image pull
+// secret. The ManifestWorks of all enabled addons are returned, even if the controller waits for their dependencies.
+// The klusterletaddonconfig is defaulted like in the controller, on a copy
+func RenderManifestWorks(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	kubeVersion string,
+	c client.Client,
+) ([]*manifestworkv1.ManifestWork, error) {
+	// events are dropped by a recorder without channel
+	r := &ReconcileKlusterletAddon{client: c, recorder: &record.FakeRecorder{}}
+
+	klusterletaddonconfig = klusterletaddonconfig.DeepCopy()
+	klusterletaddonconfig.Default()
+
+	crdManifestWork, err := newCRDManifestWork(klusterletaddonconfig, kubeVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the ManifestWork of the CRDs: %w", err)
+	}
+	operatorManifestWork, err := newComponentOperatorManifestWork(klusterletaddonconfig, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the ManifestWork of the klusterlet addon operator: %w", err)
+	}
+	manifestWorks := []*manifestworkv1.ManifestWork{crdManifestWork, operatorManifestWork}
+
+	for _, addon := range addons.GetAddonsInDependencyOrder() {
+		if !addon.IsEnabled(klusterletaddonconfig) {
+			continue
+		}
+		manifestWork, err := newCRManifestWork(addon, klusterletaddonconfig, c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the ManifestWork of addon %s: %w", addon.GetAddonName(), err)
+		}
+		manifestWorks = append(manifestWorks, manifestWork)
+	}
+
+	for _, manifestWork := range manifestWorks {
+		manifestWork.APIVersion = manifestworkv1.SchemeGroupVersion.String()
+		manifestWork.Kind = "ManifestWork"
+	}
+	return manifestWorks, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+)
+
+func TestRenderManifestWorks(t *testing.T) {
+	newKlusterletAddonConfig := func(imagePullSecret string) *agentv1.KlusterletAddonConfig {
+		return &agentv1.KlusterletAddonConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-managedcluster",
+				Namespace: "test-managedcluster",
+			},
+			Spec: agentv1.KlusterletAddonConfigSpec{
+				ClusterName:      "test-managedcluster",
+				ClusterNamespace: "test-managedcluster",
+				ImagePullSecret:  imagePullSecret,
+				PolicyController: agentv1.KlusterletAddonConfigPolicyControllerSpec{
+					Enabled: true,
+				},
+				SearchCollectorConfig: agentv1.KlusterletAddonConfigSearchCollectorSpec{
+					Enabled: true,
+				},
+				Version: "2.3.0",
+			},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		klusterletaddonconfig *agentv1.KlusterletAddonConfig
+		kubeVersion           string
+		wantNames             []string
+		wantErr               string
+	}{
+		{
+			name:                  "enabled addons in dependency order",
+			klusterletaddonconfig: newKlusterletAddonConfig(""),
+			kubeVersion:           "v1.20.0",
+			wantNames: []string{
+				"test-managedcluster-klusterlet-addon-crds",
+				"test-managedcluster-klusterlet-addon-operator",
+				"test-managedcluster-klusterlet-addon-workmgr",
+				"test-managedcluster-klusterlet-addon-policyctrl",
+				"test-managedcluster-klusterlet-addon-search",
+			},
+		},
+		{
+			name:                  "invalid kubernetes version",
+			klusterletaddonconfig: newKlusterletAddonConfig(""),
+			kubeVersion:           "invalid",
+			wantErr:               "failed to render the ManifestWork of the CRDs",
+		},
+		{
+			name:                  "missing image pull secret",
+			klusterletaddonconfig: newKlusterletAddonConfig("pull-secret"),
+			kubeVersion:           "v1.20.0",
+			wantErr:               "failed to render the ManifestWork of the klusterlet addon operator",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv(agentv1.DefaultImageRegistryEnv, "registry.example.com/ocm")
+			defer os.Unsetenv(agentv1.DefaultImageRegistryEnv)
+			original := tt.klusterletaddonconfig.DeepCopy()
+			manifestWorks, err := RenderManifestWorks(tt.klusterletaddonconfig, tt.kubeVersion,
+				fake.NewFakeClientWithScheme(scheme.Scheme))
+			if !reflect.DeepEqual(tt.klusterletaddonconfig, original) {
+				t.Errorf("RenderManifestWorks() should not modify the klusterletaddonconfig")
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("RenderManifestWorks() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RenderManifestWorks() error = %v", err)
+			}
+			var names []string
+			for _, manifestWork := range manifestWorks {
+				names = append(names, manifestWork.Name)
+				if manifestWork.Kind != "ManifestWork" || len(manifestWork.Spec.Workload.Manifests) == 0 {
+					t.Errorf("RenderManifestWorks() returned an invalid ManifestWork %s", manifestWork.Name)
+				}
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("RenderManifestWorks() = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Package klusterletaddon contains the main reconcile function & related functions for klusterletAddonConfigs
+package klusterletaddon
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/metrics"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reasons of KlusterletAddonConfig conditions
+const (
+	reasonManifestWorkMissing      = "ManifestWorkMissing"
+	reasonManifestWorkApplying     = "ManifestWorkApplying"
+	reasonManifestWorkApplied      = "ManifestWorkApplied"
+	reasonManifestWorkApplyFailed  = "ManifestWorkApplyFailed"
+	reasonAddonsAvailable          = "AddonsAvailable"
+	reasonAddonsProgressing        = "AddonsProgressing"
+	reasonAddonsDegraded           = "AddonsDegraded"
+	reasonDependenciesAvailable    = "DependenciesAvailable"
+	reasonAddonsBlocked            = "AddonsBlocked"
+	reasonNoUpdatesDeferred        = "NoUpdatesDeferred"
+	reasonUpdatesDeferred          = "UpdatesDeferred"
+	reasonInvalidMaintenanceWindow = "InvalidMaintenanceWindow"
+)
+
+// messageManifestWorkNotCreated is the message of an addon whose ManifestWork is not created yet
+const messageManifestWorkNotCreated = "Waiting for ManifestWork to be created."
+
+// names of the conditions on ManagedClusterAddOn used to compute the addon phase
+const (
+	managedClusterAddOnDegraded  = "Degraded"
+	managedClusterAddOnAvailable = "Available"
+)
+
+// updateKlusterletAddonConfigStatus computes conditions & addon status of the given klusterletaddonconfig
+// from its ManifestWorks and ManagedClusterAddOns, records the pending changes of the ManifestWorks in dry-run mode
+// and the deferred updates, and writes the status if anything changed
+func updateKlusterletAddonConfigStatus(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	c client.Client,
+	pendingChanges []agentv1.ManifestWorkChange,
+	deferredUpdates []string,
+) error {
+	newStatus := klusterletaddonconfig.Status.DeepCopy()
+	newStatus.ObservedGeneration = klusterletaddonconfig.Generation
+	if len(pendingChanges) == 0 {
+		pendingChanges = nil
+	}
+	newStatus.PendingChanges = pendingChanges
+	// the version is empty when no loaded image manifest matches, the images of the addons are not resolved then
+	resolvedVersion, err := klusterletaddonconfig.GetImageManifestVersion()
+	if err != nil {
+		metrics.SetUnresolvedImageVersion(klusterletaddonconfig.Namespace, klusterletaddonconfig.GetImageVersion())
+	} else {
+		metrics.SetUnresolvedImageVersion(klusterletaddonconfig.Namespace, "")
+	}
+	newStatus.ResolvedVersion = resolvedVersion
+
+	crdManifestWork, err := getManifestWorkIfExists(
+		klusterletaddonconfig.Name+KlusterletAddonCRDsPostfix, klusterletaddonconfig.Namespace, c)
+	if err != nil {
+		return err
+	}
+	meta.SetStatusCondition(&newStatus.Conditions,
+		newManifestWorkAppliedCondition(agentv1.KlusterletAddonConfigCRDsApplied, crdManifestWork))
+
+	operatorManifestWork, err := getManifestWorkIfExists(
+		klusterletaddonconfig.Name+KlusterletAddonOperatorPostfix, klusterletaddonconfig.Namespace, c)
+	if err != nil {
+		return err
+	}
+	meta.SetStatusCondition(&newStatus.Conditions,
+		newManifestWorkAppliedCondition(agentv1.KlusterletAddonConfigOperatorApplied, operatorManifestWork))
+
+	addonStatus, blocked, err := getEnabledAddonsStatus(klusterletaddonconfig, c)
+	if err != nil {
+		return err
+	}
+	if len(addonStatus) == 0 {
+		addonStatus = nil
+	}
+	newStatus.AddOnStatus = addonStatus
+	setAddonPhasesMetrics(klusterletaddonconfig.Namespace, addonStatus)
+	meta.SetStatusCondition(&newStatus.Conditions, newAddonsReadyCondition(addonStatus))
+	meta.SetStatusCondition(&newStatus.Conditions, newDependenciesSatisfiedCondition(blocked))
+	_, _, windowErr := inMaintenanceWindow(klusterletaddonconfig.Spec.MaintenanceWindows, time.Now())
+	meta.SetStatusCondition(&newStatus.Conditions, newDeferredCondition(deferredUpdates, windowErr))
+
+	if reflect.DeepEqual(klusterletaddonconfig.Status, *newStatus) {
+		return nil
+	}
+	klusterletaddonconfig.Status = *newStatus
+	return c.Status().Update(context.TODO(), klusterletaddonconfig)
+}
+
+// getEnabledAddonsStatus returns the status of the enabled addons keyed by ManagedClusterAddOn name, and the
+// unavailable dependencies of the addons which are not installed because of them
+func getEnabledAddonsStatus(
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	c client.Client,
+) (map[string]agentv1.KlusterletAddonStatus, map[string][]string, error) {
+	addonStatus := make(map[string]agentv1.KlusterletAddonStatus)
+	var enabledAddons []addons.KlusterletAddon
+	for _, addon := range addons.GetAddons() {
+		if !addon.IsEnabled(klusterletaddonconfig) {
+			continue
+		}
+		s, err := getAddonStatus(addon, klusterletaddonconfig, c)
+		if err != nil {
+			return nil, nil, err
+		}
+		addonStatus[addon.GetManagedClusterAddOnName()] = s
+		enabledAddons = append(enabledAddons, addon)
+	}
+
+	blocked := make(map[string][]string)
+	for _, addon := range enabledAddons {
+		name := addon.GetManagedClusterAddOnName()
+		s := addonStatus[name]
+		if s.Phase != agentv1.AddonPhaseProgressing || s.Message != messageManifestWorkNotCreated {
+			continue
+		}
+		if dependencies := getUnavailableDependencies(addon, addonStatus); len(dependencies) > 0 {
+			s.Message = fmt.Sprintf("Waiting for dependencies %s to be available.", strings.Join(dependencies, ", "))
+			addonStatus[name] = s
+			blocked[name] = dependencies
+		}
+	}
+	return addonStatus, blocked, nil
+}
+
+// getUnavailableDependencies returns the ManagedClusterAddOn names of the dependencies of the addon which are
+// not available in the given status of the enabled addons, a disabled dependency is never available
+func getUnavailableDependencies(
+	addon addons.KlusterletAddon,
+	addonStatus map[string]agentv1.KlusterletAddonStatus,
+) []string {
+	var unavailable []string
+	for _, dependency := range addon.GetDependencies() {
+		name := dependency
+		if registration, ok := registry.Get(dependency); ok {
+			name = registration.Addon.GetManagedClusterAddOnName()
+		}
+		if addonStatus[name].Phase != agentv1.AddonPhaseAvailable {
+			unavailable = append(unavailable, name)
+		}
+	}
+	return unavailable
+}
+
+// getManifestWorkIfExists returns the manifestwork, or nil if it is not found
+func getManifestWorkIfExists(name, namespace string, c client.Client) (*manifestworkv1.ManifestWork, error) {
+	mw := &manifestworkv1.ManifestWork{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, mw); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mw, nil
+}
+
+// newManifestWorkAppliedCondition returns a condition of the given type based on the Applied condition
+// of the given manifestwork
+func newManifestWorkAppliedCondition(conditionType string, mw *manifestworkv1.ManifestWork) metav1.Condition {
+	condition := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  reasonManifestWorkApplying,
+		Message: "Waiting for manifests to be applied.",
+	}
+	if mw == nil {
+		condition.Reason = reasonManifestWorkMissing
+		condition.Message = "ManifestWork is not created yet."
+		return condition
+	}
+	if c := meta.FindStatusCondition(mw.Status.Conditions, manifestworkv1.WorkApplied); c != nil {
+		switch c.Status {
+		case metav1.ConditionTrue:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = reasonManifestWorkApplied
+			condition.Message = fmt.Sprintf("ManifestWork %s is applied.", mw.Name)
+		case metav1.ConditionFalse:
+			condition.Reason = reasonManifestWorkApplyFailed
+			condition.Message = fmt.Sprintf("ManifestWork %s failed to apply: %s", mw.Name, c.Message)
+		}
+	}
+	return condition
+}
+
+// getAddonStatus returns the phase & message of an enabled addon based on its ManifestWork
+// and its ManagedClusterAddOn
+func getAddonStatus(
+	addon addons.KlusterletAddon,
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+	c client.Client,
+) (agentv1.KlusterletAddonStatus, error) {
+	mw, err := getManifestWorkIfExists(
+		addons.ConstructManifestWorkName(klusterletaddonconfig, addon), klusterletaddonconfig.Namespace, c)
+	if err != nil {
+		return agentv1.KlusterletAddonStatus{}, err
+	}
+
+	mca := &addonv1alpha1.ManagedClusterAddOn{}
+	if err := c.Get(context.TODO(), types.NamespacedName{
+		Name:      addon.GetManagedClusterAddOnName(),
+		Namespace: klusterletaddonconfig.Namespace,
+	}, mca); err != nil && !errors.IsNotFound(err) {
+		return agentv1.KlusterletAddonStatus{}, err
+	}
+
+	// degraded reported by ManagedClusterAddOn has the highest priority
+	if cond := meta.FindStatusCondition(mca.Status.Conditions, managedClusterAddOnDegraded); cond != nil &&
+		cond.Status == metav1.ConditionTrue {
+		return agentv1.KlusterletAddonStatus{
+			Phase:           agentv1.AddonPhaseDegraded,
+			Message:         cond.Message,
+			FailedManifests: utils.GetManifestFailures(mw),
+		}, nil
+	}
+
+	if mw == nil {
+		return agentv1.KlusterletAddonStatus{
+			Phase:   agentv1.AddonPhaseProgressing,
+			Message: messageManifestWorkNotCreated,
+		}, nil
+	}
+
+	applied := meta.FindStatusCondition(mw.Status.Conditions, manifestworkv1.WorkApplied)
+	if applied == nil {
+		return agentv1.KlusterletAddonStatus{
+			Phase:   agentv1.AddonPhaseProgressing,
+			Message: "Installing manifests.",
+		}, nil
+	}
+	if applied.Status == metav1.ConditionFalse {
+		return agentv1.KlusterletAddonStatus{
+			Phase:           agentv1.AddonPhaseDegraded,
+			Message:         applied.Message,
+			FailedManifests: utils.GetManifestFailures(mw),
+		}, nil
+	}
+
+	if cond := meta.FindStatusCondition(mca.Status.Conditions, managedClusterAddOnAvailable); cond != nil {
+		switch cond.Status {
+		case metav1.ConditionFalse:
+			return agentv1.KlusterletAddonStatus{Phase: agentv1.AddonPhaseDegraded, Message: cond.Message}, nil
+		case metav1.ConditionUnknown:
+			return agentv1.KlusterletAddonStatus{Phase: agentv1.AddonPhaseProgressing, Message: cond.Message}, nil
+		}
+	}
+
+	return agentv1.KlusterletAddonStatus{
+		Phase:   agentv1.AddonPhaseAvailable,
+		Message: "All manifests are installed.",
+	}, nil
+}
+
+// newAddonsReadyCondition returns the AddonsReady condition, it is true only when all enabled addons are available
+func newAddonsReadyCondition(addonStatus map[string]agentv1.KlusterletAddonStatus) metav1.Condition {
+	var degraded, progressing []string
+	for name, s := range addonStatus {
+		switch s.Phase {
+		case agentv1.AddonPhaseDegraded:
+			degraded = append(degraded, name)
+		case agentv1.AddonPhaseProgressing:
+			progressing = append(progressing, name)
+		}
+	}
+	sort.Strings(degraded)
+	sort.Strings(progressing)
+
+	if len(degraded) > 0 {
+		return metav1.Condition{
+			Type:    agentv1.KlusterletAddonConfigAddonsReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  reasonAddonsDegraded,
+			Message: "Degraded addons: " + strings.Join(degraded, ", ") + ".",
+		}
+	}
+	if len(progressing) > 0 {
+		return metav1.Condition{
+			Type:    agentv1.KlusterletAddonConfigAddonsReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  reasonAddonsProgressing,
+			Message: "Progressing addons: " + strings.Join(progressing, ", ") + ".",
+		}
+	}
+	return metav1.Condition{
+		Type:    agentv1.KlusterletAddonConfigAddonsReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  reasonAddonsAvailable,
+		Message: "All enabled addons are available.",
+	}
+}
+
+// newDependenciesSatisfiedCondition returns the DependenciesSatisfied condition, it is false when the install of
+// an enabled addon waits for its dependencies to be available
+func newDependenciesSatisfiedCondition(blocked map[string][]string) metav1.Condition {
+	if len(blocked) == 0 {
+		return metav1.Condition{
+			Type:    agentv1.KlusterletAddonConfigDependenciesSatisfied,
+			Status:  metav1.ConditionTrue,
+			Reason:  reasonDependenciesAvailable,
+			Message: "The dependencies of all enabled addons are available.",
+		}
+	}
+	names := make([]string, 0, len(blocked))
+	for name := range blocked {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	waiting := make([]string, 0, len(names))
+	for _, name := range names {
+		waiting = append(waiting, fmt.Sprintf("%s (%s)", name, strings.Join(blocked[name], ", ")))
+	}
+	return metav1.Condition{
+		Type:    agentv1.KlusterletAddonConfigDependenciesSatisfied,
+		Status:  metav1.ConditionFalse,
+		Reason:  reasonAddonsBlocked,
+		Message: "Addons waiting for their dependencies: " + strings.Join(waiting, ", ") + ".",
+	}
+}
+
+// newDeferredCondition returns the Deferred condition, it is true when the update of a ManifestWork is deferred. The
+// error of an invalid maintenance window, which is ignored, is added to the message
+func newDeferredCondition(deferredUpdates []string, windowErr error) metav1.Condition {
+	windowMessage := ""
+	if windowErr != nil {
+		windowMessage = fmt.Sprintf(" The maintenance windows are ignored, %v.", windowErr)
+	}
+	if len(deferredUpdates) == 0 {
+		condition := metav1.Condition{
+			Type:    agentv1.KlusterletAddonConfigDeferred,
+			Status:  metav1.ConditionFalse,
+			Reason:  reasonNoUpdatesDeferred,
+			Message: "No update of ManifestWork is deferred." + windowMessage,
+		}
+		if windowErr != nil {
+			condition.Reason = reasonInvalidMaintenanceWindow
+		}
+		return condition
+	}
+	return metav1.Condition{
+		Type:    agentv1.KlusterletAddonConfigDeferred,
+		Status:  metav1.ConditionTrue,
+		Reason:  reasonUpdatesDeferred,
+		Message: "ManifestWorks whose update is deferred: " + strings.Join(deferredUpdates, ", ") + "." + windowMessage,
+	}
+}
+
+// setAddonPhasesMetrics records the phase of the enabled addons of a managed cluster in the addons gauge
+func setAddonPhasesMetrics(cluster string, addonStatus map[string]agentv1.KlusterletAddonStatus) {
+	phases := make(map[string]string, len(addonStatus))
+	for name, s := range addonStatus {
+		phases[name] = s.Phase
+	}
+	metrics.SetAddonPhases(cluster, phases)
+}
@@ -0,0 +1,378 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddon
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	addonv1alpha1 "github.com/open-cluster-management/api/addon/v1alpha1"
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestManifestWork(name, namespace string, appliedStatus metav1.ConditionStatus, msg string) *manifestworkv1.ManifestWork {
+	return &manifestworkv1.ManifestWork{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: manifestworkv1.SchemeGroupVersion.String(),
+			Kind:       "ManifestWork",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Status: manifestworkv1.ManifestWorkStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:    manifestworkv1.WorkApplied,
+					Status:  appliedStatus,
+					Reason:  "AppliedManifestWorkComplete",
+					Message: msg,
+				},
+			},
+		},
+	}
+}
+
+func Test_updateKlusterletAddonConfigStatus(t *testing.T) {
+	testscheme := scheme.Scheme
+
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
+	testscheme.AddKnownTypes(manifestworkv1.SchemeGroupVersion, &manifestworkv1.ManifestWork{},
+		&manifestworkv1.ManifestWorkList{})
+	testscheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{},
+		&addonv1alpha1.ManagedClusterAddOnList{})
+
+	testKlusterletAddonConfig := &agentv1.KlusterletAddonConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: agentv1.SchemeGroupVersion.String(),
+			Kind:       "KlusterletAddonConfig",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-managedcluster",
+			Namespace:  "test-managedcluster",
+			Generation: 3,
+		},
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			SearchCollectorConfig: agentv1.KlusterletAddonConfigSearchCollectorSpec{
+				Enabled: true,
+			},
+		},
+	}
+
+	degradedSearch := &addonv1alpha1.ManagedClusterAddOn{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: addonv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ManagedClusterAddOn",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "search-collector",
+			Namespace: "test-managedcluster",
+		},
+		Status: addonv1alpha1.ManagedClusterAddOnStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:    "Degraded",
+					Status:  metav1.ConditionTrue,
+					Reason:  "AddonInstallationError",
+					Message: "Failed to complete add-on installation.",
+				},
+			},
+		},
+	}
+
+	failedWorkManager := newTestManifestWork("test-managedcluster-klusterlet-addon-workmgr", "test-managedcluster",
+		metav1.ConditionFalse, "failed to apply workmanager")
+	failedWorkManager.Status.ResourceStatus.Manifests = []manifestworkv1.ManifestCondition{
+		{
+			ResourceMeta: manifestworkv1.ManifestResourceMeta{Ordinal: 0, Kind: "WorkManager", Name: "work-manager"},
+			Conditions: []metav1.Condition{
+				{
+					Type:    string(manifestworkv1.ManifestApplied),
+					Status:  metav1.ConditionFalse,
+					Reason:  "AppliedManifestFailed",
+					Message: "no matches for kind WorkManager",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		objs                []runtime.Object
+		wantConditions      map[string]metav1.ConditionStatus
+		wantAddonPhases     map[string]string
+		wantFailedManifests map[string][]agentv1.ManifestFailure
+		wantMessages        map[string]string
+	}{
+		{
+			name: "nothing created yet",
+			objs: []runtime.Object{testKlusterletAddonConfig},
+			wantConditions: map[string]metav1.ConditionStatus{
+				agentv1.KlusterletAddonConfigCRDsApplied:           metav1.ConditionFalse,
+				agentv1.KlusterletAddonConfigOperatorApplied:       metav1.ConditionFalse,
+				agentv1.KlusterletAddonConfigAddonsReady:           metav1.ConditionFalse,
+				agentv1.KlusterletAddonConfigDependenciesSatisfied: metav1.ConditionFalse,
+			},
+			wantAddonPhases: map[string]string{
+				"search-collector": agentv1.AddonPhaseProgressing,
+				"work-manager":     agentv1.AddonPhaseProgressing,
+			},
+			wantMessages: map[string]string{
+				"search-collector": "Waiting for dependencies work-manager to be available.",
+				"work-manager":     "Waiting for ManifestWork to be created.",
+			},
+		},
+		{
+			name: "all applied",
+			objs: []runtime.Object{
+				testKlusterletAddonConfig,
+				newTestManifestWork("test-managedcluster"+KlusterletAddonCRDsPostfix, "test-managedcluster",
+					metav1.ConditionTrue, ""),
+				newTestManifestWork("test-managedcluster"+KlusterletAddonOperatorPostfix, "test-managedcluster",
+					metav1.ConditionTrue, ""),
+				newTestManifestWork("test-managedcluster-klusterlet-addon-search", "test-managedcluster",
+					metav1.ConditionTrue, ""),
+				newTestManifestWork("test-managedcluster-klusterlet-addon-workmgr", "test-managedcluster",
+					metav1.ConditionTrue, ""),
+			},
+			wantConditions: map[string]metav1.ConditionStatus{
+				agentv1.KlusterletAddonConfigCRDsApplied:           metav1.ConditionTrue,
+				agentv1.KlusterletAddonConfigOperatorApplied:       metav1.ConditionTrue,
+				agentv1.KlusterletAddonConfigAddonsReady:           metav1.ConditionTrue,
+				agentv1.KlusterletAddonConfigDependenciesSatisfied: metav1.ConditionTrue,
+			},
+			wantAddonPhases: map[string]string{
+				"search-collector": agentv1.AddonPhaseAvailable,
+				"work-manager":     agentv1.AddonPhaseAvailable,
+			},
+		},
+		{
+			name: "search degraded & work manager failed to apply",
+			objs: []runtime.Object{
+				testKlusterletAddonConfig,
+				degradedSearch,
+				newTestManifestWork("test-managedcluster"+KlusterletAddonCRDsPostfix, "test-managedcluster",
+					metav1.ConditionTrue, ""),
+				newTestManifestWork("test-managedcluster"+KlusterletAddonOperatorPostfix, "test-managedcluster",
+					metav1.ConditionFalse, "failed to apply deployment"),
+				newTestManifestWork("test-managedcluster-klusterlet-addon-search", "test-managedcluster",
+					metav1.ConditionTrue, ""),
+				failedWorkManager,
+			},
+			wantConditions: map[string]metav1.ConditionStatus{
+				agentv1.KlusterletAddonConfigCRDsApplied:     metav1.ConditionTrue,
+				agentv1.KlusterletAddonConfigOperatorApplied: metav1.ConditionFalse,
+				agentv1.KlusterletAddonConfigAddonsReady:     metav1.ConditionFalse,
+			},
+			wantAddonPhases: map[string]string{
+				"search-collector": agentv1.AddonPhaseDegraded,
+				"work-manager":     agentv1.AddonPhaseDegraded,
+			},
+			wantFailedManifests: map[string][]agentv1.ManifestFailure{
+				"work-manager": {
+					{Kind: "WorkManager", Name: "work-manager", Message: "no matches for kind WorkManager"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(testscheme, tt.objs...)
+			instance := testKlusterletAddonConfig.DeepCopy()
+			if err := updateKlusterletAddonConfigStatus(instance, c, nil, nil); err != nil {
+				t.Fatalf("updateKlusterletAddonConfigStatus() error = %v", err)
+			}
+
+			got := &agentv1.KlusterletAddonConfig{}
+			if err := c.Get(context.TODO(), types.NamespacedName{
+				Name:      testKlusterletAddonConfig.Name,
+				Namespace: testKlusterletAddonConfig.Namespace,
+			}, got); err != nil {
+				t.Fatalf("failed to get klusterletaddonconfig: %v", err)
+			}
+
+			if got.Status.ObservedGeneration != testKlusterletAddonConfig.Generation {
+				t.Errorf("observedGeneration = %d, want %d",
+					got.Status.ObservedGeneration, testKlusterletAddonConfig.Generation)
+			}
+			if got.Status.ResolvedVersion != "2.3.0" {
+				t.Errorf("resolvedVersion = %q, want %q", got.Status.ResolvedVersion, "2.3.0")
+			}
+			for conditionType, status := range tt.wantConditions {
+				if !meta.IsStatusConditionPresentAndEqual(got.Status.Conditions, conditionType, status) {
+					t.Errorf("condition %s should be %s, got %v", conditionType, status, got.Status.Conditions)
+				}
+			}
+			if len(got.Status.AddOnStatus) != len(tt.wantAddonPhases) {
+				t.Errorf("addOnStatus = %v, want %v", got.Status.AddOnStatus, tt.wantAddonPhases)
+			}
+			for name, message := range tt.wantMessages {
+				if got.Status.AddOnStatus[name].Message != message {
+					t.Errorf("message of %s = %q, want %q", name, got.Status.AddOnStatus[name].Message, message)
+				}
+			}
+			for name, phase := range tt.wantAddonPhases {
+				if got.Status.AddOnStatus[name].Phase != phase {
+					t.Errorf("phase of %s = %s, want %s", name, got.Status.AddOnStatus[name].Phase, phase)
+				}
+				if !reflect.DeepEqual(got.Status.AddOnStatus[name].FailedManifests, tt.wantFailedManifests[name]) {
+					t.Errorf("failedManifests of %s = %v, want %v",
+						name, got.Status.AddOnStatus[name].FailedManifests, tt.wantFailedManifests[name])
+				}
+			}
+		})
+	}
+}
+
+func Test_newDependenciesSatisfiedCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		blocked     map[string][]string
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:        "no blocked addon",
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  reasonDependenciesAvailable,
+			wantMessage: "The dependencies of all enabled addons are available.",
+		},
+		{
+			name: "blocked addons",
+			blocked: map[string][]string{
+				"search-collector":       {"work-manager"},
+				"cert-policy-controller": {"policy-controller"},
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: reasonAddonsBlocked,
+			wantMessage: "Addons waiting for their dependencies: cert-policy-controller (policy-controller), " +
+				"search-collector (work-manager).",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newDependenciesSatisfiedCondition(tt.blocked)
+			if got.Type != agentv1.KlusterletAddonConfigDependenciesSatisfied || got.Status != tt.wantStatus ||
+				got.Reason != tt.wantReason || got.Message != tt.wantMessage {
+				t.Errorf("newDependenciesSatisfiedCondition() = %v, want %s %s %q",
+					got, tt.wantStatus, tt.wantReason, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func Test_newDeferredCondition(t *testing.T) {
+	tests := []struct {
+		name            string
+		deferredUpdates []string
+		windowErr       error
+		wantStatus      metav1.ConditionStatus
+		wantReason      string
+		wantMessage     string
+	}{
+		{
+			name:        "no deferred update",
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  reasonNoUpdatesDeferred,
+			wantMessage: "No update of ManifestWork is deferred.",
+		},
+		{
+			name: "deferred updates",
+			deferredUpdates: []string{
+				"cluster1-klusterlet-addon-operator (out of the maintenance windows)",
+				"cluster1-klusterlet-addon-search (addon search-collector is paused)",
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: reasonUpdatesDeferred,
+			wantMessage: "ManifestWorks whose update is deferred: " +
+				"cluster1-klusterlet-addon-operator (out of the maintenance windows), " +
+				"cluster1-klusterlet-addon-search (addon search-collector is paused).",
+		},
+		{
+			name:       "invalid maintenance window",
+			windowErr:  fmt.Errorf(`maintenance window 0 is invalid: unknown time zone "Mars/Olympus"`),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: reasonInvalidMaintenanceWindow,
+			wantMessage: "No update of ManifestWork is deferred. The maintenance windows are ignored, " +
+				`maintenance window 0 is invalid: unknown time zone "Mars/Olympus".`,
+		},
+		{
+			name:            "deferred updates & invalid maintenance window",
+			deferredUpdates: []string{"cluster1-klusterlet-addon-search (addon search-collector is paused)"},
+			windowErr:       fmt.Errorf(`maintenance window 0 is invalid: unknown time zone "Mars/Olympus"`),
+			wantStatus:      metav1.ConditionTrue,
+			wantReason:      reasonUpdatesDeferred,
+			wantMessage: "ManifestWorks whose update is deferred: " +
+				"cluster1-klusterlet-addon-search (addon search-collector is paused). The maintenance windows are " +
+				`ignored, maintenance window 0 is invalid: unknown time zone "Mars/Olympus".`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newDeferredCondition(tt.deferredUpdates, tt.windowErr)
+			if got.Type != agentv1.KlusterletAddonConfigDeferred || got.Status != tt.wantStatus ||
+				got.Reason != tt.wantReason || got.Message != tt.wantMessage {
+				t.Errorf("newDeferredCondition() = %v, want %s %s %q", got, tt.wantStatus, tt.wantReason, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func Test_newAddonsReadyCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		addonStatus map[string]agentv1.KlusterletAddonStatus
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:        "no addons",
+			addonStatus: nil,
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  reasonAddonsAvailable,
+			wantMessage: "All enabled addons are available.",
+		},
+		{
+			name: "degraded has priority over progressing",
+			addonStatus: map[string]agentv1.KlusterletAddonStatus{
+				"work-manager":      {Phase: agentv1.AddonPhaseDegraded},
+				"search-collector":  {Phase: agentv1.AddonPhaseProgressing},
+				"policy-controller": {Phase: agentv1.AddonPhaseDegraded},
+			},
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  reasonAddonsDegraded,
+			wantMessage: "Degraded addons: policy-controller, work-manager.",
+		},
+		{
+			name: "progressing",
+			addonStatus: map[string]agentv1.KlusterletAddonStatus{
+				"work-manager":     {Phase: agentv1.AddonPhaseAvailable},
+				"search-collector": {Phase: agentv1.AddonPhaseProgressing},
+			},
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  reasonAddonsProgressing,
+			wantMessage: "Progressing addons: search-collector.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newAddonsReadyCondition(tt.addonStatus)
+			if got.Status != tt.wantStatus || got.Reason != tt.wantReason || got.Message != tt.wantMessage {
+				t.Errorf("newAddonsReadyCondition() = %v, want %s/%s/%s", got, tt.wantStatus, tt.wantReason, tt.wantMessage)
+			}
+		})
+	}
+}
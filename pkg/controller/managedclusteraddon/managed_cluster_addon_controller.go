@@ -19,13 +19,17 @@ import (
 	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
 	addons "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
 	addonoperator "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/addon-operator/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -37,18 +41,26 @@ import (
 
 var log = logf.Log.WithName("controller_managedclusteraddon")
 
+// LeaseGracePeriod is how long an addon lease can go without being renewed before the addon is reported unavailable
+var LeaseGracePeriod = 5 * time.Minute
+
 const (
 
 	// types of condition
+	addonAvailable   = "Available"
 	addonDegraded    = "Degraded"
 	addonProgressing = "Progressing"
 
 	// reasons of condition
-	progressingReasonMissing   = "ManifestWorkCreating"
-	progressingReasonCreated   = "ManifestWorkCreated"
-	progressingReasonApplied   = "ManifestWorkApplied"
-	progressingReasonDeleting  = "AddonTerminating"
-	degradedReasonInstallError = "AddonInstallationError"
+	progressingReasonMissing     = "ManifestWorkCreating"
+	progressingReasonCreated     = "ManifestWorkCreated"
+	progressingReasonApplied     = "ManifestWorkApplied"
+	progressingReasonDeleting    = "AddonTerminating"
+	degradedReasonInstallError   = "AddonInstallationError"
+	degradedReasonCSRRateLimited = "CSRApprovalRateLimitExceeded" // set by the csr controller
+	availableReasonLeaseUpdated  = "AddonLeaseUpdated"
+	availableReasonLeaseExpired  = "AddonLeaseUpdateStopped"
+	availableReasonLeaseNotFound = "AddonLeaseNotFound"
 
 	// messages of condition
 	progressingMSGMissing           = "Creating manifests for add-on installation." // message will show when we are waiting to create the manifests of addons
@@ -56,9 +68,17 @@ const (
 	progressingMSGApplied           = "All manifests are installed."                // message when the manifestwork is applied (manifest is installed)
 	progressingMsgDeleting          = "Add-on is being deleted."                    // message when addon is in deletion
 	degradedMSGInstallErrorTemplate = "Failed to complete add-on installation: %s." // message when we detect error in addon's manifests installation, %s is errorFailedApplyTemplate
+	availableMSGLeaseUpdated        = "Add-on is available."                        // message when the lease of the addon is renewed within the grace period
+	availableMSGLeaseExpiredTmpl    = "Add-on stopped updating its lease since %s." // message when the lease of the addon is not renewed within the grace period
+	availableMSGLeaseNotFound       = "Add-on lease is not found."                  // message when the addon has not created its lease
+	availableMSGLeaseNotFoundTmpl   = "Add-on lease is not found since %s."         // message when the addon has not created its lease within the grace period
+
+	// reasons of events
+	eventReasonDegraded  = "AddonDegraded"
+	eventReasonRecovered = "AddonRecovered"
 
 	// possible error messages
-	errorFailedApplyTemplate = "%d of %d manifests failed to apply"
+	errorFailedApplyTemplate = "%d of %d manifests failed to apply: %s"
 )
 
 /**
@@ -74,7 +94,11 @@ func Add(mgr manager.Manager) error {
 
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	return &ReconcileManagedClusterAddOn{client: mgr.GetClient(), scheme: mgr.GetScheme()}
+	return &ReconcileManagedClusterAddOn{
+		client:   mgr.GetClient(),
+		scheme:   mgr.GetScheme(),
+		recorder: mgr.GetEventRecorderFor("managedclusteraddon-controller"),
+	}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -146,8 +170,9 @@ var _ reconcile.Reconciler = &ReconcileManagedClusterAddOn{}
 type ReconcileManagedClusterAddOn struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client client.Client
-	scheme *runtime.Scheme
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
 }
 
 // Reconcile reads that state of the cluster for a ManagedClusterAddOn object and makes changes based on the state read
@@ -261,8 +286,24 @@ func (r *ReconcileManagedClusterAddOn) Reconcile(request reconcile.Request) (rec
 		manifestWork,
 	)
 
+	// check & set available information from the lease of the addon
+	var requeueAfter time.Duration
+	if addon.IsEnabled(klusterletaddonconfig) && klusterletaddonconfig.DeletionTimestamp == nil && !manifestWorkIsNotFound {
+		lease := &coordinationv1.Lease{}
+		if err := r.client.Get(context.TODO(), request.NamespacedName, lease); err != nil {
+			if !errors.IsNotFound(err) {
+				return reconcile.Result{}, err
+			}
+			lease = nil
+		}
+		requeueAfter = updateAvailableStatus(managedClusterAddOn, lease, time.Now(), LeaseGracePeriod)
+	} else {
+		filterConditions(&managedClusterAddOn.Status.Conditions, addonAvailable)
+	}
+
 	// check & set degraded information
-	_ = updateDegradedStatus(managedClusterAddOn, errProgressing)
+	wasDegraded := meta.IsStatusConditionTrue(oldstatus.Conditions, addonDegraded)
+	isDegraded := updateDegradedStatus(managedClusterAddOn, errProgressing) == metav1.ConditionTrue
 
 	// write managedClusterAddOn status if needed
 	if !reflect.DeepEqual(*oldstatus, managedClusterAddOn.Status) {
@@ -271,9 +312,28 @@ func (r *ReconcileManagedClusterAddOn) Reconcile(request reconcile.Request) (rec
 			log.Error(err, "Failed to update status of ManagedClusterAddOn "+managedClusterAddOn.Name)
 			return reconcile.Result{}, err
 		}
+		r.recordDegradedEvent(managedClusterAddOn, wasDegraded, isDegraded)
 	}
 
-	return reconcile.Result{}, nil
+	// requeue to detect a lease which stops being renewed
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// recordDegradedEvent records an event on the ManagedClusterAddOn when it becomes degraded or recovers
+func (r *ReconcileManagedClusterAddOn) recordDegradedEvent(
+	mca *addonv1alpha1.ManagedClusterAddOn,
+	wasDegraded, isDegraded bool,
+) {
+	switch {
+	case !wasDegraded && isDegraded:
+		msg := ""
+		if c := meta.FindStatusCondition(mca.Status.Conditions, addonDegraded); c != nil {
+			msg = c.Message
+		}
+		r.recorder.Event(mca, corev1.EventTypeWarning, eventReasonDegraded, msg)
+	case wasDegraded && !isDegraded:
+		r.recorder.Event(mca, corev1.EventTypeNormal, eventReasonRecovered, "Add-on is no longer degraded.")
+	}
 }
 
 // filterConditions removes conditions if they match the type
@@ -292,10 +352,15 @@ func filterConditions(conditions *[]metav1.Condition, excludeType string) {
 }
 
 // updateDegradedStatus updates ManagedClusterAddOn.status's degraded type condition based on former errors
-// will remove degraded condition if nothing is wrong
+// will remove degraded condition if nothing is wrong, except the degraded condition of the csr controller
+// which is removed by the csr controller
 func updateDegradedStatus(mca *addonv1alpha1.ManagedClusterAddOn,
 	errProgressing error) metav1.ConditionStatus {
 	if errProgressing == nil {
+		if c := meta.FindStatusCondition(mca.Status.Conditions, addonDegraded); c != nil &&
+			c.Reason == degradedReasonCSRRateLimited {
+			return c.Status
+		}
 		// filter out degraded
 		filterConditions(&mca.Status.Conditions, addonDegraded)
 		return metav1.ConditionFalse
@@ -314,6 +379,61 @@ func updateDegradedStatus(mca *addonv1alpha1.ManagedClusterAddOn,
 	return conditionStatus
 }
 
+// updateAvailableStatus updates ManagedClusterAddOn.status's available type condition based on the lease of the addon
+// available is true if the lease is renewed within the grace period, false if not, and unknown if there is no lease.
+// A lease still not found after the grace period sets available to false.
+// returns when the addon should be checked again
+func updateAvailableStatus(
+	mca *addonv1alpha1.ManagedClusterAddOn,
+	lease *coordinationv1.Lease,
+	now time.Time,
+	gracePeriod time.Duration,
+) time.Duration {
+	conditionStatus := metav1.ConditionUnknown
+	conditionReason := availableReasonLeaseNotFound
+	conditionMsg := availableMSGLeaseNotFound
+	requeueAfter := gracePeriod
+
+	if lease == nil {
+		// the grace period starts when the lease is first reported missing
+		if c := meta.FindStatusCondition(mca.Status.Conditions, addonAvailable); c != nil &&
+			c.Reason == availableReasonLeaseNotFound {
+			missingSince := c.LastTransitionTime.Time
+			if expireTime := missingSince.Add(gracePeriod); c.Status == metav1.ConditionFalse || !expireTime.After(now) {
+				conditionStatus = metav1.ConditionFalse
+				conditionMsg = fmt.Sprintf(availableMSGLeaseNotFoundTmpl, missingSince.UTC().Format(time.RFC3339))
+			} else {
+				requeueAfter = expireTime.Sub(now) + time.Second
+			}
+		}
+	} else {
+		var renewTime time.Time
+		if lease.Spec.RenewTime != nil {
+			renewTime = lease.Spec.RenewTime.Time
+		} else if lease.Spec.AcquireTime != nil {
+			renewTime = lease.Spec.AcquireTime.Time
+		} else {
+			renewTime = lease.CreationTimestamp.Time
+		}
+		if expireTime := renewTime.Add(gracePeriod); expireTime.After(now) {
+			conditionStatus = metav1.ConditionTrue
+			conditionReason = availableReasonLeaseUpdated
+			conditionMsg = availableMSGLeaseUpdated
+			// check again right after the lease would expire
+			requeueAfter = expireTime.Sub(now) + time.Second
+		} else {
+			conditionStatus = metav1.ConditionFalse
+			conditionReason = availableReasonLeaseExpired
+			conditionMsg = fmt.Sprintf(availableMSGLeaseExpiredTmpl, renewTime.UTC().Format(time.RFC3339))
+		}
+	}
+
+	condition := createCondition(addonAvailable, conditionStatus, conditionReason, conditionMsg)
+	condition.LastTransitionTime = metav1.NewTime(now)
+	setStatusCondition(&mca.Status.Conditions, condition)
+	return requeueAfter
+}
+
 // updateProgressingStatus updates ManagedClusterAddOn.status's processing type condition based on given manifestwork
 // if manifestwork is not created/still waiting for complete, will show processing=true
 // if manifestwork is finished apply (with or without errors), will show processing=false
@@ -350,7 +470,8 @@ func updateProgressingStatus(
 			conditionMsg = progressingMSGCreated
 		}
 		if numFailed > 0 {
-			err = fmt.Errorf(errorFailedApplyTemplate, numFailed, numTotal)
+			err = fmt.Errorf(errorFailedApplyTemplate, numFailed, numTotal,
+				utils.FormatManifestFailures(utils.GetManifestFailures(mw), numFailed))
 		}
 	}
 	// update condition
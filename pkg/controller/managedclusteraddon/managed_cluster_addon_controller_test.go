@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -653,8 +654,9 @@ func Test_Reconcile(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.fields.scheme.AddKnownTypes(addonv1alpha1.SchemeGroupVersion, &addonv1alpha1.ManagedClusterAddOn{})
 			r := &ReconcileManagedClusterAddOn{
-				client: tt.fields.client,
-				scheme: tt.fields.scheme,
+				client:   tt.fields.client,
+				scheme:   tt.fields.scheme,
+				recorder: record.NewFakeRecorder(100),
 			}
 
 			got, err := r.Reconcile(tt.args.request)
@@ -744,6 +746,28 @@ func Test_updateDegradedStatus(t *testing.T) {
 			wantReason: degradedReasonInstallError,
 			wantType:   true,
 		},
+		{
+			name: "no error should keep csr rate limit",
+			args: args{
+				mca: &addonv1alpha1.ManagedClusterAddOn{
+					Status: addonv1alpha1.ManagedClusterAddOnStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               "Degraded",
+								Status:             metav1.ConditionTrue,
+								LastTransitionTime: oldTime,
+								Reason:             degradedReasonCSRRateLimited,
+								Message:            "rate limited",
+							},
+						},
+					},
+				},
+				errProgressing: nil,
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: degradedReasonCSRRateLimited,
+			wantType:   true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -766,3 +790,163 @@ func Test_updateDegradedStatus(t *testing.T) {
 		})
 	}
 }
+
+func Test_recordDegradedEvent(t *testing.T) {
+	mca := &addonv1alpha1.ManagedClusterAddOn{
+		ObjectMeta: metav1.ObjectMeta{Name: "search-collector", Namespace: "cluster1"},
+		Status: addonv1alpha1.ManagedClusterAddOnStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:    addonDegraded,
+					Status:  metav1.ConditionTrue,
+					Reason:  degradedReasonInstallError,
+					Message: "Failed to complete add-on installation: 1 of 2 manifests failed to apply.",
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		wasDegraded bool
+		isDegraded  bool
+		wantEvent   string
+	}{
+		{
+			name:       "becomes degraded",
+			isDegraded: true,
+			wantEvent: "Warning AddonDegraded " +
+				"Failed to complete add-on installation: 1 of 2 manifests failed to apply.",
+		},
+		{
+			name:        "recovers",
+			wasDegraded: true,
+			wantEvent:   "Normal AddonRecovered Add-on is no longer degraded.",
+		},
+		{
+			name:        "still degraded",
+			wasDegraded: true,
+			isDegraded:  true,
+		},
+		{
+			name: "still healthy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			r := &ReconcileManagedClusterAddOn{recorder: recorder}
+			r.recordDegradedEvent(mca, tt.wasDegraded, tt.isDegraded)
+			select {
+			case event := <-recorder.Events:
+				if event != tt.wantEvent {
+					t.Errorf("recordDegradedEvent() event = %q, want %q", event, tt.wantEvent)
+				}
+			default:
+				if tt.wantEvent != "" {
+					t.Errorf("recordDegradedEvent() no event, want %q", tt.wantEvent)
+				}
+			}
+		})
+	}
+}
+
+func Test_updateAvailableStatus(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	gracePeriod := 5 * time.Minute
+	leaseRenewedAt := func(renewTime time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: "search-collector", Namespace: "cluster1"},
+			Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: renewTime}},
+		}
+	}
+
+	leaseNotFoundSince := func(status metav1.ConditionStatus, since time.Time) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:               addonAvailable,
+			Status:             status,
+			Reason:             availableReasonLeaseNotFound,
+			LastTransitionTime: metav1.NewTime(since),
+		}}
+	}
+
+	tests := []struct {
+		name             string
+		conditions       []metav1.Condition
+		lease            *coordinationv1.Lease
+		wantStatus       metav1.ConditionStatus
+		wantReason       string
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name:             "lease not found",
+			lease:            nil,
+			wantStatus:       metav1.ConditionUnknown,
+			wantReason:       availableReasonLeaseNotFound,
+			wantRequeueAfter: gracePeriod,
+		},
+		{
+			name:             "lease not found within grace period",
+			conditions:       leaseNotFoundSince(metav1.ConditionUnknown, now.Add(-2*time.Minute)),
+			lease:            nil,
+			wantStatus:       metav1.ConditionUnknown,
+			wantReason:       availableReasonLeaseNotFound,
+			wantRequeueAfter: 3*time.Minute + time.Second,
+		},
+		{
+			name:             "lease not found after grace period",
+			conditions:       leaseNotFoundSince(metav1.ConditionUnknown, now.Add(-10*time.Minute)),
+			lease:            nil,
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       availableReasonLeaseNotFound,
+			wantRequeueAfter: gracePeriod,
+		},
+		{
+			name:             "lease still not found",
+			conditions:       leaseNotFoundSince(metav1.ConditionFalse, now.Add(-10*time.Minute)),
+			lease:            nil,
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       availableReasonLeaseNotFound,
+			wantRequeueAfter: gracePeriod,
+		},
+		{
+			name:             "lease created after grace period",
+			conditions:       leaseNotFoundSince(metav1.ConditionFalse, now.Add(-10*time.Minute)),
+			lease:            leaseRenewedAt(now.Add(-1 * time.Minute)),
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       availableReasonLeaseUpdated,
+			wantRequeueAfter: 4*time.Minute + time.Second,
+		},
+		{
+			name:             "lease renewed",
+			lease:            leaseRenewedAt(now.Add(-1 * time.Minute)),
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       availableReasonLeaseUpdated,
+			wantRequeueAfter: 4*time.Minute + time.Second,
+		},
+		{
+			name:             "lease not renewed within grace period",
+			lease:            leaseRenewedAt(now.Add(-10 * time.Minute)),
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       availableReasonLeaseExpired,
+			wantRequeueAfter: gracePeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mca := &addonv1alpha1.ManagedClusterAddOn{}
+			mca.Status.Conditions = tt.conditions
+			requeueAfter := updateAvailableStatus(mca, tt.lease, now, gracePeriod)
+			if requeueAfter != tt.wantRequeueAfter {
+				t.Errorf("updateAvailableStatus() requeueAfter = %v, want %v", requeueAfter, tt.wantRequeueAfter)
+			}
+			if len(mca.Status.Conditions) != 1 {
+				t.Fatalf("updateAvailableStatus() conditions = %v, want 1 condition", mca.Status.Conditions)
+			}
+			c := mca.Status.Conditions[0]
+			if c.Type != addonAvailable || c.Status != tt.wantStatus || c.Reason != tt.wantReason {
+				t.Errorf("updateAvailableStatus() condition = %v, want status %s reason %s", c, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
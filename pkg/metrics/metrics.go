@@ -0,0 +1,150 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Package metrics contains the prometheus metrics of the klusterlet addon controller.
+// They are registered in the controller-runtime registry, so they are served on the metrics endpoint of the manager
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "klusterlet_addon_controller"
+
+// results of the operations counted
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+	ResultApproved  = "approved"
+	ResultDenied    = "denied"
+)
+
+// operations on ManifestWorks
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+var (
+	// addons is the number of managed clusters per addon & phase
+	addons = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "addons",
+			Help:      "Number of managed clusters with the addon in the phase, the phase is Progressing, Degraded or Available.",
+		},
+		[]string{"addon", "phase"},
+	)
+
+	// ManifestWorkOperations counts the create/update/delete of ManifestWorks
+	ManifestWorkOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "manifestwork_operations_total",
+			Help:      "Number of ManifestWorks created, updated or deleted by the controller.",
+		},
+		[]string{"operation", "result"},
+	)
+
+	// CSRs counts the addon CSRs approved or denied
+	CSRs = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "csrs_total",
+			Help:      "Number of addon certificate signing requests approved or denied by the controller.",
+		},
+		[]string{"result"},
+	)
+
+	// CSRDenials counts the addon CSRs denied per reason of the Denied condition
+	CSRDenials = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "csr_denials_total",
+			Help:      "Number of addon certificate signing requests denied by the controller, per reason.",
+		},
+		[]string{"reason"},
+	)
+
+	// unresolvedImageVersions is the number of managed clusters per version whose image manifest cannot be found
+	unresolvedImageVersions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "unresolved_image_manifest_versions",
+			Help:      "Number of managed clusters whose addon images cannot be resolved, per version of the image manifest.",
+		},
+		[]string{"version"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(addons, ManifestWorkOperations, CSRs, CSRDenials, unresolvedImageVersions)
+}
+
+// addonPhases keeps the phase of the addons of every managed cluster, so the addons gauge can be updated
+// from the status of a single klusterletaddonconfig
+var addonPhases = struct {
+	sync.Mutex
+	clusters map[string]map[string]string
+}{clusters: map[string]map[string]string{}}
+
+// SetAddonPhases replaces the phases of the addons of a managed cluster, keyed by addon name
+func SetAddonPhases(cluster string, phases map[string]string) {
+	addonPhases.Lock()
+	defer addonPhases.Unlock()
+
+	for addon, phase := range addonPhases.clusters[cluster] {
+		addons.WithLabelValues(addon, phase).Dec()
+	}
+	if len(phases) == 0 {
+		delete(addonPhases.clusters, cluster)
+		return
+	}
+	clusterPhases := make(map[string]string, len(phases))
+	for addon, phase := range phases {
+		addons.WithLabelValues(addon, phase).Inc()
+		clusterPhases[addon] = phase
+	}
+	addonPhases.clusters[cluster] = clusterPhases
+}
+
+// DeleteAddonPhases removes the addons of a managed cluster from the addons gauge
+func DeleteAddonPhases(cluster string) {
+	SetAddonPhases(cluster, nil)
+}
+
+// unresolvedVersions keeps the version of the image manifest which cannot be found for every managed cluster, so the
+// unresolvedImageVersions gauge can be updated from the status of a single klusterletaddonconfig
+var unresolvedVersions = struct {
+	sync.Mutex
+	clusters map[string]string
+}{clusters: map[string]string{}}
+
+// SetUnresolvedImageVersion records the version of the image manifest of a managed cluster which cannot be found, an
+// empty version removes the cluster from the unresolvedImageVersions gauge
+func SetUnresolvedImageVersion(cluster, version string) {
+	unresolvedVersions.Lock()
+	defer unresolvedVersions.Unlock()
+
+	if previous, ok := unresolvedVersions.clusters[cluster]; ok {
+		unresolvedImageVersions.WithLabelValues(previous).Dec()
+	}
+	if version == "" {
+		delete(unresolvedVersions.clusters, cluster)
+		return
+	}
+	unresolvedImageVersions.WithLabelValues(version).Inc()
+	unresolvedVersions.clusters[cluster] = version
+}
+
+// ResultOf returns ResultFailed if err is not nil, ResultSucceeded otherwise
+func ResultOf(err error) string {
+	if err != nil {
+		return ResultFailed
+	}
+	return ResultSucceeded
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestSetAddonPhases(t *testing.T) {
+	defer addons.Reset()
+
+	type wantValue struct {
+		addon, phase string
+		value        float64
+	}
+	tests := []struct {
+		name    string
+		cluster string
+		phases  map[string]string
+		want    []wantValue
+	}{
+		{
+			name:    "first cluster",
+			cluster: "cluster1",
+			phases:  map[string]string{"search-collector": "Progressing", "work-manager": "Available"},
+			want: []wantValue{
+				{"search-collector", "Progressing", 1},
+				{"work-manager", "Available", 1},
+			},
+		},
+		{
+			name:    "second cluster",
+			cluster: "cluster2",
+			phases:  map[string]string{"search-collector": "Progressing", "work-manager": "Degraded"},
+			want: []wantValue{
+				{"search-collector", "Progressing", 2},
+				{"work-manager", "Available", 1},
+				{"work-manager", "Degraded", 1},
+			},
+		},
+		{
+			name:    "phase changed",
+			cluster: "cluster1",
+			phases:  map[string]string{"search-collector": "Available", "work-manager": "Available"},
+			want: []wantValue{
+				{"search-collector", "Progressing", 1},
+				{"search-collector", "Available", 1},
+				{"work-manager", "Available", 1},
+			},
+		},
+		{
+			name:    "cluster removed",
+			cluster: "cluster2",
+			phases:  nil,
+			want: []wantValue{
+				{"search-collector", "Progressing", 0},
+				{"work-manager", "Degraded", 0},
+				{"work-manager", "Available", 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetAddonPhases(tt.cluster, tt.phases)
+			for _, w := range tt.want {
+				if got := testutil.ToFloat64(addons.WithLabelValues(w.addon, w.phase)); got != w.value {
+					t.Errorf("addons{addon=%q, phase=%q} = %v, want %v", w.addon, w.phase, got, w.value)
+				}
+			}
+		})
+	}
+
+	DeleteAddonPhases("cluster1")
+	if _, ok := addonPhases.clusters["cluster1"]; ok {
+		t.Errorf("cluster1 should be removed")
+	}
+}
+
+func TestSetUnresolvedImageVersion(t *testing.T) {
+	defer unresolvedImageVersions.Reset()
+
+	SetUnresolvedImageVersion("cluster1", "3.0.0")
+	SetUnresolvedImageVersion("cluster2", "3.0.0")
+	// an unchanged version is counted once
+	SetUnresolvedImageVersion("cluster2", "3.0.0")
+	if got := testutil.ToFloat64(unresolvedImageVersions.WithLabelValues("3.0.0")); got != 2 {
+		t.Errorf("unresolved_image_manifest_versions{version=3.0.0} = %v, want 2", got)
+	}
+
+	SetUnresolvedImageVersion("cluster1", "")
+	SetUnresolvedImageVersion("cluster2", "4.0.0")
+	if got := testutil.ToFloat64(unresolvedImageVersions.WithLabelValues("3.0.0")); got != 0 {
+		t.Errorf("unresolved_image_manifest_versions{version=3.0.0} = %v, want 0", got)
+	}
+	if got := testutil.ToFloat64(unresolvedImageVersions.WithLabelValues("4.0.0")); got != 1 {
+		t.Errorf("unresolved_image_manifest_versions{version=4.0.0} = %v, want 1", got)
+	}
+	if _, ok := unresolvedVersions.clusters["cluster1"]; ok {
+		t.Errorf("cluster1 should be removed")
+	}
+}
+
+func TestResultOf(t *testing.T) {
+	if got := ResultOf(nil); got != ResultSucceeded {
+		t.Errorf("ResultOf(nil) = %q, want %q", got, ResultSucceeded)
+	}
+	if got := ResultOf(errors.New("failed")); got != ResultFailed {
+		t.Errorf("ResultOf(err) = %q, want %q", got, ResultFailed)
+	}
+}
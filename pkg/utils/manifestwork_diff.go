@@ -0,0 +1,210 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// MaxFieldChanges is the maximum number of changed fields reported for a manifest
+	MaxFieldChanges = 10
+	// maxFieldChangeValueLength is the maximum length of the old & new values of a changed field
+	maxFieldChangeValueLength = 256
+	// redactedFieldValue replaces the values of the data of a Secret
+	redactedFieldValue = "<redacted>"
+)
+
+// DiffManifestWorks returns the changes applying the desired manifestwork makes to the live one. live is nil when the
+// manifestwork does not exist and desired is nil when it is deleted. The manifests are matched by
+// apiVersion/kind/namespace/name and only the attributes compareManifests checks are compared.
+// nil is returned when there is no change
+func DiffManifestWorks(live, desired *manifestworkv1.ManifestWork) (*agentv1.ManifestWorkChange, error) {
+	switch {
+	case live == nil && desired == nil:
+		return nil, nil
+	case desired == nil:
+		return &agentv1.ManifestWorkChange{Name: live.Name, Operation: agentv1.ChangeOperationDelete}, nil
+	}
+
+	desiredManifests, err := manifestsOf(desired)
+	if err != nil {
+		return nil, err
+	}
+	if live == nil {
+		change := &agentv1.ManifestWorkChange{Name: desired.Name, Operation: agentv1.ChangeOperationCreate}
+		for _, u := range desiredManifests {
+			change.Manifests = append(change.Manifests, newManifestChange(u, agentv1.ChangeOperationCreate))
+		}
+		return change, nil
+	}
+
+	liveManifests, err := manifestsOf(live)
+	if err != nil {
+		return nil, err
+	}
+	change := &agentv1.ManifestWorkChange{Name: desired.Name, Operation: agentv1.ChangeOperationUpdate}
+	matched := make(map[int]bool, len(liveManifests))
+	for _, u := range desiredManifests {
+		i := indexOfManifest(liveManifests, u)
+		if i < 0 {
+			change.Manifests = append(change.Manifests, newManifestChange(u, agentv1.ChangeOperationCreate))
+			continue
+		}
+		matched[i] = true
+		var fields []agentv1.FieldChange
+		for _, attribute := range rootAttributes {
+			diffFields(attribute, liveManifests[i].Object[attribute], u.Object[attribute], &fields)
+		}
+		if isSecret(u) {
+			redactSecretFields(fields)
+		}
+		if len(fields) > 0 {
+			manifestChange := newManifestChange(u, agentv1.ChangeOperationUpdate)
+			manifestChange.Fields = fields
+			change.Manifests = append(change.Manifests, manifestChange)
+		}
+	}
+	for i, u := range liveManifests {
+		if !matched[i] {
+			change.Manifests = append(change.Manifests, newManifestChange(u, agentv1.ChangeOperationDelete))
+		}
+	}
+	if len(change.Manifests) == 0 {
+		return nil, nil
+	}
+	return change, nil
+}
+
+// manifestsOf converts the manifests of the manifestwork to unstructured objects, empty manifests are skipped
+func manifestsOf(mw *manifestworkv1.ManifestWork) ([]*unstructured.Unstructured, error) {
+	var manifests []*unstructured.Unstructured
+	for i := range mw.Spec.Workload.Manifests {
+		u, err := convertRawExtensiontoUnstructured(&mw.Spec.Workload.Manifests[i].RawExtension)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %d of ManifestWork %s: %w", i, mw.Name, err)
+		}
+		if u != nil {
+			manifests = append(manifests, u)
+		}
+	}
+	return manifests, nil
+}
+
+// indexOfManifest returns the index of the manifest with the same apiVersion/kind/namespace/name, or -1
+func indexOfManifest(manifests []*unstructured.Unstructured, u *unstructured.Unstructured) int {
+	for i, m := range manifests {
+		if m.GetAPIVersion() == u.GetAPIVersion() && m.GetKind() == u.GetKind() &&
+			m.GetNamespace() == u.GetNamespace() && m.GetName() == u.GetName() {
+			return i
+		}
+	}
+	return -1
+}
+
+func newManifestChange(u *unstructured.Unstructured, operation string) agentv1.ManifestChange {
+	return agentv1.ManifestChange{
+		APIVersion: u.GetAPIVersion(),
+		Kind:       u.GetKind(),
+		Namespace:  u.GetNamespace(),
+		Name:       u.GetName(),
+		Operation:  operation,
+	}
+}
+
+// isSecret returns true if the manifest is a Secret
+func isSecret(u *unstructured.Unstructured) bool {
+	return u.GetAPIVersion() == "v1" && u.GetKind() == "Secret"
+}
+
+// redactSecretFields replaces the values of the changed data & stringData fields of a Secret, so the secret values
+// are never copied into the status. Only whether the field is added, changed or removed is kept
+func redactSecretFields(fields []agentv1.FieldChange) {
+	for i := range fields {
+		path := fields[i].Path
+		if path != "data" && !strings.HasPrefix(path, "data.") &&
+			path != "stringData" && !strings.HasPrefix(path, "stringData.") {
+			continue
+		}
+		if fields[i].Old != "" {
+			fields[i].Old = redactedFieldValue
+		}
+		if fields[i].New != "" {
+			fields[i].New = redactedFieldValue
+		}
+	}
+}
+
+// diffFields appends the changed leaf fields between the old & new values under the given path, maps & lists are
+// walked when both values have the same type. At most MaxFieldChanges fields are appended
+func diffFields(path string, oldValue, newValue interface{}, fields *[]agentv1.FieldChange) {
+	if len(*fields) >= MaxFieldChanges || reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for key := range oldMap {
+			keys = append(keys, key)
+		}
+		for key := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffFields(path+"."+key, oldMap[key], newMap[key], fields)
+		}
+		return
+	}
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			var oldItem, newItem interface{}
+			if i < len(oldList) {
+				oldItem = oldList[i]
+			}
+			if i < len(newList) {
+				newItem = newList[i]
+			}
+			diffFields(fmt.Sprintf("%s[%d]", path, i), oldItem, newItem, fields)
+		}
+		return
+	}
+	*fields = append(*fields, agentv1.FieldChange{
+		Path: path,
+		Old:  formatFieldValue(oldValue),
+		New:  formatFieldValue(newValue),
+	})
+}
+
+// formatFieldValue returns strings as they are and other values as JSON, long values are truncated
+func formatFieldValue(value interface{}) string {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		s = v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			s = fmt.Sprintf("%v", v)
+		} else {
+			s = string(data)
+		}
+	}
+	return truncate(s, maxFieldChangeValueLength)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newTestConfigMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "open-cluster-management-agent-addon"},
+		Data:       data,
+	}
+}
+
+// newTestManifestWork returns a manifestwork of the objects, as raw JSON like a manifestwork read from the apiserver
+// when raw is true
+func newTestManifestWork(t *testing.T, raw bool, objs ...runtime.Object) *manifestworkv1.ManifestWork {
+	mw := &manifestworkv1.ManifestWork{ObjectMeta: metav1.ObjectMeta{Name: "cluster1-klusterlet-addon-search"}}
+	for _, obj := range objs {
+		manifest := manifestworkv1.Manifest{RawExtension: runtime.RawExtension{Object: obj}}
+		if raw {
+			data, err := json.Marshal(obj)
+			if err != nil {
+				t.Fatalf("failed to marshal %v: %v", obj, err)
+			}
+			manifest = manifestworkv1.Manifest{RawExtension: runtime.RawExtension{Raw: data}}
+		}
+		mw.Spec.Workload.Manifests = append(mw.Spec.Workload.Manifests, manifest)
+	}
+	return mw
+}
+
+func newTestSecret(name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "open-cluster-management-agent-addon"},
+		Data:       data,
+	}
+}
+
+func TestDiffManifestWorks(t *testing.T) {
+	manyFields := make(map[string]string)
+	for i := 0; i < MaxFieldChanges+5; i++ {
+		manyFields[fmt.Sprintf("key%02d", i)] = "value"
+	}
+
+	tests := []struct {
+		name    string
+		live    *manifestworkv1.ManifestWork
+		desired *manifestworkv1.ManifestWork
+		want    *agentv1.ManifestWorkChange
+	}{
+		{
+			name: "no manifestwork",
+		},
+		{
+			name:    "created manifestwork",
+			desired: newTestManifestWork(t, false, newTestConfigMap("a", map[string]string{"key": "value"})),
+			want: &agentv1.ManifestWorkChange{
+				Name:      "cluster1-klusterlet-addon-search",
+				Operation: agentv1.ChangeOperationCreate,
+				Manifests: []agentv1.ManifestChange{{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Namespace:  "open-cluster-management-agent-addon",
+					Name:       "a",
+					Operation:  agentv1.ChangeOperationCreate,
+				}},
+			},
+		},
+		{
+			name: "deleted manifestwork",
+			live: newTestManifestWork(t, true, newTestConfigMap("a", map[string]string{"key": "value"})),
+			want: &agentv1.ManifestWorkChange{
+				Name:      "cluster1-klusterlet-addon-search",
+				Operation: agentv1.ChangeOperationDelete,
+			},
+		},
+		{
+			name:    "unchanged manifestwork",
+			live:    newTestManifestWork(t, true, newTestConfigMap("a", map[string]string{"key": "value"})),
+			desired: newTestManifestWork(t, false, newTestConfigMap("a", map[string]string{"key": "value"})),
+		},
+		{
+			name: "updated manifestwork",
+			live: newTestManifestWork(t, true,
+				newTestConfigMap("a", map[string]string{"changed": "old", "removed": "value"}),
+				newTestConfigMap("b", nil),
+			),
+			desired: newTestManifestWork(t, false,
+				newTestConfigMap("a", map[string]string{"changed": "new", "added": "value"}),
+				newTestConfigMap("c", nil),
+			),
+			want: &agentv1.ManifestWorkChange{
+				Name:      "cluster1-klusterlet-addon-search",
+				Operation: agentv1.ChangeOperationUpdate,
+				Manifests: []agentv1.ManifestChange{
+					{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Namespace:  "open-cluster-management-agent-addon",
+						Name:       "a",
+						Operation:  agentv1.ChangeOperationUpdate,
+						Fields: []agentv1.FieldChange{
+							{Path: "data.added", New: "value"},
+							{Path: "data.changed", Old: "old", New: "new"},
+							{Path: "data.removed", Old: "value"},
+						},
+					},
+					{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Namespace:  "open-cluster-management-agent-addon",
+						Name:       "c",
+						Operation:  agentv1.ChangeOperationCreate,
+					},
+					{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Namespace:  "open-cluster-management-agent-addon",
+						Name:       "b",
+						Operation:  agentv1.ChangeOperationDelete,
+					},
+				},
+			},
+		},
+		{
+			name: "updated secret",
+			live: newTestManifestWork(t, true,
+				newTestSecret("a", map[string][]byte{"changed": []byte("old"), "removed": []byte("value")}),
+				newTestSecret("b", nil),
+			),
+			desired: newTestManifestWork(t, false,
+				newTestSecret("a", map[string][]byte{"changed": []byte("new"), "added": []byte("value")}),
+				newTestSecret("b", map[string][]byte{"token": []byte("secret-token")}),
+			),
+			want: &agentv1.ManifestWorkChange{
+				Name:      "cluster1-klusterlet-addon-search",
+				Operation: agentv1.ChangeOperationUpdate,
+				Manifests: []agentv1.ManifestChange{
+					{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Namespace:  "open-cluster-management-agent-addon",
+						Name:       "a",
+						Operation:  agentv1.ChangeOperationUpdate,
+						Fields: []agentv1.FieldChange{
+							{Path: "data.added", New: redactedFieldValue},
+							{Path: "data.changed", Old: redactedFieldValue, New: redactedFieldValue},
+							{Path: "data.removed", Old: redactedFieldValue},
+						},
+					},
+					{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Namespace:  "open-cluster-management-agent-addon",
+						Name:       "b",
+						Operation:  agentv1.ChangeOperationUpdate,
+						Fields: []agentv1.FieldChange{
+							{Path: "data", New: redactedFieldValue},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffManifestWorks(tt.live, tt.desired)
+			if err != nil {
+				t.Fatalf("DiffManifestWorks() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffManifestWorks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("truncated fields", func(t *testing.T) {
+		got, err := DiffManifestWorks(
+			newTestManifestWork(t, true, newTestConfigMap("a", nil)),
+			newTestManifestWork(t, false, newTestConfigMap("a", manyFields)),
+		)
+		if err != nil {
+			t.Fatalf("DiffManifestWorks() error = %v", err)
+		}
+		if len(got.Manifests) != 1 || len(got.Manifests[0].Fields) != 1 ||
+			!strings.HasPrefix(got.Manifests[0].Fields[0].New, `{"key00":"value"`) {
+			t.Errorf("DiffManifestWorks() = %+v, want the added data as a single field", got)
+		}
+	})
+}
+
+func Test_diffFields(t *testing.T) {
+	oldValue := map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{"image": "search-collector:2.2.0", "args": []interface{}{"--v=2"}},
+		},
+		"replicas": int64(1),
+	}
+	newValue := map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{"image": "search-collector:2.3.0", "args": []interface{}{"--v=2", "--log"}},
+		},
+		"replicas": int64(2),
+		"long":     strings.Repeat("x", maxFieldChangeValueLength+1),
+		"unicode":  strings.Repeat("é", maxFieldChangeValueLength),
+	}
+
+	var fields []agentv1.FieldChange
+	diffFields("spec", oldValue, newValue, &fields)
+	want := []agentv1.FieldChange{
+		{Path: "spec.containers[0].args[1]", New: "--log"},
+		{Path: "spec.containers[0].image", Old: "search-collector:2.2.0", New: "search-collector:2.3.0"},
+		{Path: "spec.long", New: strings.Repeat("x", maxFieldChangeValueLength-3) + "..."},
+		{Path: "spec.replicas", Old: "1", New: "2"},
+		{Path: "spec.unicode", New: strings.Repeat("é", (maxFieldChangeValueLength-4)/2) + "..."},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("diffFields() = %+v, want %+v", fields, want)
+	}
+
+	fields = nil
+	for i := 0; i < MaxFieldChanges+5; i++ {
+		diffFields(fmt.Sprintf("data.key%d", i), nil, "value", &fields)
+	}
+	if len(fields) != MaxFieldChanges {
+		t.Errorf("diffFields() returned %d fields, want %d", len(fields), MaxFieldChanges)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+)
+
+// Schedule is a cron schedule: minute hour day-of-month month day-of-week
+type Schedule struct {
+	minutes, hours, daysOfMonth, months, daysOfWeek uint64
+	// a day matches either the day of month or the day of week when both are restricted, like in cron
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// scheduleFields are the ranges of the fields of a cron schedule
+var scheduleFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// maxScheduleLookahead bounds the search of the next time of a schedule which never matches, e.g. on February 30
+const maxScheduleLookahead = 5 * 366 * 24 * time.Hour
+
+// ParseSchedule parses a cron schedule of 5 numeric fields. A field is *, a value, a range a-b or a list of them
+// separated by commas, and an optional step /n. Sunday is either 0 or 7
+func ParseSchedule(spec string) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(scheduleFields) {
+		return nil, fmt.Errorf("schedule %q must have %d fields: minute hour day-of-month month day-of-week",
+			spec, len(scheduleFields))
+	}
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		var err error
+		if bits[i], err = parseScheduleField(field, scheduleFields[i].min, scheduleFields[i].max); err != nil {
+			return nil, fmt.Errorf("invalid %s in schedule %q: %v", scheduleFields[i].name, spec, err)
+		}
+	}
+	// 7 is Sunday
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &Schedule{
+		minutes:       bits[0],
+		hours:         bits[1],
+		daysOfMonth:   bits[2],
+		months:        bits[3],
+		daysOfWeek:    bits[4],
+		anyDayOfMonth: strings.HasPrefix(fields[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseScheduleField returns the values of the field as a bit set
+func parseScheduleField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(item, "/", 2)
+		start, end := min, max
+		if rangeAndStep[0] != "*" {
+			bounds := strings.SplitN(rangeAndStep[0], "-", 2)
+			var err error
+			if start, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("%q is not a number", bounds[0])
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("%q is not a number", bounds[1])
+				}
+			}
+			if start < min || end > max || start > end {
+				return 0, fmt.Errorf("%q is out of the range %d-%d", rangeAndStep[0], min, max)
+			}
+		}
+		step := 1
+		if len(rangeAndStep) == 2 {
+			var err error
+			if step, err = strconv.Atoi(rangeAndStep[1]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("%q is not a positive step", rangeAndStep[1])
+			}
+			// a/n starts at a and goes up to the max
+			if rangeAndStep[0] != "*" && !strings.Contains(rangeAndStep[0], "-") {
+				end = max
+			}
+		}
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the first time of the schedule strictly after t, in the location of t. The zero time is returned
+// when the schedule never matches
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(maxScheduleLookahead)
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	for t.Before(limit) {
+		switch {
+		case s.months&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hours&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minutes&(1<<uint(t.Minute())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// ParseMaintenanceWindow returns the schedule & the location of the maintenance window
+func ParseMaintenanceWindow(window agentv1.MaintenanceWindow) (*Schedule, *time.Location, error) {
+	if window.Duration.Duration <= 0 {
+		return nil, nil, fmt.Errorf("duration %s must be positive", window.Duration.Duration)
+	}
+	schedule, err := ParseSchedule(window.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	location := time.UTC
+	if window.TimeZone != "" {
+		if location, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("unknown time zone %q", window.TimeZone)
+		}
+	}
+	return schedule, location, nil
+}
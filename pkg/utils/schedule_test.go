@@ -0,0 +1,107 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{name: "every minute", spec: "* * * * *"},
+		{name: "lists, ranges & steps", spec: "0,30 1-5/2 */10 1-12 1-5"},
+		{name: "sunday is 7", spec: "0 2 * * 7"},
+		{name: "missing field", spec: "0 2 * *", wantErr: "must have 5 fields"},
+		{name: "out of range", spec: "60 2 * * *", wantErr: "invalid minute"},
+		{name: "inverted range", spec: "0 5-1 * * *", wantErr: "invalid hour"},
+		{name: "not a number", spec: "0 2 * jan *", wantErr: "invalid month"},
+		{name: "invalid step", spec: "*/0 2 * * *", wantErr: "not a positive step"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchedule(tt.spec)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("ParseSchedule() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("ParseSchedule() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	// a Wednesday
+	from := time.Date(2021, time.March, 10, 14, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "next minute",
+			spec: "* * * * *",
+			from: from,
+			want: time.Date(2021, time.March, 10, 14, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "later today",
+			spec: "0 22 * * *",
+			from: from,
+			want: time.Date(2021, time.March, 10, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "next saturday",
+			spec: "0 2 * * 6",
+			from: from,
+			want: time.Date(2021, time.March, 13, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			spec: "0 0 11 * 0",
+			from: from,
+			want: time.Date(2021, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "next year",
+			spec: "0 0 1 1 *",
+			from: from,
+			want: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "in the location of the time",
+			spec: "0 2 * * *",
+			from: from.In(paris),
+			want: time.Date(2021, time.March, 11, 2, 0, 0, 0, paris),
+		},
+		{
+			name: "never",
+			spec: "0 0 30 2 *",
+			from: from,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseSchedule() error = %v", err)
+			}
+			if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
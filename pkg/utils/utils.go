@@ -13,8 +13,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"unicode/utf8"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/conversion"
@@ -25,6 +28,8 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/metrics"
 )
 
 var log = logf.Log.WithName("utils")
@@ -182,13 +187,14 @@ func compareManifests(r1, r2 *runtime.RawExtension) bool {
 	return !hasDiff
 }
 
-// CreateOrUpdateManifestWork creates a new ManifestWork or update an existing ManifestWork
+// CreateOrUpdateManifestWork creates a new ManifestWork or update an existing ManifestWork,
+// and returns whether the ManifestWork was created, updated or unchanged
 func CreateOrUpdateManifestWork(
 	manifestwork *manifestworkv1.ManifestWork,
 	client client.Client,
 	owner metav1.Object,
 	scheme *runtime.Scheme,
-) error {
+) (controllerutil.OperationResult, error) {
 	var oldManifestwork manifestworkv1.ManifestWork
 
 	err := client.Get(
@@ -200,27 +206,32 @@ func CreateOrUpdateManifestWork(
 		// Check if update is require
 		if !compareManifestWorks(&oldManifestwork, manifestwork) {
 			oldManifestwork.Spec.Workload.Manifests = manifestwork.Spec.Workload.Manifests
-			if err := client.Update(context.TODO(), &oldManifestwork); err != nil {
+			err := client.Update(context.TODO(), &oldManifestwork)
+			metrics.ManifestWorkOperations.WithLabelValues(metrics.OperationUpdate, metrics.ResultOf(err)).Inc()
+			if err != nil {
 				log.Error(err, "Fail to update manifestwork")
-				return err
+				return controllerutil.OperationResultNone, err
 			}
+			return controllerutil.OperationResultUpdated, nil
 		}
 	} else {
 		if errors.IsNotFound(err) {
 			if err := controllerutil.SetControllerReference(owner, manifestwork, scheme); err != nil {
 				log.Error(err, "Unable to SetControllerReference")
-				return err
+				return controllerutil.OperationResultNone, err
 			}
-			if err := client.Create(context.TODO(), manifestwork); err != nil {
+			err := client.Create(context.TODO(), manifestwork)
+			metrics.ManifestWorkOperations.WithLabelValues(metrics.OperationCreate, metrics.ResultOf(err)).Inc()
+			if err != nil {
 				log.Error(err, "Fail to create manifestwork")
-				return err
+				return controllerutil.OperationResultNone, err
 			}
-			return nil
+			return controllerutil.OperationResultCreated, nil
 		}
-		return err
+		return controllerutil.OperationResultNone, err
 	}
 
-	return nil
+	return controllerutil.OperationResultNone, nil
 }
 
 // DeleteManifestWork deletes a manifestwork
@@ -246,6 +257,7 @@ func DeleteManifestWork(name, namespace string, client client.Client, removeFina
 
 	if manifestWork.DeletionTimestamp == nil {
 		err := client.Delete(context.TODO(), manifestWork)
+		metrics.ManifestWorkOperations.WithLabelValues(metrics.OperationDelete, metrics.ResultOf(err)).Inc()
 		if err != nil {
 			return err
 		}
@@ -267,3 +279,67 @@ func GetManifestWork(name, namespace string, client client.Client) (*manifestwor
 
 	return manifestWork, nil
 }
+
+const (
+	// MaxManifestFailures is the maximum number of failed manifests reported for a ManifestWork
+	MaxManifestFailures = 5
+	// maxManifestFailureMessageLength is the maximum length of the message of a failed manifest
+	maxManifestFailureMessageLength = 256
+)
+
+// GetManifestFailures returns the manifests of the ManifestWork whose Applied condition is false, in the order of the
+// manifests. At most MaxManifestFailures manifests are returned and long messages are truncated
+func GetManifestFailures(mw *manifestworkv1.ManifestWork) []agentv1.ManifestFailure {
+	if mw == nil {
+		return nil
+	}
+	var failures []agentv1.ManifestFailure
+	for _, mc := range mw.Status.ResourceStatus.Manifests {
+		applied := meta.FindStatusCondition(mc.Conditions, string(manifestworkv1.ManifestApplied))
+		if applied == nil || applied.Status != metav1.ConditionFalse {
+			continue
+		}
+		if len(failures) == MaxManifestFailures {
+			break
+		}
+		message := truncate(applied.Message, maxManifestFailureMessageLength)
+		failures = append(failures, agentv1.ManifestFailure{
+			Group:     mc.ResourceMeta.Group,
+			Version:   mc.ResourceMeta.Version,
+			Kind:      mc.ResourceMeta.Kind,
+			Namespace: mc.ResourceMeta.Namespace,
+			Name:      mc.ResourceMeta.Name,
+			Message:   message,
+		})
+	}
+	return failures
+}
+
+// truncate shortens s to at most max bytes ending with "...", it is cut on a rune boundary so the result is valid UTF-8
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	end := max - 3
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + "..."
+}
+
+// FormatManifestFailures returns a human readable list of the failed manifests, numFailed is the total number of
+// failed manifests so the truncated ones are counted
+func FormatManifestFailures(failures []agentv1.ManifestFailure, numFailed int) string {
+	details := make([]string, 0, len(failures)+1)
+	for _, f := range failures {
+		name := f.Name
+		if f.Namespace != "" {
+			name = f.Namespace + "/" + f.Name
+		}
+		details = append(details, fmt.Sprintf("%s %s: %s", f.Kind, name, f.Message))
+	}
+	if numFailed > len(failures) {
+		details = append(details, fmt.Sprintf("and %d more", numFailed-len(failures)))
+	}
+	return strings.Join(details, "; ")
+}
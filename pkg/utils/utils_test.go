@@ -10,11 +10,14 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	managedclusterv1 "github.com/open-cluster-management/api/cluster/v1"
 	manifestworkv1 "github.com/open-cluster-management/api/work/v1"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,6 +25,7 @@ import (
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
@@ -314,6 +318,15 @@ func TestCreateOrUpdateManifestWork(t *testing.T) {
 			Namespace: "test-managedcluster",
 		},
 	}
+	changedManifestWork := manifestWork.DeepCopy()
+	changedManifestWork.Spec.Workload.Manifests = []manifestworkv1.Manifest{
+		{
+			RawExtension: runtime.RawExtension{Object: &corev1.Namespace{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+				ObjectMeta: metav1.ObjectMeta{Name: "open-cluster-management-agent-addon"},
+			}},
+		},
+	}
 
 	type args struct {
 		manifestwork *manifestworkv1.ManifestWork
@@ -323,9 +336,10 @@ func TestCreateOrUpdateManifestWork(t *testing.T) {
 	}
 
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
+		name       string
+		args       args
+		wantResult controllerutil.OperationResult
+		wantErr    bool
 	}{
 		{
 			name: "create manifestwork",
@@ -335,24 +349,38 @@ func TestCreateOrUpdateManifestWork(t *testing.T) {
 				owner:        manifestWork,
 				scheme:       testscheme,
 			},
-			wantErr: false,
+			wantResult: controllerutil.OperationResultCreated,
+			wantErr:    false,
 		},
 		{
 			name: "update manifestwork",
+			args: args{
+				manifestwork: changedManifestWork,
+				client:       fake.NewFakeClientWithScheme(testscheme, []runtime.Object{manifestWork}...),
+			},
+			wantResult: controllerutil.OperationResultUpdated,
+			wantErr:    false,
+		},
+		{
+			name: "manifestwork not changed",
 			args: args{
 				manifestwork: manifestWork,
 				client:       fake.NewFakeClientWithScheme(testscheme, []runtime.Object{manifestWork}...),
 			},
-			wantErr: false,
+			wantResult: controllerutil.OperationResultNone,
+			wantErr:    false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := CreateOrUpdateManifestWork(tt.args.manifestwork, tt.args.client, tt.args.owner, tt.args.scheme)
+			result, err := CreateOrUpdateManifestWork(tt.args.manifestwork, tt.args.client, tt.args.owner, tt.args.scheme)
 			if tt.wantErr != (err != nil) {
 				t.Errorf("CreateOrUpdateManifestWork() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if result != tt.wantResult {
+				t.Errorf("CreateOrUpdateManifestWork() result = %v, want %v", result, tt.wantResult)
+			}
 		})
 	}
 }
@@ -626,3 +654,102 @@ func Test_compareManifestWorks(t *testing.T) {
 	}
 
 }
+
+func TestGetManifestFailures(t *testing.T) {
+	manifestCondition := func(ordinal int32, name string, status metav1.ConditionStatus, msg string) manifestworkv1.ManifestCondition {
+		return manifestworkv1.ManifestCondition{
+			ResourceMeta: manifestworkv1.ManifestResourceMeta{
+				Ordinal:   ordinal,
+				Version:   "v1",
+				Kind:      "Deployment",
+				Namespace: "open-cluster-management-agent-addon",
+				Name:      name,
+			},
+			Conditions: []metav1.Condition{
+				{Type: string(manifestworkv1.ManifestApplied), Status: status, Message: msg},
+			},
+		}
+	}
+	longMessage := strings.Repeat("x", 300)
+
+	mw := &manifestworkv1.ManifestWork{}
+	mw.Status.ResourceStatus.Manifests = []manifestworkv1.ManifestCondition{
+		manifestCondition(0, "applied", metav1.ConditionTrue, "Apply manifest complete"),
+		manifestCondition(1, "forbidden", metav1.ConditionFalse, "deployments.apps is forbidden"),
+		manifestCondition(2, "long", metav1.ConditionFalse, longMessage),
+	}
+	for i := 0; i < MaxManifestFailures; i++ {
+		mw.Status.ResourceStatus.Manifests = append(mw.Status.ResourceStatus.Manifests,
+			manifestCondition(int32(3+i), "failed", metav1.ConditionFalse, "failed"))
+	}
+
+	failures := GetManifestFailures(mw)
+	assert.Len(t, failures, MaxManifestFailures)
+	assert.Equal(t, agentv1.ManifestFailure{
+		Version:   "v1",
+		Kind:      "Deployment",
+		Namespace: "open-cluster-management-agent-addon",
+		Name:      "forbidden",
+		Message:   "deployments.apps is forbidden",
+	}, failures[0])
+	assert.Len(t, failures[1].Message, maxManifestFailureMessageLength)
+	assert.True(t, strings.HasSuffix(failures[1].Message, "..."))
+
+	assert.Nil(t, GetManifestFailures(nil))
+	assert.Nil(t, GetManifestFailures(&manifestworkv1.ManifestWork{}))
+}
+
+func TestFormatManifestFailures(t *testing.T) {
+	failures := []agentv1.ManifestFailure{
+		{Kind: "ClusterRole", Name: "search", Message: "forbidden"},
+		{Kind: "Deployment", Namespace: "open-cluster-management-agent-addon", Name: "search", Message: "invalid"},
+	}
+	assert.Equal(t,
+		"ClusterRole search: forbidden; Deployment open-cluster-management-agent-addon/search: invalid",
+		FormatManifestFailures(failures, 2))
+	assert.Equal(t,
+		"ClusterRole search: forbidden; Deployment open-cluster-management-agent-addon/search: invalid; and 3 more",
+		FormatManifestFailures(failures, 5))
+}
+
+func Test_truncate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		want string
+	}{
+		{
+			name: "short string",
+			s:    "forbidden",
+			max:  10,
+			want: "forbidden",
+		},
+		{
+			name: "ascii string",
+			s:    "deployments.apps is forbidden",
+			max:  10,
+			want: "deploym...",
+		},
+		{
+			name: "cut in the middle of a rune",
+			s:    "ressource créée",
+			max:  16,
+			want: "ressource cr...",
+		},
+		{
+			name: "cut after a multi-byte rune",
+			s:    "éééé",
+			max:  7,
+			want: "éé...",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.s, tt.max)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, utf8.ValidString(got))
+			assert.LessOrEqual(t, len(got), tt.max)
+		})
+	}
+}
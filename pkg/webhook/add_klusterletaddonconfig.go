@@ -0,0 +1,13 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package webhook
+
+import (
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/webhook/klusterletaddonconfig"
+)
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create webhooks and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, klusterletaddonconfig.Add)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddonconfig
+
+import (
+	"context"
+	"net/http"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Defaulter records the default imagePullSecret, imageRegistry & proxyConfig of the controller in klusterletaddonconfigs,
+// and marks their spec.version as honored
+type Defaulter struct {
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &Defaulter{}
+var _ admission.DecoderInjector = &Defaulter{}
+
+// InjectDecoder injects the decoder
+func (d *Defaulter) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+	return nil
+}
+
+// Handle patches the empty fields of klusterletaddonconfigs with their defaults on create. Existing
+// klusterletaddonconfigs are not defaulted, so changing the defaults of the controller does not rewrite them.
+// Only the defaulted fields are patched, the rest of the object is left untouched. The spec.version of a new
+// klusterletaddonconfig is set knowing it is honored, so it is marked as migrated
+func (d *Defaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create {
+		return admission.Allowed("")
+	}
+
+	klusterletaddonconfig := &agentv1.KlusterletAddonConfig{}
+	if err := d.decoder.Decode(req, klusterletaddonconfig); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if klusterletaddonconfig.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+
+	defaulted := klusterletaddonconfig.DeepCopy()
+	defaulted.Default()
+
+	var patches []jsonpatch.JsonPatchOperation
+	if defaulted.Spec.ImagePullSecret != klusterletaddonconfig.Spec.ImagePullSecret {
+		patches = append(patches, jsonpatch.NewPatch("add", "/spec/imagePullSecret", defaulted.Spec.ImagePullSecret))
+	}
+	if defaulted.Spec.ImageRegistry != klusterletaddonconfig.Spec.ImageRegistry {
+		patches = append(patches, jsonpatch.NewPatch("add", "/spec/imageRegistry", defaulted.Spec.ImageRegistry))
+	}
+	if klusterletaddonconfig.Spec.ProxyConfig == nil && defaulted.Spec.ProxyConfig != nil {
+		patches = append(patches, jsonpatch.NewPatch("add", "/spec/proxyConfig", defaulted.Spec.ProxyConfig))
+	}
+	if !klusterletaddonconfig.IsVersionMigrated() {
+		if len(klusterletaddonconfig.Annotations) == 0 {
+			patches = append(patches, jsonpatch.NewPatch("add", "/metadata/annotations", map[string]string{
+				agentv1.KlusterletAddonConfigAnnotationVersionMigrated: "true",
+			}))
+		} else {
+			patches = append(patches, jsonpatch.NewPatch("add",
+				"/metadata/annotations/"+agentv1.KlusterletAddonConfigAnnotationVersionMigrated, "true"))
+		}
+	}
+	if len(patches) == 0 {
+		return admission.Allowed("")
+	}
+	return admission.Patched("defaults recorded", patches...)
+}
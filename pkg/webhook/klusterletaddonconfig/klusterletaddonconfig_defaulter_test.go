@@ -0,0 +1,124 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddonconfig
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestDefaulter_Handle(t *testing.T) {
+	testscheme := scheme.Scheme
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
+
+	decoder, err := admission.NewDecoder(testscheme)
+	if err != nil {
+		t.Fatalf("failed to create decoder: %v", err)
+	}
+
+	os.Setenv(agentv1.DefaultImagePullSecretEnv, "default-pull-secret")
+	os.Setenv(agentv1.DefaultImageRegistryEnv, "mirror.example.com/ocm")
+	os.Setenv(agentv1.DefaultHTTPSProxyEnv, "http://proxy.example.com:3128")
+	defer os.Unsetenv(agentv1.DefaultImagePullSecretEnv)
+	defer os.Unsetenv(agentv1.DefaultImageRegistryEnv)
+	defer os.Unsetenv(agentv1.DefaultHTTPSProxyEnv)
+
+	defaultProxy := &agentv1.ProxyConfig{HTTPSProxy: "http://proxy.example.com:3128"}
+	versionMigrated := jsonpatch.NewPatch("add", "/metadata/annotations", map[string]string{
+		agentv1.KlusterletAddonConfigAnnotationVersionMigrated: "true",
+	})
+
+	tests := []struct {
+		name        string
+		obj         *agentv1.KlusterletAddonConfig
+		operation   admissionv1beta1.Operation
+		wantPatches []jsonpatch.JsonPatchOperation
+	}{
+		{
+			name:      "record defaults on create",
+			obj:       newTestKlusterletAddonConfig("cluster1", "cluster1"),
+			operation: admissionv1beta1.Create,
+			wantPatches: []jsonpatch.JsonPatchOperation{
+				jsonpatch.NewPatch("add", "/spec/imagePullSecret", "default-pull-secret"),
+				jsonpatch.NewPatch("add", "/spec/imageRegistry", "mirror.example.com/ocm"),
+				jsonpatch.NewPatch("add", "/spec/proxyConfig", defaultProxy),
+				versionMigrated,
+			},
+		},
+		{
+			name: "keep values set by users",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.ImagePullSecret = "pull-secret"
+				kac.Spec.ProxyConfig = &agentv1.ProxyConfig{HTTPProxy: "http://proxy.example.com:8080"}
+				return kac
+			}(),
+			operation: admissionv1beta1.Create,
+			wantPatches: []jsonpatch.JsonPatchOperation{
+				jsonpatch.NewPatch("add", "/spec/imageRegistry", "mirror.example.com/ocm"),
+				versionMigrated,
+			},
+		},
+		{
+			name:        "existing klusterletaddonconfigs are not defaulted",
+			obj:         newTestKlusterletAddonConfig("cluster1", "cluster1"),
+			operation:   admissionv1beta1.Update,
+			wantPatches: nil,
+		},
+		{
+			name: "nothing to default",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.ImagePullSecret = "pull-secret"
+				kac.Spec.ImageRegistry = "registry.example.com"
+				kac.Spec.ProxyConfig = &agentv1.ProxyConfig{}
+				kac.Annotations = map[string]string{agentv1.KlusterletAddonConfigAnnotationVersionMigrated: "true"}
+				return kac
+			}(),
+			operation:   admissionv1beta1.Create,
+			wantPatches: nil,
+		},
+		{
+			name: "mark the version of a new klusterletaddonconfig as migrated",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.ImagePullSecret = "pull-secret"
+				kac.Spec.ImageRegistry = "registry.example.com"
+				kac.Spec.ProxyConfig = &agentv1.ProxyConfig{}
+				kac.Spec.Version = "2.2.0"
+				kac.Annotations = map[string]string{"owner": "team-a"}
+				return kac
+			}(),
+			operation: admissionv1beta1.Create,
+			wantPatches: []jsonpatch.JsonPatchOperation{
+				jsonpatch.NewPatch("add",
+					"/metadata/annotations/"+agentv1.KlusterletAddonConfigAnnotationVersionMigrated, "true"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Defaulter{}
+			if err := d.InjectDecoder(decoder); err != nil {
+				t.Fatalf("failed to inject decoder: %v", err)
+			}
+			resp := d.Handle(context.TODO(), newTestAdmissionRequest(t, tt.obj, tt.operation))
+			if !resp.Allowed {
+				t.Errorf("Handle() should allow, result %v", resp.Result)
+			}
+			if !reflect.DeepEqual(resp.Patches, tt.wantPatches) {
+				t.Errorf("Handle() patches = %v, want %v", resp.Patches, tt.wantPatches)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddonconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/components/registry"
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/utils"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator rejects klusterletaddonconfigs which can never be reconciled
+type Validator struct {
+	reader  client.Reader
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &Validator{}
+var _ admission.DecoderInjector = &Validator{}
+
+// InjectDecoder injects the decoder
+func (v *Validator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle validates klusterletaddonconfigs on create & update
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1beta1.Create && req.Operation != admissionv1beta1.Update {
+		return admission.Allowed("")
+	}
+
+	klusterletaddonconfig := &agentv1.KlusterletAddonConfig{}
+	if err := v.decoder.Decode(req, klusterletaddonconfig); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// do not block the removal of finalizers
+	if klusterletaddonconfig.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+
+	var reasons []string
+	reasons = append(reasons, validateClusterNameAndNamespace(klusterletaddonconfig)...)
+	reasons = append(reasons, validateProxyConfig(klusterletaddonconfig)...)
+	reasons = append(reasons, validateCustomAddons(klusterletaddonconfig)...)
+	reasons = append(reasons, validatePausedAddons(klusterletaddonconfig)...)
+	reasons = append(reasons, validateMaintenanceWindows(klusterletaddonconfig)...)
+
+	var oldKlusterletAddonConfig *agentv1.KlusterletAddonConfig
+	oldVersion := ""
+	if req.Operation == admissionv1beta1.Update && len(req.OldObject.Raw) > 0 {
+		oldKlusterletAddonConfig = &agentv1.KlusterletAddonConfig{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldKlusterletAddonConfig); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		oldVersion = oldKlusterletAddonConfig.Spec.Version
+	}
+	reasons = append(reasons, validateVersion(klusterletaddonconfig, oldVersion)...)
+	reasons = append(reasons, validateAddonDependencies(klusterletaddonconfig, oldKlusterletAddonConfig)...)
+
+	reason, err := v.validateImagePullSecret(ctx, klusterletaddonconfig)
+	if err != nil {
+		log.Error(err, "failed to validate imagePullSecret",
+			"name", klusterletaddonconfig.Name, "namespace", klusterletaddonconfig.Namespace)
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if reason != "" {
+		reasons = append(reasons, reason)
+	}
+
+	if len(reasons) > 0 {
+		return admission.Denied(strings.Join(reasons, "; "))
+	}
+	return admission.Allowed("")
+}
+
+// validateClusterNameAndNamespace makes sure the klusterletaddonconfig is in the namespace of its ManagedCluster,
+// and has the same name as its ManagedCluster, otherwise it is never reconciled
+func validateClusterNameAndNamespace(klusterletaddonconfig *agentv1.KlusterletAddonConfig) []string {
+	var reasons []string
+	if klusterletaddonconfig.Name != klusterletaddonconfig.Namespace {
+		reasons = append(reasons, fmt.Sprintf(
+			"metadata.name %q must be the same as metadata.namespace %q, which is the name of the ManagedCluster",
+			klusterletaddonconfig.Name, klusterletaddonconfig.Namespace))
+	}
+	if klusterletaddonconfig.Spec.ClusterName != "" &&
+		klusterletaddonconfig.Spec.ClusterName != klusterletaddonconfig.Namespace {
+		reasons = append(reasons, fmt.Sprintf(
+			"spec.clusterName %q must be the name of the ManagedCluster %q",
+			klusterletaddonconfig.Spec.ClusterName, klusterletaddonconfig.Namespace))
+	}
+	if klusterletaddonconfig.Spec.ClusterNamespace != "" &&
+		klusterletaddonconfig.Spec.ClusterNamespace != klusterletaddonconfig.Namespace {
+		reasons = append(reasons, fmt.Sprintf(
+			"spec.clusterNamespace %q must be the namespace of the ManagedCluster %q",
+			klusterletaddonconfig.Spec.ClusterNamespace, klusterletaddonconfig.Namespace))
+	}
+	return reasons
+}
+
+// validateProxyConfig makes sure the proxies are http or https URLs, which is what the addons expect in HTTP(S)_PROXY
+func validateProxyConfig(klusterletaddonconfig *agentv1.KlusterletAddonConfig) []string {
+	proxy := klusterletaddonconfig.Spec.ProxyConfig
+	if proxy == nil {
+		return nil
+	}
+	var reasons []string
+	for _, p := range []struct{ field, value string }{
+		{"spec.proxyConfig.httpProxy", proxy.HTTPProxy},
+		{"spec.proxyConfig.httpsProxy", proxy.HTTPSProxy},
+	} {
+		if p.value == "" {
+			continue
+		}
+		u, err := url.Parse(p.value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			reasons = append(reasons, fmt.Sprintf("%s %q must be a http or https URL", p.field, p.value))
+		}
+	}
+	return reasons
+}
+
+// validateCustomAddons makes sure the customAddons are addons rendered from a template of the addon registry
+func validateCustomAddons(klusterletaddonconfig *agentv1.KlusterletAddonConfig) []string {
+	var reasons []string
+	for name := range klusterletaddonconfig.Spec.CustomAddons {
+		registration, ok := registry.Get(name)
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("spec.customAddons %q is not a registered addon", name))
+			continue
+		}
+		if _, ok := registration.Addon.(registry.ManifestsAddon); !ok {
+			reasons = append(reasons, fmt.Sprintf("spec.customAddons %q is not an addon rendered from a template", name))
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+// validatePausedAddons makes sure the pausedAddons are registered addons, by addon or ManagedClusterAddOn name
+func validatePausedAddons(klusterletaddonconfig *agentv1.KlusterletAddonConfig) []string {
+	var reasons []string
+	for _, name := range klusterletaddonconfig.Spec.PausedAddons {
+		if _, ok := registry.Get(name); ok {
+			continue
+		}
+		if _, ok := registry.GetByManagedClusterAddOnName(name); ok {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("spec.pausedAddons %q is not a registered addon", name))
+	}
+	return reasons
+}
+
+// validateMaintenanceWindows makes sure the maintenance windows have a cron schedule, a positive duration and a
+// known time zone, otherwise the updates are deferred forever
+func validateMaintenanceWindows(klusterletaddonconfig *agentv1.KlusterletAddonConfig) []string {
+	var reasons []string
+	for i, window := range klusterletaddonconfig.Spec.MaintenanceWindows {
+		if _, _, err := utils.ParseMaintenanceWindow(window); err != nil {
+			reasons = append(reasons, fmt.Sprintf("spec.maintenanceWindows[%d] is invalid: %v", i, err))
+		}
+	}
+	return reasons
+}
+
+// validateAddonDependencies makes sure the addons an enabled addon depends on are enabled, otherwise the addon waits
+// for its dependencies forever. A missing dependency which was already missing before the update is not reported, so
+// the klusterletaddonconfigs created before the validation can still be updated
+func validateAddonDependencies(klusterletaddonconfig, oldKlusterletAddonConfig *agentv1.KlusterletAddonConfig) []string {
+	// missingDependencies returns the reasons of the enabled addons whose dependencies are not enabled
+	missingDependencies := func(instance *agentv1.KlusterletAddonConfig) []string {
+		var missing []string
+		for _, addon := range registry.AddonsInDependencyOrder() {
+			if !addon.IsEnabled(instance) {
+				continue
+			}
+			for _, dependency := range addon.GetDependencies() {
+				if registration, ok := registry.Get(dependency); ok && !registration.Addon.IsEnabled(instance) {
+					missing = append(missing, fmt.Sprintf("addon %q depends on addon %q which is not enabled",
+						addon.GetAddonName(), dependency))
+				}
+			}
+		}
+		return missing
+	}
+
+	alreadyMissing := map[string]bool{}
+	if oldKlusterletAddonConfig != nil {
+		for _, reason := range missingDependencies(oldKlusterletAddonConfig) {
+			alreadyMissing[reason] = true
+		}
+	}
+	var reasons []string
+	for _, reason := range missingDependencies(klusterletaddonconfig) {
+		if !alreadyMissing[reason] {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
+
+// validateVersion makes sure a loaded image manifest matches the version the klusterletaddonconfig is pinned to. An
+// unchanged version is not validated again, its image manifest may have been removed since
+func validateVersion(klusterletaddonconfig *agentv1.KlusterletAddonConfig, oldVersion string) []string {
+	version := klusterletaddonconfig.Spec.Version
+	if version == "" || version == oldVersion {
+		return nil
+	}
+	if err := agentv1.CheckImageManifest(version); err != nil {
+		return []string{fmt.Sprintf("spec.version %q has no loaded image manifest: %v", version, err)}
+	}
+	return nil
+}
+
+// validateImagePullSecret looks up the imagePullSecret the same way the klusterlet addon operator does:
+// in the namespace of the klusterletaddonconfig first, then the default imagePullSecret in the pod namespace.
+// It returns a reason if the secret found is not a dockerconfigjson secret. A secret not created yet is allowed.
+func (v *Validator) validateImagePullSecret(
+	ctx context.Context,
+	klusterletaddonconfig *agentv1.KlusterletAddonConfig,
+) (string, error) {
+	if klusterletaddonconfig.Spec.ImagePullSecret == "" {
+		return "", nil
+	}
+
+	candidates := []types.NamespacedName{
+		{Name: klusterletaddonconfig.Spec.ImagePullSecret, Namespace: klusterletaddonconfig.Namespace},
+	}
+	if name, namespace := os.Getenv(agentv1.DefaultImagePullSecretEnv), os.Getenv("POD_NAMESPACE"); name != "" {
+		candidates = append(candidates, types.NamespacedName{Name: name, Namespace: namespace})
+	}
+
+	for _, nsn := range candidates {
+		secret := &corev1.Secret{}
+		if err := v.reader.Get(ctx, nsn, secret); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+		if secret.Type != corev1.SecretTypeDockerConfigJson {
+			return fmt.Sprintf("spec.imagePullSecret %q must be of type %s, secret %s/%s is of type %s",
+				klusterletaddonconfig.Spec.ImagePullSecret, corev1.SecretTypeDockerConfigJson,
+				nsn.Namespace, nsn.Name, secret.Type), nil
+		}
+		return "", nil
+	}
+	return "", nil
+}
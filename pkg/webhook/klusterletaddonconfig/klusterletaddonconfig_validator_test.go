@@ -0,0 +1,318 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddonconfig
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	_ "github.com/open-cluster-management/klusterlet-addon-controller/pkg/components"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newTestKlusterletAddonConfig(name, namespace string) *agentv1.KlusterletAddonConfig {
+	return &agentv1.KlusterletAddonConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: agentv1.SchemeGroupVersion.String(),
+			Kind:       "KlusterletAddonConfig",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: agentv1.KlusterletAddonConfigSpec{
+			ClusterName:      namespace,
+			ClusterNamespace: namespace,
+		},
+	}
+}
+
+func newTestSecret(name, namespace string, secretType corev1.SecretType) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			".dockerconfigjson": []byte("fake-token"),
+		},
+		Type: secretType,
+	}
+}
+
+func newTestAdmissionRequest(t *testing.T, obj runtime.Object, operation admissionv1beta1.Operation) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	return admission.Request{
+		AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestValidator_Handle(t *testing.T) {
+	testscheme := scheme.Scheme
+	testscheme.AddKnownTypes(agentv1.SchemeGroupVersion, &agentv1.KlusterletAddonConfig{})
+
+	decoder, err := admission.NewDecoder(testscheme)
+	if err != nil {
+		t.Fatalf("failed to create decoder: %v", err)
+	}
+
+	os.Setenv("DEFAULT_IMAGE_PULL_SECRET", "default-pull-secret")
+	os.Setenv("POD_NAMESPACE", "open-cluster-management")
+	defer os.Unsetenv("DEFAULT_IMAGE_PULL_SECRET")
+	defer os.Unsetenv("POD_NAMESPACE")
+
+	withPullSecret := func(kac *agentv1.KlusterletAddonConfig, secret string) *agentv1.KlusterletAddonConfig {
+		kac.Spec.ImagePullSecret = secret
+		return kac
+	}
+	withVersion := func(kac *agentv1.KlusterletAddonConfig, version string) *agentv1.KlusterletAddonConfig {
+		kac.Spec.Version = version
+		return kac
+	}
+	if err := agentv1.LoadConfigmaps(fake.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "image-manifest-2.2.0",
+			Namespace: "open-cluster-management",
+			Labels:    map[string]string{"ocm-configmap-type": "image-manifest", "ocm-release-version": "2.2.0"},
+		},
+	})); err != nil {
+		t.Fatalf("failed to load the image manifests: %v", err)
+	}
+	withCertPolicy := func(kac *agentv1.KlusterletAddonConfig, policyController bool) *agentv1.KlusterletAddonConfig {
+		kac.Spec.CertPolicyControllerConfig.Enabled = true
+		kac.Spec.PolicyController.Enabled = policyController
+		return kac
+	}
+	inDeletion := newTestKlusterletAddonConfig("cluster1", "cluster2")
+	now := metav1.Now()
+	inDeletion.DeletionTimestamp = &now
+
+	tests := []struct {
+		name        string
+		objs        []runtime.Object
+		obj         *agentv1.KlusterletAddonConfig
+		oldObj      *agentv1.KlusterletAddonConfig
+		operation   admissionv1beta1.Operation
+		wantAllowed bool
+		wantReasons []string
+	}{
+		{
+			name:        "valid",
+			obj:         newTestKlusterletAddonConfig("cluster1", "cluster1"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: true,
+		},
+		{
+			name:        "name is not namespace",
+			obj:         newTestKlusterletAddonConfig("klusterletaddonconfig", "cluster1"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{`metadata.name "klusterletaddonconfig" must be the same as metadata.namespace`},
+		},
+		{
+			name: "clusterName & clusterNamespace mismatch",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.ClusterName = "cluster2"
+				kac.Spec.ClusterNamespace = "cluster2"
+				return kac
+			}(),
+			operation:   admissionv1beta1.Update,
+			wantAllowed: false,
+			wantReasons: []string{
+				`spec.clusterName "cluster2" must be the name of the ManagedCluster "cluster1"`,
+				`spec.clusterNamespace "cluster2" must be the namespace of the ManagedCluster "cluster1"`,
+			},
+		},
+		{
+			name: "invalid proxy",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.ProxyConfig = &agentv1.ProxyConfig{
+					HTTPProxy:  "http://proxy.example.com:3128",
+					HTTPSProxy: "proxy.example.com:3128",
+					NoProxy:    ".cluster.local,10.0.0.0/16",
+				}
+				return kac
+			}(),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{`spec.proxyConfig.httpsProxy "proxy.example.com:3128" must be a http or https URL`},
+		},
+		{
+			name: "unknown custom addon",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.CustomAddons = map[string]agentv1.KlusterletAddonConfigCustomAddonSpec{
+					"my-agent": {Enabled: true},
+				}
+				return kac
+			}(),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{`spec.customAddons "my-agent" is not a registered addon`},
+		},
+		{
+			name: "unknown paused addon",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.PausedAddons = []string{"my-agent"}
+				return kac
+			}(),
+			operation:   admissionv1beta1.Update,
+			wantAllowed: false,
+			wantReasons: []string{`spec.pausedAddons "my-agent" is not a registered addon`},
+		},
+		{
+			name: "invalid maintenance windows",
+			obj: func() *agentv1.KlusterletAddonConfig {
+				kac := newTestKlusterletAddonConfig("cluster1", "cluster1")
+				kac.Spec.MaintenanceWindows = []agentv1.MaintenanceWindow{
+					{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Paris"},
+					{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					{Schedule: "0 2 * * *"},
+				}
+				return kac
+			}(),
+			operation:   admissionv1beta1.Update,
+			wantAllowed: false,
+			wantReasons: []string{
+				`spec.maintenanceWindows[1] is invalid: invalid hour in schedule "0 25 * * *"`,
+				"spec.maintenanceWindows[2] is invalid: duration 0s must be positive",
+			},
+		},
+		{
+			name:        "version with an image manifest",
+			obj:         withVersion(newTestKlusterletAddonConfig("cluster1", "cluster1"), "2.1.0"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: true,
+		},
+		{
+			name:        "version without image manifest",
+			obj:         withVersion(newTestKlusterletAddonConfig("cluster1", "cluster1"), "3.0.0"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{`spec.version "3.0.0" has no loaded image manifest: version 3.0.0 not supported`},
+		},
+		{
+			name:        "unchanged version without image manifest",
+			obj:         withVersion(newTestKlusterletAddonConfig("cluster1", "cluster1"), "1.0.0"),
+			oldObj:      withVersion(newTestKlusterletAddonConfig("cluster1", "cluster1"), "1.0.0"),
+			operation:   admissionv1beta1.Update,
+			wantAllowed: true,
+		},
+		{
+			name:        "enabled dependency",
+			obj:         withCertPolicy(newTestKlusterletAddonConfig("cluster1", "cluster1"), true),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: true,
+		},
+		{
+			name:        "disabled dependency",
+			obj:         withCertPolicy(newTestKlusterletAddonConfig("cluster1", "cluster1"), false),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{`addon "certpolicyctrl" depends on addon "policyctrl" which is not enabled`},
+		},
+		{
+			name:        "dependency disabled on update",
+			obj:         withCertPolicy(newTestKlusterletAddonConfig("cluster1", "cluster1"), false),
+			oldObj:      withCertPolicy(newTestKlusterletAddonConfig("cluster1", "cluster1"), true),
+			operation:   admissionv1beta1.Update,
+			wantAllowed: false,
+			wantReasons: []string{`addon "certpolicyctrl" depends on addon "policyctrl" which is not enabled`},
+		},
+		{
+			name:        "dependency already disabled before the update",
+			obj:         withCertPolicy(newTestKlusterletAddonConfig("cluster1", "cluster1"), false),
+			oldObj:      withCertPolicy(newTestKlusterletAddonConfig("cluster1", "cluster1"), false),
+			operation:   admissionv1beta1.Update,
+			wantAllowed: true,
+		},
+		{
+			name: "imagePullSecret is dockerconfigjson",
+			objs: []runtime.Object{
+				newTestSecret("pull-secret", "cluster1", corev1.SecretTypeDockerConfigJson),
+			},
+			obj:         withPullSecret(newTestKlusterletAddonConfig("cluster1", "cluster1"), "pull-secret"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: true,
+		},
+		{
+			name: "imagePullSecret is opaque",
+			objs: []runtime.Object{
+				newTestSecret("pull-secret", "cluster1", corev1.SecretTypeOpaque),
+			},
+			obj:         withPullSecret(newTestKlusterletAddonConfig("cluster1", "cluster1"), "pull-secret"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{`spec.imagePullSecret "pull-secret" must be of type kubernetes.io/dockerconfigjson`},
+		},
+		{
+			name: "default imagePullSecret is opaque",
+			objs: []runtime.Object{
+				newTestSecret("default-pull-secret", "open-cluster-management", corev1.SecretTypeOpaque),
+			},
+			obj:         withPullSecret(newTestKlusterletAddonConfig("cluster1", "cluster1"), "pull-secret"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: false,
+			wantReasons: []string{"secret open-cluster-management/default-pull-secret is of type Opaque"},
+		},
+		{
+			name:        "imagePullSecret not created yet",
+			obj:         withPullSecret(newTestKlusterletAddonConfig("cluster1", "cluster1"), "pull-secret"),
+			operation:   admissionv1beta1.Create,
+			wantAllowed: true,
+		},
+		{
+			name:        "in deletion",
+			obj:         inDeletion,
+			operation:   admissionv1beta1.Update,
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &Validator{reader: fake.NewFakeClientWithScheme(testscheme, tt.objs...)}
+			if err := v.InjectDecoder(decoder); err != nil {
+				t.Fatalf("failed to inject decoder: %v", err)
+			}
+			req := newTestAdmissionRequest(t, tt.obj, tt.operation)
+			if tt.oldObj != nil {
+				req.OldObject = newTestAdmissionRequest(t, tt.oldObj, tt.operation).Object
+			}
+			resp := v.Handle(context.TODO(), req)
+			if resp.Allowed != tt.wantAllowed {
+				t.Errorf("Handle() allowed = %v, want %v, result %v", resp.Allowed, tt.wantAllowed, resp.Result)
+			}
+			for _, reason := range tt.wantReasons {
+				if resp.Result == nil || !strings.Contains(string(resp.Result.Reason), reason) {
+					t.Errorf("Handle() result %v should contain %q", resp.Result, reason)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+// Package klusterletaddonconfig contains the admission webhooks of klusterletaddonconfigs
+package klusterletaddonconfig
+
+import (
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// paths the webhooks of klusterletaddonconfigs are served on
+const (
+	MutatingWebhookPath   = "/mutate-agent-open-cluster-management-io-v1-klusterletaddonconfig"
+	ValidatingWebhookPath = "/validate-agent-open-cluster-management-io-v1-klusterletaddonconfig"
+)
+
+var log = logf.Log.WithName("klusterletaddonconfig-webhook")
+
+// Add registers the webhooks of klusterletaddonconfigs to the webhook server of the manager
+func Add(mgr manager.Manager) error {
+	mgr.GetWebhookServer().Register(MutatingWebhookPath, &webhook.Admission{
+		Handler: &Defaulter{},
+	})
+	// read secrets directly from the apiserver, so the manager does not cache all secrets of the hub
+	mgr.GetWebhookServer().Register(ValidatingWebhookPath, &webhook.Admission{
+		Handler: &Validator{reader: mgr.GetAPIReader()},
+	})
+	return nil
+}
@@ -0,0 +1,269 @@
+// Copyright (c) Red Hat, Inc.
+// Copyright Contributors to the Open Cluster Management project
+
+package klusterletaddonconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis"
+	agentv1 "github.com/open-cluster-management/klusterlet-addon-controller/pkg/apis/agent/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// envtestAssetsAvailable returns true if the kube-apiserver & etcd binaries used by envtest can be found, it fails
+// the test if KUBEBUILDER_ASSETS is set to a directory without them, as make test does
+func envtestAssetsAvailable(t *testing.T) bool {
+	assets := os.Getenv("KUBEBUILDER_ASSETS")
+	required := assets != ""
+	if !required {
+		assets = "/usr/local/kubebuilder/bin"
+	}
+	if _, err := os.Stat(filepath.Join(assets, "kube-apiserver")); err != nil {
+		if required {
+			t.Fatalf("envtest assets not found in KUBEBUILDER_ASSETS: %v", err)
+		}
+		return false
+	}
+	return true
+}
+
+func newTestValidatingWebhookConfiguration() *admissionregistrationv1.ValidatingWebhookConfiguration {
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	// envtest replaces the service by https://<host>:<port>/<path>
+	path := strings.TrimPrefix(ValidatingWebhookPath, "/")
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+			Kind:       "ValidatingWebhookConfiguration",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "klusterlet-addon-controller-webhook",
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name:                    "klusterletaddonconfig.validating.agent.open-cluster-management.io",
+				AdmissionReviewVersions: []string{"v1beta1"},
+				SideEffects:             &sideEffects,
+				FailurePolicy:           &failurePolicy,
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Name:      "klusterlet-addon-controller-webhook",
+						Namespace: "open-cluster-management",
+						Path:      &path,
+					},
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create,
+							admissionregistrationv1.Update,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"agent.open-cluster-management.io"},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"klusterletaddonconfigs"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestMutatingWebhookConfiguration() *admissionregistrationv1.MutatingWebhookConfiguration {
+	failurePolicy := admissionregistrationv1.Fail
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	path := strings.TrimPrefix(MutatingWebhookPath, "/")
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+			Kind:       "MutatingWebhookConfiguration",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "klusterlet-addon-controller-webhook",
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:                    "klusterletaddonconfig.mutating.agent.open-cluster-management.io",
+				AdmissionReviewVersions: []string{"v1beta1"},
+				SideEffects:             &sideEffects,
+				FailurePolicy:           &failurePolicy,
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Name:      "klusterlet-addon-controller-webhook",
+						Namespace: "open-cluster-management",
+						Path:      &path,
+					},
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"agent.open-cluster-management.io"},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"klusterletaddonconfigs"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWebhookWithAPIServer(t *testing.T) {
+	if !envtestAssetsAvailable(t) {
+		t.Skip("envtest assets not found, set KUBEBUILDER_ASSETS to run this test")
+	}
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "..", "deploy", "agent.open-cluster-management.io_klusterletaddonconfigs_crd.yaml"),
+		},
+		ErrorIfCRDPathMissing: true,
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			MutatingWebhooks:   []runtime.Object{newTestMutatingWebhookConfiguration()},
+			ValidatingWebhooks: []runtime.Object{newTestValidatingWebhookConfiguration()},
+		},
+	}
+	cfg, err := testEnv.Start()
+	if err != nil {
+		t.Fatalf("failed to start envtest: %v", err)
+	}
+	defer func() {
+		if err := testEnv.Stop(); err != nil {
+			t.Errorf("failed to stop envtest: %v", err)
+		}
+	}()
+
+	testscheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(testscheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	if err := apis.AddToScheme(testscheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	webhookOptions := testEnv.WebhookInstallOptions
+	mgr, err := manager.New(cfg, manager.Options{
+		Scheme:             testscheme,
+		Host:               webhookOptions.LocalServingHost,
+		Port:               webhookOptions.LocalServingPort,
+		CertDir:            webhookOptions.LocalServingCertDir,
+		MetricsBindAddress: "0",
+	})
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	if err := Add(mgr); err != nil {
+		t.Fatalf("failed to add webhook: %v", err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		if err := mgr.Start(stop); err != nil {
+			t.Errorf("failed to start manager: %v", err)
+		}
+	}()
+
+	// wait for the webhook server to serve
+	addr := net.JoinHostPort(webhookOptions.LocalServingHost, fmt.Sprintf("%d", webhookOptions.LocalServingPort))
+	if err := wait.PollImmediate(100*time.Millisecond, 10*time.Second, func() (bool, error) {
+		// #nosec G402 -- only checks the port is served
+		conn, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true})
+		if err != nil {
+			return false, nil
+		}
+		conn.Close()
+		return true, nil
+	}); err != nil {
+		t.Fatalf("webhook server is not serving: %v", err)
+	}
+
+	c, err := client.New(cfg, client.Options{Scheme: testscheme})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	for _, ns := range []string{"cluster1", "cluster2"} {
+		if err := c.Create(context.TODO(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}}); err != nil {
+			t.Fatalf("failed to create namespace %s: %v", ns, err)
+		}
+	}
+	if err := c.Create(context.TODO(), newTestSecret("opaque-secret", "cluster2", corev1.SecretTypeOpaque)); err != nil {
+		t.Fatalf("failed to create secret: %v", err)
+	}
+
+	opaquePullSecret := newTestKlusterletAddonConfig("cluster2", "cluster2")
+	opaquePullSecret.Spec.ImagePullSecret = "opaque-secret"
+
+	tests := []struct {
+		name       string
+		obj        runtime.Object
+		wantErr    bool
+		wantReason string
+	}{
+		{
+			name:    "valid",
+			obj:     newTestKlusterletAddonConfig("cluster1", "cluster1"),
+			wantErr: false,
+		},
+		{
+			name:       "name is not namespace",
+			obj:        newTestKlusterletAddonConfig("klusterletaddonconfig", "cluster1"),
+			wantErr:    true,
+			wantReason: `metadata.name "klusterletaddonconfig" must be the same as metadata.namespace "cluster1"`,
+		},
+		{
+			name:       "imagePullSecret is opaque",
+			obj:        opaquePullSecret,
+			wantErr:    true,
+			wantReason: `spec.imagePullSecret "opaque-secret" must be of type kubernetes.io/dockerconfigjson`,
+		},
+	}
+
+	os.Setenv(agentv1.DefaultImageRegistryEnv, "mirror.example.com/ocm")
+	defer os.Unsetenv(agentv1.DefaultImageRegistryEnv)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.Create(context.TODO(), tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantReason) {
+				t.Errorf("Create() error = %v, should contain %q", err, tt.wantReason)
+			}
+		})
+	}
+
+	// defaults are recorded by the defaulting webhook
+	created := &agentv1.KlusterletAddonConfig{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: "cluster1", Namespace: "cluster1"}, created); err != nil {
+		t.Fatalf("failed to get klusterletaddonconfig: %v", err)
+	}
+	if created.Spec.ImageRegistry != "mirror.example.com/ocm" {
+		t.Errorf("spec.imageRegistry = %q, want %q", created.Spec.ImageRegistry, "mirror.example.com/ocm")
+	}
+}
@@ -22,32 +22,32 @@ var cmaValidations = map[string][]string{
 	clustermanagementaddon.ApplicationManager: []string{
 		`"kind":"ClusterManagementAddOn"`,
 		`"name":"application-manager"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.ApplicationManager].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.ApplicationManager).DisplayName + `"`,
 	},
 	clustermanagementaddon.CertPolicyController: []string{
 		`"kind":"ClusterManagementAddOn"`,
 		`"name":"cert-policy-controller"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.CertPolicyController].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.CertPolicyController).DisplayName + `"`,
 	},
 	clustermanagementaddon.IamPolicyController: []string{
 		`"kind":"ClusterManagementAddOn"`,
 		`"name":"iam-policy-controller"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.IamPolicyController].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.IamPolicyController).DisplayName + `"`,
 	},
 	clustermanagementaddon.PolicyController: []string{
 		`"kind":"ClusterManagementAddOn"`,
 		`"name":"policy-controller"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.PolicyController].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.PolicyController).DisplayName + `"`,
 	},
 	clustermanagementaddon.SearchCollector: []string{
 		`"kind":"ClusterManagementAddOn"`,
 		`"name":"search-collector"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.SearchCollector].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.SearchCollector).DisplayName + `"`,
 	},
 	clustermanagementaddon.WorkManager: []string{
 		`"kind":"ClusterManagementAddOn"`,
 		`"name":"work-manager"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.WorkManager].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.WorkManager).DisplayName + `"`,
 	},
 }
 
@@ -84,7 +84,7 @@ var _ = Describe("Creating ClusterManagementAddOn", func() {
 		By("Check all addons has clustermanagementaddons", func() {
 			var err error
 			Expect(err).Should(BeNil())
-			for _, addonName := range clustermanagementaddon.ClusterManagementAddOnNames {
+			for _, addonName := range clustermanagementaddon.ClusterManagementAddOnNames() {
 				var clusterManagementAddOn *unstructured.Unstructured
 				Eventually(func() error {
 					clusterManagementAddOn, err = clientClusterDynamic.Resource(gvrClusterManagementAddOn).Get(context.TODO(), addonName, metav1.GetOptions{})
@@ -97,7 +97,7 @@ var _ = Describe("Creating ClusterManagementAddOn", func() {
 
 		By("Deleting one by one ClusterManagementAddOn", func() {
 			var err error
-			for _, addonName := range clustermanagementaddon.ClusterManagementAddOnNames {
+			for _, addonName := range clustermanagementaddon.ClusterManagementAddOnNames() {
 				err = clientClusterDynamic.Resource(gvrClusterManagementAddOn).Delete(context.TODO(), addonName, metav1.DeleteOptions{})
 				Expect(err).To(BeNil())
 				By("Checking the ClusterManagementAddOn "+addonName+" is created back", func() {
@@ -114,7 +114,7 @@ var _ = Describe("Creating ClusterManagementAddOn", func() {
 
 		By("Modifying one by one ClusterManagementAddOn", func() {
 			var err error
-			for _, addonName := range clustermanagementaddon.ClusterManagementAddOnNames {
+			for _, addonName := range clustermanagementaddon.ClusterManagementAddOnNames() {
 				_, err = clientClusterDynamic.Resource(gvrClusterManagementAddOn).Patch(context.TODO(), addonName, types.JSONPatchType, []byte(addOnPatchStrings[addonName]), metav1.PatchOptions{})
 				Expect(err).To(BeNil())
 				time.Sleep(time.Second * 2)
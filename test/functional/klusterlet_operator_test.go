@@ -94,14 +94,22 @@ var addPatchStrings = map[string]string{
 	),
 }
 
-// list of manifestwork name for addon crs
+// list of manifestwork name for addon crs, in dependency order
 var addonCRs = []string{
+	workManager,
+	applicationManager,
+	policyController,
+	certPolicyController,
+	iamPolicyController,
+	searchCollector,
+}
+
+// list of manifestwork name for addon crs which no other addon depends on
+var leafAddonCRs = []string{
 	applicationManager,
 	certPolicyController,
 	iamPolicyController,
-	policyController,
 	searchCollector,
-	workManager,
 }
 
 // list of regex we will use to validate json from the manifestwork
@@ -227,10 +235,12 @@ var _ = Describe("Creating KlusterletAddonConfig", func() {
 					Eventually(func() error {
 						cr, err = clientClusterDynamic.Resource(gvrManifestwork).Namespace(testNamespace).Get(context.TODO(), crName, metav1.GetOptions{})
 						return err
-					}, 5, 1).Should(BeNil())
+					}, 10, 1).Should(BeNil())
 					By("Validating " + crName)
 					validateUnstructured(cr, validations[crName])
 					Expect(isOwner(ownerKlusterletAddonConfig, cr)).Should(BeTrue(), "OwnerRef of "+crName+" should be set correctly")
+					// the addons depending on this addon are created once it is available
+					setManifestWorkStatusApplied(clientClusterDynamic, crName, testNamespace)
 				}
 			})
 		})
@@ -304,6 +314,9 @@ var _ = Describe("Disabling & Enabling Addons", func() {
 			setManifestWorkStatusAvailable(clientClusterDynamic, allCRDs, testNamespace)
 		})
 		time.Sleep(30 * time.Second)
+		By("Updating manifestworks of addons with all applied", func() {
+			setAddonManifestWorksApplied(clientClusterDynamic, testNamespace)
+		})
 		By("Disabling all addons one by one", func() {
 			for _, addon := range tmpAddonCRs {
 				// workmgr is always enabled
@@ -335,10 +348,8 @@ var _ = Describe("Disabling & Enabling Addons", func() {
 				return nil
 			}, 3, 1).Should(BeNil())
 		})
-		By("Shaffling addon orders")
-		rand.Shuffle(len(tmpAddonCRs), func(i, j int) { tmpAddonCRs[i], tmpAddonCRs[j] = tmpAddonCRs[j], tmpAddonCRs[i] })
-		By("Enabling all Addons one by one", func() {
-			for _, addon := range tmpAddonCRs {
+		By("Enabling all Addons one by one in dependency order", func() {
+			for _, addon := range addonCRs {
 				// workmgr is always enabled
 				if addon == workManager {
 					continue
@@ -350,8 +361,9 @@ var _ = Describe("Disabling & Enabling Addons", func() {
 					Eventually(func() error {
 						_, err = clientClusterDynamic.Resource(gvrManifestwork).Namespace(testNamespace).Get(context.TODO(), addon, metav1.GetOptions{})
 						return err
-					}, 5, 1).Should(BeNil())
+					}, 10, 1).Should(BeNil())
 				})
+				setManifestWorkStatusApplied(clientClusterDynamic, addon, testNamespace)
 			}
 		})
 	})
@@ -444,6 +456,10 @@ var _ = Describe("Deleting A Joined ManagedCluster", func() {
 			checkFinalizerIsSet(clientClusterDynamic, gvrKlusterletAddonConfig, testKlusterletAddonConfigName, testKlusterletAddonConfigName, klusterletAddonFinalizer)
 		})
 
+		By("Updating manifestworks of addons with all applied", func() {
+			setAddonManifestWorksApplied(clientClusterDynamic, testNamespace)
+		})
+
 		// adding finalizers to manifestworks
 		By("Adding finalizers to manifestworks", func() {
 			addFinalizerToManifestWork(clientClusterDynamic, allCRDs, testNamespace)
@@ -465,9 +481,9 @@ var _ = Describe("Deleting A Joined ManagedCluster", func() {
 			})
 		})
 		It("Should remove all Manifestworks for Addon CRs before removing Manifestworks for CRDs and Addon Operator", func() {
-			By("Checking deletion timestamp are set for all CRs", func() {
+			By("Checking deletion timestamp are set for the CRs no addon depends on", func() {
 				Eventually(func() error {
-					for _, crName := range addonCRs {
+					for _, crName := range leafAddonCRs {
 						if err := checkDeletionTimestampIsSet(clientClusterDynamic, gvrManifestwork, crName, testNamespace); err != nil {
 							return err
 						}
@@ -476,6 +492,17 @@ var _ = Describe("Deleting A Joined ManagedCluster", func() {
 				}, 5, 1).Should(BeNil())
 			})
 
+			By("Checking deletion timestamp empty for the CRs other addons depend on", func() {
+				Consistently(func() error {
+					for _, crName := range []string{workManager, policyController} {
+						if err := checkDeletionTimestampIsNotSet(clientClusterDynamic, gvrManifestwork, crName, testNamespace); err != nil {
+							return err
+						}
+					}
+					return nil
+				}, 5, 1).Should(BeNil())
+			})
+
 			By("Checking deletion timestamp empty for klusterlet addon operator", func() {
 				Consistently(func() error {
 					return checkDeletionTimestampIsNotSet(clientClusterDynamic, gvrManifestwork, klusterletAddonOperator, testNamespace)
@@ -570,6 +597,10 @@ var _ = Describe("Deleting KlusterletAddonConfig Only", func() {
 			checkFinalizerIsSet(clientClusterDynamic, gvrKlusterletAddonConfig, testKlusterletAddonConfigName, testKlusterletAddonConfigName, klusterletAddonFinalizer)
 		})
 
+		By("Updating manifestworks of addons with all applied", func() {
+			setAddonManifestWorksApplied(clientClusterDynamic, testNamespace)
+		})
+
 		// adding finalizers to manifestworks
 		By("Adding finalizers to manifestworks", func() {
 			addFinalizerToManifestWork(clientClusterDynamic, allCRDs, testNamespace)
@@ -819,6 +850,36 @@ func isOwner(owner *unstructured.Unstructured, obj interface{}) bool {
 	return false
 }
 
+func setManifestWorkStatusApplied(clientHubDynamic dynamic.Interface, name, namespace string) {
+	patchString := `{"status":{"conditions":[{"lastTransitionTime":"2021-03-31T14:46:27Z","type":"Applied","status":"True","message":"Apply manifest work complete","reason":"AppliedManifestWorkComplete"}]}}`
+
+	Expect(func() error {
+		_, err := clientHubDynamic.Resource(gvrManifestwork).Namespace(namespace).Patch(context.TODO(), name, types.MergePatchType, []byte(patchString), metav1.PatchOptions{}, "status")
+		return err
+	}()).Should(BeNil())
+}
+
+func resetManifestWorkStatus(clientHubDynamic dynamic.Interface, name, namespace string) {
+	patchString := `{"status":{"conditions":[]}}`
+
+	Expect(func() error {
+		_, err := clientHubDynamic.Resource(gvrManifestwork).Namespace(namespace).Patch(context.TODO(), name, types.MergePatchType, []byte(patchString), metav1.PatchOptions{}, "status")
+		return err
+	}()).Should(BeNil())
+}
+
+// setAddonManifestWorksApplied waits for the manifestworks of the addons in dependency order and sets them applied,
+// so the manifestworks of the addons depending on them are created
+func setAddonManifestWorksApplied(clientHubDynamic dynamic.Interface, namespace string) {
+	for _, crName := range addonCRs {
+		Eventually(func() error {
+			_, err := clientHubDynamic.Resource(gvrManifestwork).Namespace(namespace).Get(context.TODO(), crName, metav1.GetOptions{})
+			return err
+		}, 10, 1).Should(BeNil())
+		setManifestWorkStatusApplied(clientHubDynamic, crName, namespace)
+	}
+}
+
 func setManifestWorkStatusAvailable(clientHubDynamic dynamic.Interface, name, namespace string) {
 	patchString := `{"status":{"conditions":[{"lastTransitionTime":"2021-03-31T14:46:27Z","type":"Available","status":"True","message":"All resources are available","reason":"ResourcesAvailable"`
 	//	patchString = patchString + `"lastTransitionTime":` + metav1.Time{Time: time.Now()}
@@ -37,7 +37,7 @@ var mcaValidations = map[string][]string{
 		`"name":"` + testKlusterletAddonConfigName + `"`,
 		`"crName":"` + testKlusterletAddonConfigName + `"`,
 		`"crdName":"klusterletaddonconfigs.agent.open-cluster-management.io"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.ApplicationManager].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.ApplicationManager).DisplayName + `"`,
 	},
 	certPolicyController: []string{
 		`"resource":"klusterletaddonconfigs"`,
@@ -45,7 +45,7 @@ var mcaValidations = map[string][]string{
 		`"name":"` + testKlusterletAddonConfigName + `"`,
 		`"crName":"` + testKlusterletAddonConfigName + `"`,
 		`"crdName":"klusterletaddonconfigs.agent.open-cluster-management.io"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.CertPolicyController].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.CertPolicyController).DisplayName + `"`,
 	},
 	iamPolicyController: []string{
 		`"resource":"klusterletaddonconfigs"`,
@@ -53,7 +53,7 @@ var mcaValidations = map[string][]string{
 		`"name":"` + testKlusterletAddonConfigName + `"`,
 		`"crName":"` + testKlusterletAddonConfigName + `"`,
 		`"crdName":"klusterletaddonconfigs.agent.open-cluster-management.io"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.IamPolicyController].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.IamPolicyController).DisplayName + `"`,
 	},
 	policyController: []string{
 		`"resource":"klusterletaddonconfigs"`,
@@ -61,7 +61,7 @@ var mcaValidations = map[string][]string{
 		`"name":"` + testKlusterletAddonConfigName + `"`,
 		`"crName":"` + testKlusterletAddonConfigName + `"`,
 		`"crdName":"klusterletaddonconfigs.agent.open-cluster-management.io"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.PolicyController].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.PolicyController).DisplayName + `"`,
 	},
 	searchCollector: []string{
 		`"resource":"klusterletaddonconfigs"`,
@@ -69,7 +69,7 @@ var mcaValidations = map[string][]string{
 		`"name":"` + testKlusterletAddonConfigName + `"`,
 		`"crName":"` + testKlusterletAddonConfigName + `"`,
 		`"crdName":"klusterletaddonconfigs.agent.open-cluster-management.io"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.SearchCollector].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.SearchCollector).DisplayName + `"`,
 	},
 	workManager: []string{
 		`"resource":"klusterletaddonconfigs"`,
@@ -77,7 +77,7 @@ var mcaValidations = map[string][]string{
 		`"name":"` + testKlusterletAddonConfigName + `"`,
 		`"crName":"` + testKlusterletAddonConfigName + `"`,
 		`"crdName":"klusterletaddonconfigs.agent.open-cluster-management.io"`,
-		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMap[clustermanagementaddon.WorkManager].DisplayName + `"`,
+		`"displayName":"` + clustermanagementaddon.ClusterManagementAddOnMetadata(clustermanagementaddon.WorkManager).DisplayName + `"`,
 	},
 }
 
@@ -218,6 +218,11 @@ var _ = Describe("ManagedClusterAddOns", func() {
 	})
 
 	It("Should show correct Progressing condition status", func() {
+		// create the manifestworks of all addons, then set them back to installing
+		setAddonManifestWorksApplied(clientClusterDynamic, testNamespace)
+		for _, crName := range addonCRs {
+			resetManifestWorkStatus(clientClusterDynamic, crName, testNamespace)
+		}
 		// add finalizers to manifestworks
 		for _, crName := range addonCRs {
 			addFinalizerToManifestWork(clientClusterDynamic, crName, testNamespace)